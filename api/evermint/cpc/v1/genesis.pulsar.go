@@ -13,11 +13,168 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*CustomPrecompiledContractMeta
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CustomPrecompiledContractMeta)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CustomPrecompiledContractMeta)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(CustomPrecompiledContractMeta)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(CustomPrecompiledContractMeta)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Erc20CpcAllowance
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Erc20CpcAllowance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Erc20CpcAllowance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Erc20CpcAllowance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Erc20CpcAllowance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*Erc20CpcPermitNonce
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Erc20CpcPermitNonce)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Erc20CpcPermitNonce)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(Erc20CpcPermitNonce)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(Erc20CpcPermitNonce)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                         protoreflect.MessageDescriptor
 	fd_GenesisState_params                  protoreflect.FieldDescriptor
 	fd_GenesisState_deploy_erc20_native     protoreflect.FieldDescriptor
 	fd_GenesisState_deploy_staking_contract protoreflect.FieldDescriptor
+	fd_GenesisState_deployed_contracts      protoreflect.FieldDescriptor
+	fd_GenesisState_erc20_allowances        protoreflect.FieldDescriptor
+	fd_GenesisState_module_account_nonce    protoreflect.FieldDescriptor
+	fd_GenesisState_erc20_permit_nonces     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +183,10 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_deploy_erc20_native = md_GenesisState.Fields().ByName("deploy_erc20_native")
 	fd_GenesisState_deploy_staking_contract = md_GenesisState.Fields().ByName("deploy_staking_contract")
+	fd_GenesisState_deployed_contracts = md_GenesisState.Fields().ByName("deployed_contracts")
+	fd_GenesisState_erc20_allowances = md_GenesisState.Fields().ByName("erc20_allowances")
+	fd_GenesisState_module_account_nonce = md_GenesisState.Fields().ByName("module_account_nonce")
+	fd_GenesisState_erc20_permit_nonces = md_GenesisState.Fields().ByName("erc20_permit_nonces")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -111,6 +272,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.DeployedContracts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DeployedContracts})
+		if !f(fd_GenesisState_deployed_contracts, value) {
+			return
+		}
+	}
+	if len(x.Erc20Allowances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Erc20Allowances})
+		if !f(fd_GenesisState_erc20_allowances, value) {
+			return
+		}
+	}
+	if x.ModuleAccountNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ModuleAccountNonce)
+		if !f(fd_GenesisState_module_account_nonce, value) {
+			return
+		}
+	}
+	if len(x.Erc20PermitNonces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.Erc20PermitNonces})
+		if !f(fd_GenesisState_erc20_permit_nonces, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -132,6 +317,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.DeployErc20Native != false
 	case "evermint.cpc.v1.GenesisState.deploy_staking_contract":
 		return x.DeployStakingContract != false
+	case "evermint.cpc.v1.GenesisState.deployed_contracts":
+		return len(x.DeployedContracts) != 0
+	case "evermint.cpc.v1.GenesisState.erc20_allowances":
+		return len(x.Erc20Allowances) != 0
+	case "evermint.cpc.v1.GenesisState.module_account_nonce":
+		return x.ModuleAccountNonce != uint64(0)
+	case "evermint.cpc.v1.GenesisState.erc20_permit_nonces":
+		return len(x.Erc20PermitNonces) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.GenesisState"))
@@ -154,6 +347,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.DeployErc20Native = false
 	case "evermint.cpc.v1.GenesisState.deploy_staking_contract":
 		x.DeployStakingContract = false
+	case "evermint.cpc.v1.GenesisState.deployed_contracts":
+		x.DeployedContracts = nil
+	case "evermint.cpc.v1.GenesisState.erc20_allowances":
+		x.Erc20Allowances = nil
+	case "evermint.cpc.v1.GenesisState.module_account_nonce":
+		x.ModuleAccountNonce = uint64(0)
+	case "evermint.cpc.v1.GenesisState.erc20_permit_nonces":
+		x.Erc20PermitNonces = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.GenesisState"))
@@ -179,6 +380,27 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "evermint.cpc.v1.GenesisState.deploy_staking_contract":
 		value := x.DeployStakingContract
 		return protoreflect.ValueOfBool(value)
+	case "evermint.cpc.v1.GenesisState.deployed_contracts":
+		if len(x.DeployedContracts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.DeployedContracts}
+		return protoreflect.ValueOfList(listValue)
+	case "evermint.cpc.v1.GenesisState.erc20_allowances":
+		if len(x.Erc20Allowances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Erc20Allowances}
+		return protoreflect.ValueOfList(listValue)
+	case "evermint.cpc.v1.GenesisState.module_account_nonce":
+		value := x.ModuleAccountNonce
+		return protoreflect.ValueOfUint64(value)
+	case "evermint.cpc.v1.GenesisState.erc20_permit_nonces":
+		if len(x.Erc20PermitNonces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.Erc20PermitNonces}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.GenesisState"))
@@ -205,6 +427,20 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.DeployErc20Native = value.Bool()
 	case "evermint.cpc.v1.GenesisState.deploy_staking_contract":
 		x.DeployStakingContract = value.Bool()
+	case "evermint.cpc.v1.GenesisState.deployed_contracts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.DeployedContracts = *clv.list
+	case "evermint.cpc.v1.GenesisState.erc20_allowances":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Erc20Allowances = *clv.list
+	case "evermint.cpc.v1.GenesisState.module_account_nonce":
+		x.ModuleAccountNonce = value.Uint()
+	case "evermint.cpc.v1.GenesisState.erc20_permit_nonces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.Erc20PermitNonces = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.GenesisState"))
@@ -230,10 +466,30 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "evermint.cpc.v1.GenesisState.deployed_contracts":
+		if x.DeployedContracts == nil {
+			x.DeployedContracts = []*CustomPrecompiledContractMeta{}
+		}
+		value := &_GenesisState_4_list{list: &x.DeployedContracts}
+		return protoreflect.ValueOfList(value)
+	case "evermint.cpc.v1.GenesisState.erc20_allowances":
+		if x.Erc20Allowances == nil {
+			x.Erc20Allowances = []*Erc20CpcAllowance{}
+		}
+		value := &_GenesisState_5_list{list: &x.Erc20Allowances}
+		return protoreflect.ValueOfList(value)
+	case "evermint.cpc.v1.GenesisState.erc20_permit_nonces":
+		if x.Erc20PermitNonces == nil {
+			x.Erc20PermitNonces = []*Erc20CpcPermitNonce{}
+		}
+		value := &_GenesisState_7_list{list: &x.Erc20PermitNonces}
+		return protoreflect.ValueOfList(value)
 	case "evermint.cpc.v1.GenesisState.deploy_erc20_native":
 		panic(fmt.Errorf("field deploy_erc20_native of message evermint.cpc.v1.GenesisState is not mutable"))
 	case "evermint.cpc.v1.GenesisState.deploy_staking_contract":
 		panic(fmt.Errorf("field deploy_staking_contract of message evermint.cpc.v1.GenesisState is not mutable"))
+	case "evermint.cpc.v1.GenesisState.module_account_nonce":
+		panic(fmt.Errorf("field module_account_nonce of message evermint.cpc.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.GenesisState"))
@@ -254,6 +510,17 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfBool(false)
 	case "evermint.cpc.v1.GenesisState.deploy_staking_contract":
 		return protoreflect.ValueOfBool(false)
+	case "evermint.cpc.v1.GenesisState.deployed_contracts":
+		list := []*CustomPrecompiledContractMeta{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "evermint.cpc.v1.GenesisState.erc20_allowances":
+		list := []*Erc20CpcAllowance{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "evermint.cpc.v1.GenesisState.module_account_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "evermint.cpc.v1.GenesisState.erc20_permit_nonces":
+		list := []*Erc20CpcPermitNonce{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.GenesisState"))
@@ -333,6 +600,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.DeployStakingContract {
 			n += 2
 		}
+		if len(x.DeployedContracts) > 0 {
+			for _, e := range x.DeployedContracts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Erc20Allowances) > 0 {
+			for _, e := range x.Erc20Allowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ModuleAccountNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.ModuleAccountNonce))
+		}
+		if len(x.Erc20PermitNonces) > 0 {
+			for _, e := range x.Erc20PermitNonces {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -362,6 +650,59 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Erc20PermitNonces) > 0 {
+			for iNdEx := len(x.Erc20PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Erc20PermitNonces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.ModuleAccountNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ModuleAccountNonce))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Erc20Allowances) > 0 {
+			for iNdEx := len(x.Erc20Allowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Erc20Allowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.DeployedContracts) > 0 {
+			for iNdEx := len(x.DeployedContracts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DeployedContracts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.DeployStakingContract {
 			i--
 			if x.DeployStakingContract {
@@ -521,6 +862,127 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.DeployStakingContract = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeployedContracts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeployedContracts = append(x.DeployedContracts, &CustomPrecompiledContractMeta{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeployedContracts[len(x.DeployedContracts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Allowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Allowances = append(x.Erc20Allowances, &Erc20CpcAllowance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Erc20Allowances[len(x.Erc20Allowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountNonce", wireType)
+				}
+				x.ModuleAccountNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ModuleAccountNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20PermitNonces", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20PermitNonces = append(x.Erc20PermitNonces, &Erc20CpcPermitNonce{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Erc20PermitNonces[len(x.Erc20PermitNonces)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -556,74 +1018,32 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Params_2_list)(nil)
+var (
+	md_Erc20CpcAllowance          protoreflect.MessageDescriptor
+	fd_Erc20CpcAllowance_contract protoreflect.FieldDescriptor
+	fd_Erc20CpcAllowance_owner    protoreflect.FieldDescriptor
+	fd_Erc20CpcAllowance_spender  protoreflect.FieldDescriptor
+	fd_Erc20CpcAllowance_amount   protoreflect.FieldDescriptor
+)
 
-type _Params_2_list struct {
-	list *[]string
+func init() {
+	file_evermint_cpc_v1_genesis_proto_init()
+	md_Erc20CpcAllowance = File_evermint_cpc_v1_genesis_proto.Messages().ByName("Erc20CpcAllowance")
+	fd_Erc20CpcAllowance_contract = md_Erc20CpcAllowance.Fields().ByName("contract")
+	fd_Erc20CpcAllowance_owner = md_Erc20CpcAllowance.Fields().ByName("owner")
+	fd_Erc20CpcAllowance_spender = md_Erc20CpcAllowance.Fields().ByName("spender")
+	fd_Erc20CpcAllowance_amount = md_Erc20CpcAllowance.Fields().ByName("amount")
 }
 
-func (x *_Params_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_Erc20CpcAllowance)(nil)
 
-func (x *_Params_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_Params_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Params_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Params_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field WhitelistedDeployers as it is not of Message kind"))
-}
-
-func (x *_Params_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_Params_2_list) IsValid() bool {
-	return x.list != nil
-}
+type fastReflection_Erc20CpcAllowance Erc20CpcAllowance
 
-var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_protocol_version      protoreflect.FieldDescriptor
-	fd_Params_whitelisted_deployers protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_evermint_cpc_v1_genesis_proto_init()
-	md_Params = File_evermint_cpc_v1_genesis_proto.Messages().ByName("Params")
-	fd_Params_protocol_version = md_Params.Fields().ByName("protocol_version")
-	fd_Params_whitelisted_deployers = md_Params.Fields().ByName("whitelisted_deployers")
-}
-
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
-
-type fastReflection_Params Params
-
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *Erc20CpcAllowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Erc20CpcAllowance)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *Erc20CpcAllowance) slowProtoReflect() protoreflect.Message {
 	mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,43 +1055,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_Erc20CpcAllowance_messageType fastReflection_Erc20CpcAllowance_messageType
+var _ protoreflect.MessageType = fastReflection_Erc20CpcAllowance_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_Erc20CpcAllowance_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_Erc20CpcAllowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Erc20CpcAllowance)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_Erc20CpcAllowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_Erc20CpcAllowance)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_Erc20CpcAllowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Erc20CpcAllowance
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_Erc20CpcAllowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_Erc20CpcAllowance
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_Erc20CpcAllowance) Type() protoreflect.MessageType {
+	return _fastReflection_Erc20CpcAllowance_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_Erc20CpcAllowance) New() protoreflect.Message {
+	return new(fastReflection_Erc20CpcAllowance)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_Erc20CpcAllowance) Interface() protoreflect.ProtoMessage {
+	return (*Erc20CpcAllowance)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -679,16 +1099,28 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProtocolVersion != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ProtocolVersion)
-		if !f(fd_Params_protocol_version, value) {
+func (x *fastReflection_Erc20CpcAllowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_Erc20CpcAllowance_contract, value) {
 			return
 		}
 	}
-	if len(x.WhitelistedDeployers) != 0 {
-		value := protoreflect.ValueOfList(&_Params_2_list{list: &x.WhitelistedDeployers})
-		if !f(fd_Params_whitelisted_deployers, value) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_Erc20CpcAllowance_owner, value) {
+			return
+		}
+	}
+	if x.Spender != "" {
+		value := protoreflect.ValueOfString(x.Spender)
+		if !f(fd_Erc20CpcAllowance_spender, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_Erc20CpcAllowance_amount, value) {
 			return
 		}
 	}
@@ -705,17 +1137,21 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Erc20CpcAllowance) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "evermint.cpc.v1.Params.protocol_version":
-		return x.ProtocolVersion != uint32(0)
-	case "evermint.cpc.v1.Params.whitelisted_deployers":
-		return len(x.WhitelistedDeployers) != 0
+	case "evermint.cpc.v1.Erc20CpcAllowance.contract":
+		return x.Contract != ""
+	case "evermint.cpc.v1.Erc20CpcAllowance.owner":
+		return x.Owner != ""
+	case "evermint.cpc.v1.Erc20CpcAllowance.spender":
+		return x.Spender != ""
+	case "evermint.cpc.v1.Erc20CpcAllowance.amount":
+		return x.Amount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcAllowance"))
 		}
-		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -725,17 +1161,21 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Erc20CpcAllowance) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "evermint.cpc.v1.Params.protocol_version":
-		x.ProtocolVersion = uint32(0)
-	case "evermint.cpc.v1.Params.whitelisted_deployers":
-		x.WhitelistedDeployers = nil
+	case "evermint.cpc.v1.Erc20CpcAllowance.contract":
+		x.Contract = ""
+	case "evermint.cpc.v1.Erc20CpcAllowance.owner":
+		x.Owner = ""
+	case "evermint.cpc.v1.Erc20CpcAllowance.spender":
+		x.Spender = ""
+	case "evermint.cpc.v1.Erc20CpcAllowance.amount":
+		x.Amount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcAllowance"))
 		}
-		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -745,22 +1185,25 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Erc20CpcAllowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "evermint.cpc.v1.Params.protocol_version":
-		value := x.ProtocolVersion
-		return protoreflect.ValueOfUint32(value)
-	case "evermint.cpc.v1.Params.whitelisted_deployers":
-		if len(x.WhitelistedDeployers) == 0 {
-			return protoreflect.ValueOfList(&_Params_2_list{})
-		}
-		listValue := &_Params_2_list{list: &x.WhitelistedDeployers}
-		return protoreflect.ValueOfList(listValue)
+	case "evermint.cpc.v1.Erc20CpcAllowance.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "evermint.cpc.v1.Erc20CpcAllowance.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "evermint.cpc.v1.Erc20CpcAllowance.spender":
+		value := x.Spender
+		return protoreflect.ValueOfString(value)
+	case "evermint.cpc.v1.Erc20CpcAllowance.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcAllowance"))
 		}
-		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcAllowance does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -774,19 +1217,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Erc20CpcAllowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "evermint.cpc.v1.Params.protocol_version":
-		x.ProtocolVersion = uint32(value.Uint())
-	case "evermint.cpc.v1.Params.whitelisted_deployers":
-		lv := value.List()
-		clv := lv.(*_Params_2_list)
-		x.WhitelistedDeployers = *clv.list
+	case "evermint.cpc.v1.Erc20CpcAllowance.contract":
+		x.Contract = value.Interface().(string)
+	case "evermint.cpc.v1.Erc20CpcAllowance.owner":
+		x.Owner = value.Interface().(string)
+	case "evermint.cpc.v1.Erc20CpcAllowance.spender":
+		x.Spender = value.Interface().(string)
+	case "evermint.cpc.v1.Erc20CpcAllowance.amount":
+		x.Amount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcAllowance"))
 		}
-		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -800,49 +1245,52 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Erc20CpcAllowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "evermint.cpc.v1.Params.whitelisted_deployers":
-		if x.WhitelistedDeployers == nil {
-			x.WhitelistedDeployers = []string{}
-		}
-		value := &_Params_2_list{list: &x.WhitelistedDeployers}
-		return protoreflect.ValueOfList(value)
-	case "evermint.cpc.v1.Params.protocol_version":
-		panic(fmt.Errorf("field protocol_version of message evermint.cpc.v1.Params is not mutable"))
+	case "evermint.cpc.v1.Erc20CpcAllowance.contract":
+		panic(fmt.Errorf("field contract of message evermint.cpc.v1.Erc20CpcAllowance is not mutable"))
+	case "evermint.cpc.v1.Erc20CpcAllowance.owner":
+		panic(fmt.Errorf("field owner of message evermint.cpc.v1.Erc20CpcAllowance is not mutable"))
+	case "evermint.cpc.v1.Erc20CpcAllowance.spender":
+		panic(fmt.Errorf("field spender of message evermint.cpc.v1.Erc20CpcAllowance is not mutable"))
+	case "evermint.cpc.v1.Erc20CpcAllowance.amount":
+		panic(fmt.Errorf("field amount of message evermint.cpc.v1.Erc20CpcAllowance is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcAllowance"))
 		}
-		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Erc20CpcAllowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "evermint.cpc.v1.Params.protocol_version":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "evermint.cpc.v1.Params.whitelisted_deployers":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
+	case "evermint.cpc.v1.Erc20CpcAllowance.contract":
+		return protoreflect.ValueOfString("")
+	case "evermint.cpc.v1.Erc20CpcAllowance.owner":
+		return protoreflect.ValueOfString("")
+	case "evermint.cpc.v1.Erc20CpcAllowance.spender":
+		return protoreflect.ValueOfString("")
+	case "evermint.cpc.v1.Erc20CpcAllowance.amount":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcAllowance"))
 		}
-		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcAllowance does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Erc20CpcAllowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in evermint.cpc.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in evermint.cpc.v1.Erc20CpcAllowance", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -850,7 +1298,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Erc20CpcAllowance) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -861,7 +1309,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Erc20CpcAllowance) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -873,7 +1321,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_Erc20CpcAllowance) IsValid() bool {
 	return x != nil
 }
 
@@ -883,9 +1331,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Erc20CpcAllowance) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*Erc20CpcAllowance)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -897,14 +1345,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProtocolVersion != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProtocolVersion))
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.WhitelistedDeployers) > 0 {
-			for _, s := range x.WhitelistedDeployers {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Spender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -916,7 +1371,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*Erc20CpcAllowance)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -935,19 +1390,33 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.WhitelistedDeployers) > 0 {
-			for iNdEx := len(x.WhitelistedDeployers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.WhitelistedDeployers[iNdEx])
-				copy(dAtA[i:], x.WhitelistedDeployers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WhitelistedDeployers[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
 		}
-		if x.ProtocolVersion != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolVersion))
+		if len(x.Spender) > 0 {
+			i -= len(x.Spender)
+			copy(dAtA[i:], x.Spender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Spender)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -960,7 +1429,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*Erc20CpcAllowance)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -992,17 +1461,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Erc20CpcAllowance: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Erc20CpcAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 				}
-				x.ProtocolVersion = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1012,14 +1481,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProtocolVersion |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDeployers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1047,46 +1529,2669 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WhitelistedDeployers = append(x.WhitelistedDeployers, string(dAtA[iNdEx:postIndex]))
+				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Spender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_2_list)(nil)
+
+type _Params_2_list struct {
+	list *[]string
+}
+
+func (x *_Params_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field WhitelistedDeployers as it is not of Message kind"))
+}
+
+func (x *_Params_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_3_list)(nil)
+
+type _Params_3_list struct {
+	list *[]*CpcMethodGas
+}
+
+func (x *_Params_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CpcMethodGas)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CpcMethodGas)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_3_list) AppendMutable() protoreflect.Value {
+	v := new(CpcMethodGas)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_3_list) NewElement() protoreflect.Value {
+	v := new(CpcMethodGas)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                       protoreflect.MessageDescriptor
+	fd_Params_protocol_version      protoreflect.FieldDescriptor
+	fd_Params_whitelisted_deployers protoreflect.FieldDescriptor
+	fd_Params_method_gas_table      protoreflect.FieldDescriptor
+	fd_Params_dynamic_gas_enabled   protoreflect.FieldDescriptor
+	fd_Params_erc20_auto_deploy     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evermint_cpc_v1_genesis_proto_init()
+	md_Params = File_evermint_cpc_v1_genesis_proto.Messages().ByName("Params")
+	fd_Params_protocol_version = md_Params.Fields().ByName("protocol_version")
+	fd_Params_whitelisted_deployers = md_Params.Fields().ByName("whitelisted_deployers")
+	fd_Params_method_gas_table = md_Params.Fields().ByName("method_gas_table")
+	fd_Params_dynamic_gas_enabled = md_Params.Fields().ByName("dynamic_gas_enabled")
+	fd_Params_erc20_auto_deploy = md_Params.Fields().ByName("erc20_auto_deploy")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProtocolVersion != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ProtocolVersion)
+		if !f(fd_Params_protocol_version, value) {
+			return
+		}
+	}
+	if len(x.WhitelistedDeployers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_2_list{list: &x.WhitelistedDeployers})
+		if !f(fd_Params_whitelisted_deployers, value) {
+			return
+		}
+	}
+	if len(x.MethodGasTable) != 0 {
+		value := protoreflect.ValueOfList(&_Params_3_list{list: &x.MethodGasTable})
+		if !f(fd_Params_method_gas_table, value) {
+			return
+		}
+	}
+	if x.DynamicGasEnabled != false {
+		value := protoreflect.ValueOfBool(x.DynamicGasEnabled)
+		if !f(fd_Params_dynamic_gas_enabled, value) {
+			return
+		}
+	}
+	if x.Erc20AutoDeploy != nil {
+		value := protoreflect.ValueOfMessage(x.Erc20AutoDeploy.ProtoReflect())
+		if !f(fd_Params_erc20_auto_deploy, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Params.protocol_version":
+		return x.ProtocolVersion != uint32(0)
+	case "evermint.cpc.v1.Params.whitelisted_deployers":
+		return len(x.WhitelistedDeployers) != 0
+	case "evermint.cpc.v1.Params.method_gas_table":
+		return len(x.MethodGasTable) != 0
+	case "evermint.cpc.v1.Params.dynamic_gas_enabled":
+		return x.DynamicGasEnabled != false
+	case "evermint.cpc.v1.Params.erc20_auto_deploy":
+		return x.Erc20AutoDeploy != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Params.protocol_version":
+		x.ProtocolVersion = uint32(0)
+	case "evermint.cpc.v1.Params.whitelisted_deployers":
+		x.WhitelistedDeployers = nil
+	case "evermint.cpc.v1.Params.method_gas_table":
+		x.MethodGasTable = nil
+	case "evermint.cpc.v1.Params.dynamic_gas_enabled":
+		x.DynamicGasEnabled = false
+	case "evermint.cpc.v1.Params.erc20_auto_deploy":
+		x.Erc20AutoDeploy = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evermint.cpc.v1.Params.protocol_version":
+		value := x.ProtocolVersion
+		return protoreflect.ValueOfUint32(value)
+	case "evermint.cpc.v1.Params.whitelisted_deployers":
+		if len(x.WhitelistedDeployers) == 0 {
+			return protoreflect.ValueOfList(&_Params_2_list{})
+		}
+		listValue := &_Params_2_list{list: &x.WhitelistedDeployers}
+		return protoreflect.ValueOfList(listValue)
+	case "evermint.cpc.v1.Params.method_gas_table":
+		if len(x.MethodGasTable) == 0 {
+			return protoreflect.ValueOfList(&_Params_3_list{})
+		}
+		listValue := &_Params_3_list{list: &x.MethodGasTable}
+		return protoreflect.ValueOfList(listValue)
+	case "evermint.cpc.v1.Params.dynamic_gas_enabled":
+		value := x.DynamicGasEnabled
+		return protoreflect.ValueOfBool(value)
+	case "evermint.cpc.v1.Params.erc20_auto_deploy":
+		value := x.Erc20AutoDeploy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Params.protocol_version":
+		x.ProtocolVersion = uint32(value.Uint())
+	case "evermint.cpc.v1.Params.whitelisted_deployers":
+		lv := value.List()
+		clv := lv.(*_Params_2_list)
+		x.WhitelistedDeployers = *clv.list
+	case "evermint.cpc.v1.Params.method_gas_table":
+		lv := value.List()
+		clv := lv.(*_Params_3_list)
+		x.MethodGasTable = *clv.list
+	case "evermint.cpc.v1.Params.dynamic_gas_enabled":
+		x.DynamicGasEnabled = value.Bool()
+	case "evermint.cpc.v1.Params.erc20_auto_deploy":
+		x.Erc20AutoDeploy = value.Message().Interface().(*Erc20AutoDeploy)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Params.whitelisted_deployers":
+		if x.WhitelistedDeployers == nil {
+			x.WhitelistedDeployers = []string{}
+		}
+		value := &_Params_2_list{list: &x.WhitelistedDeployers}
+		return protoreflect.ValueOfList(value)
+	case "evermint.cpc.v1.Params.method_gas_table":
+		if x.MethodGasTable == nil {
+			x.MethodGasTable = []*CpcMethodGas{}
+		}
+		value := &_Params_3_list{list: &x.MethodGasTable}
+		return protoreflect.ValueOfList(value)
+	case "evermint.cpc.v1.Params.erc20_auto_deploy":
+		if x.Erc20AutoDeploy == nil {
+			x.Erc20AutoDeploy = new(Erc20AutoDeploy)
+		}
+		return protoreflect.ValueOfMessage(x.Erc20AutoDeploy.ProtoReflect())
+	case "evermint.cpc.v1.Params.protocol_version":
+		panic(fmt.Errorf("field protocol_version of message evermint.cpc.v1.Params is not mutable"))
+	case "evermint.cpc.v1.Params.dynamic_gas_enabled":
+		panic(fmt.Errorf("field dynamic_gas_enabled of message evermint.cpc.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Params.protocol_version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "evermint.cpc.v1.Params.whitelisted_deployers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
+	case "evermint.cpc.v1.Params.method_gas_table":
+		list := []*CpcMethodGas{}
+		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "evermint.cpc.v1.Params.dynamic_gas_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "evermint.cpc.v1.Params.erc20_auto_deploy":
+		m := new(Erc20AutoDeploy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evermint.cpc.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProtocolVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProtocolVersion))
+		}
+		if len(x.WhitelistedDeployers) > 0 {
+			for _, s := range x.WhitelistedDeployers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MethodGasTable) > 0 {
+			for _, e := range x.MethodGasTable {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DynamicGasEnabled {
+			n += 2
+		}
+		if x.Erc20AutoDeploy != nil {
+			l = options.Size(x.Erc20AutoDeploy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Erc20AutoDeploy != nil {
+			encoded, err := options.Marshal(x.Erc20AutoDeploy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.DynamicGasEnabled {
+			i--
+			if x.DynamicGasEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.MethodGasTable) > 0 {
+			for iNdEx := len(x.MethodGasTable) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MethodGasTable[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.WhitelistedDeployers) > 0 {
+			for iNdEx := len(x.WhitelistedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.WhitelistedDeployers[iNdEx])
+				copy(dAtA[i:], x.WhitelistedDeployers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WhitelistedDeployers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.ProtocolVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolVersion))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+				}
+				x.ProtocolVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProtocolVersion |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDeployers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WhitelistedDeployers = append(x.WhitelistedDeployers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MethodGasTable", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MethodGasTable = append(x.MethodGasTable, &CpcMethodGas{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MethodGasTable[len(x.MethodGasTable)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DynamicGasEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DynamicGasEnabled = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20AutoDeploy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Erc20AutoDeploy == nil {
+					x.Erc20AutoDeploy = &Erc20AutoDeploy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Erc20AutoDeploy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Erc20AutoDeploy_3_list)(nil)
+
+type _Erc20AutoDeploy_3_list struct {
+	list *[]string
+}
+
+func (x *_Erc20AutoDeploy_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Erc20AutoDeploy_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Erc20AutoDeploy_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Erc20AutoDeploy_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Erc20AutoDeploy_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Erc20AutoDeploy at list field AllowedDenoms as it is not of Message kind"))
+}
+
+func (x *_Erc20AutoDeploy_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Erc20AutoDeploy_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Erc20AutoDeploy_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Erc20AutoDeploy_4_list)(nil)
+
+type _Erc20AutoDeploy_4_list struct {
+	list *[]string
+}
+
+func (x *_Erc20AutoDeploy_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Erc20AutoDeploy_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Erc20AutoDeploy_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Erc20AutoDeploy_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Erc20AutoDeploy_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Erc20AutoDeploy at list field DeniedDenoms as it is not of Message kind"))
+}
+
+func (x *_Erc20AutoDeploy_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Erc20AutoDeploy_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Erc20AutoDeploy_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Erc20AutoDeploy                       protoreflect.MessageDescriptor
+	fd_Erc20AutoDeploy_on_ibc_receive        protoreflect.FieldDescriptor
+	fd_Erc20AutoDeploy_on_denom_metadata_set protoreflect.FieldDescriptor
+	fd_Erc20AutoDeploy_allowed_denoms        protoreflect.FieldDescriptor
+	fd_Erc20AutoDeploy_denied_denoms         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evermint_cpc_v1_genesis_proto_init()
+	md_Erc20AutoDeploy = File_evermint_cpc_v1_genesis_proto.Messages().ByName("Erc20AutoDeploy")
+	fd_Erc20AutoDeploy_on_ibc_receive = md_Erc20AutoDeploy.Fields().ByName("on_ibc_receive")
+	fd_Erc20AutoDeploy_on_denom_metadata_set = md_Erc20AutoDeploy.Fields().ByName("on_denom_metadata_set")
+	fd_Erc20AutoDeploy_allowed_denoms = md_Erc20AutoDeploy.Fields().ByName("allowed_denoms")
+	fd_Erc20AutoDeploy_denied_denoms = md_Erc20AutoDeploy.Fields().ByName("denied_denoms")
+}
+
+var _ protoreflect.Message = (*fastReflection_Erc20AutoDeploy)(nil)
+
+type fastReflection_Erc20AutoDeploy Erc20AutoDeploy
+
+func (x *Erc20AutoDeploy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Erc20AutoDeploy)(x)
+}
+
+func (x *Erc20AutoDeploy) slowProtoReflect() protoreflect.Message {
+	mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Erc20AutoDeploy_messageType fastReflection_Erc20AutoDeploy_messageType
+var _ protoreflect.MessageType = fastReflection_Erc20AutoDeploy_messageType{}
+
+type fastReflection_Erc20AutoDeploy_messageType struct{}
+
+func (x fastReflection_Erc20AutoDeploy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Erc20AutoDeploy)(nil)
+}
+func (x fastReflection_Erc20AutoDeploy_messageType) New() protoreflect.Message {
+	return new(fastReflection_Erc20AutoDeploy)
+}
+func (x fastReflection_Erc20AutoDeploy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Erc20AutoDeploy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Erc20AutoDeploy) Descriptor() protoreflect.MessageDescriptor {
+	return md_Erc20AutoDeploy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Erc20AutoDeploy) Type() protoreflect.MessageType {
+	return _fastReflection_Erc20AutoDeploy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Erc20AutoDeploy) New() protoreflect.Message {
+	return new(fastReflection_Erc20AutoDeploy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Erc20AutoDeploy) Interface() protoreflect.ProtoMessage {
+	return (*Erc20AutoDeploy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Erc20AutoDeploy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OnIbcReceive != false {
+		value := protoreflect.ValueOfBool(x.OnIbcReceive)
+		if !f(fd_Erc20AutoDeploy_on_ibc_receive, value) {
+			return
+		}
+	}
+	if x.OnDenomMetadataSet != false {
+		value := protoreflect.ValueOfBool(x.OnDenomMetadataSet)
+		if !f(fd_Erc20AutoDeploy_on_denom_metadata_set, value) {
+			return
+		}
+	}
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Erc20AutoDeploy_3_list{list: &x.AllowedDenoms})
+		if !f(fd_Erc20AutoDeploy_allowed_denoms, value) {
+			return
+		}
+	}
+	if len(x.DeniedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Erc20AutoDeploy_4_list{list: &x.DeniedDenoms})
+		if !f(fd_Erc20AutoDeploy_denied_denoms, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Erc20AutoDeploy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_ibc_receive":
+		return x.OnIbcReceive != false
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_denom_metadata_set":
+		return x.OnDenomMetadataSet != false
+	case "evermint.cpc.v1.Erc20AutoDeploy.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "evermint.cpc.v1.Erc20AutoDeploy.denied_denoms":
+		return len(x.DeniedDenoms) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20AutoDeploy"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20AutoDeploy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Erc20AutoDeploy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_ibc_receive":
+		x.OnIbcReceive = false
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_denom_metadata_set":
+		x.OnDenomMetadataSet = false
+	case "evermint.cpc.v1.Erc20AutoDeploy.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "evermint.cpc.v1.Erc20AutoDeploy.denied_denoms":
+		x.DeniedDenoms = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20AutoDeploy"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20AutoDeploy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Erc20AutoDeploy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_ibc_receive":
+		value := x.OnIbcReceive
+		return protoreflect.ValueOfBool(value)
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_denom_metadata_set":
+		value := x.OnDenomMetadataSet
+		return protoreflect.ValueOfBool(value)
+	case "evermint.cpc.v1.Erc20AutoDeploy.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Erc20AutoDeploy_3_list{})
+		}
+		listValue := &_Erc20AutoDeploy_3_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "evermint.cpc.v1.Erc20AutoDeploy.denied_denoms":
+		if len(x.DeniedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Erc20AutoDeploy_4_list{})
+		}
+		listValue := &_Erc20AutoDeploy_4_list{list: &x.DeniedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20AutoDeploy"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20AutoDeploy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Erc20AutoDeploy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_ibc_receive":
+		x.OnIbcReceive = value.Bool()
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_denom_metadata_set":
+		x.OnDenomMetadataSet = value.Bool()
+	case "evermint.cpc.v1.Erc20AutoDeploy.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_Erc20AutoDeploy_3_list)
+		x.AllowedDenoms = *clv.list
+	case "evermint.cpc.v1.Erc20AutoDeploy.denied_denoms":
+		lv := value.List()
+		clv := lv.(*_Erc20AutoDeploy_4_list)
+		x.DeniedDenoms = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20AutoDeploy"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20AutoDeploy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Erc20AutoDeploy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20AutoDeploy.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_Erc20AutoDeploy_3_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "evermint.cpc.v1.Erc20AutoDeploy.denied_denoms":
+		if x.DeniedDenoms == nil {
+			x.DeniedDenoms = []string{}
+		}
+		value := &_Erc20AutoDeploy_4_list{list: &x.DeniedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_ibc_receive":
+		panic(fmt.Errorf("field on_ibc_receive of message evermint.cpc.v1.Erc20AutoDeploy is not mutable"))
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_denom_metadata_set":
+		panic(fmt.Errorf("field on_denom_metadata_set of message evermint.cpc.v1.Erc20AutoDeploy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20AutoDeploy"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20AutoDeploy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Erc20AutoDeploy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_ibc_receive":
+		return protoreflect.ValueOfBool(false)
+	case "evermint.cpc.v1.Erc20AutoDeploy.on_denom_metadata_set":
+		return protoreflect.ValueOfBool(false)
+	case "evermint.cpc.v1.Erc20AutoDeploy.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Erc20AutoDeploy_3_list{list: &list})
+	case "evermint.cpc.v1.Erc20AutoDeploy.denied_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Erc20AutoDeploy_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20AutoDeploy"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20AutoDeploy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Erc20AutoDeploy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evermint.cpc.v1.Erc20AutoDeploy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Erc20AutoDeploy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Erc20AutoDeploy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Erc20AutoDeploy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Erc20AutoDeploy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Erc20AutoDeploy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OnIbcReceive {
+			n += 2
+		}
+		if x.OnDenomMetadataSet {
+			n += 2
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedDenoms) > 0 {
+			for _, s := range x.DeniedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Erc20AutoDeploy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DeniedDenoms) > 0 {
+			for iNdEx := len(x.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedDenoms[iNdEx])
+				copy(dAtA[i:], x.DeniedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.OnDenomMetadataSet {
+			i--
+			if x.OnDenomMetadataSet {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OnIbcReceive {
+			i--
+			if x.OnIbcReceive {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Erc20AutoDeploy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Erc20AutoDeploy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Erc20AutoDeploy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnIbcReceive", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OnIbcReceive = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnDenomMetadataSet", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OnDenomMetadataSet = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedDenoms = append(x.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CpcMethodGas                 protoreflect.MessageDescriptor
+	fd_CpcMethodGas_contract_type   protoreflect.FieldDescriptor
+	fd_CpcMethodGas_method_selector protoreflect.FieldDescriptor
+	fd_CpcMethodGas_gas             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evermint_cpc_v1_genesis_proto_init()
+	md_CpcMethodGas = File_evermint_cpc_v1_genesis_proto.Messages().ByName("CpcMethodGas")
+	fd_CpcMethodGas_contract_type = md_CpcMethodGas.Fields().ByName("contract_type")
+	fd_CpcMethodGas_method_selector = md_CpcMethodGas.Fields().ByName("method_selector")
+	fd_CpcMethodGas_gas = md_CpcMethodGas.Fields().ByName("gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_CpcMethodGas)(nil)
+
+type fastReflection_CpcMethodGas CpcMethodGas
+
+func (x *CpcMethodGas) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CpcMethodGas)(x)
+}
+
+func (x *CpcMethodGas) slowProtoReflect() protoreflect.Message {
+	mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CpcMethodGas_messageType fastReflection_CpcMethodGas_messageType
+var _ protoreflect.MessageType = fastReflection_CpcMethodGas_messageType{}
+
+type fastReflection_CpcMethodGas_messageType struct{}
+
+func (x fastReflection_CpcMethodGas_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CpcMethodGas)(nil)
+}
+func (x fastReflection_CpcMethodGas_messageType) New() protoreflect.Message {
+	return new(fastReflection_CpcMethodGas)
+}
+func (x fastReflection_CpcMethodGas_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CpcMethodGas
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CpcMethodGas) Descriptor() protoreflect.MessageDescriptor {
+	return md_CpcMethodGas
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CpcMethodGas) Type() protoreflect.MessageType {
+	return _fastReflection_CpcMethodGas_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CpcMethodGas) New() protoreflect.Message {
+	return new(fastReflection_CpcMethodGas)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CpcMethodGas) Interface() protoreflect.ProtoMessage {
+	return (*CpcMethodGas)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CpcMethodGas) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractType != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ContractType)
+		if !f(fd_CpcMethodGas_contract_type, value) {
+			return
+		}
+	}
+	if x.MethodSelector != "" {
+		value := protoreflect.ValueOfString(x.MethodSelector)
+		if !f(fd_CpcMethodGas_method_selector, value) {
+			return
+		}
+	}
+	if x.Gas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Gas)
+		if !f(fd_CpcMethodGas_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CpcMethodGas) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.CpcMethodGas.contract_type":
+		return x.ContractType != uint32(0)
+	case "evermint.cpc.v1.CpcMethodGas.method_selector":
+		return x.MethodSelector != ""
+	case "evermint.cpc.v1.CpcMethodGas.gas":
+		return x.Gas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.CpcMethodGas"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.CpcMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CpcMethodGas) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.CpcMethodGas.contract_type":
+		x.ContractType = uint32(0)
+	case "evermint.cpc.v1.CpcMethodGas.method_selector":
+		x.MethodSelector = ""
+	case "evermint.cpc.v1.CpcMethodGas.gas":
+		x.Gas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.CpcMethodGas"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.CpcMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CpcMethodGas) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evermint.cpc.v1.CpcMethodGas.contract_type":
+		value := x.ContractType
+		return protoreflect.ValueOfUint32(value)
+	case "evermint.cpc.v1.CpcMethodGas.method_selector":
+		value := x.MethodSelector
+		return protoreflect.ValueOfString(value)
+	case "evermint.cpc.v1.CpcMethodGas.gas":
+		value := x.Gas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.CpcMethodGas"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.CpcMethodGas does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CpcMethodGas) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.CpcMethodGas.contract_type":
+		x.ContractType = uint32(value.Uint())
+	case "evermint.cpc.v1.CpcMethodGas.method_selector":
+		x.MethodSelector = value.Interface().(string)
+	case "evermint.cpc.v1.CpcMethodGas.gas":
+		x.Gas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.CpcMethodGas"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.CpcMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CpcMethodGas) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.CpcMethodGas.contract_type":
+		panic(fmt.Errorf("field contract_type of message evermint.cpc.v1.CpcMethodGas is not mutable"))
+	case "evermint.cpc.v1.CpcMethodGas.method_selector":
+		panic(fmt.Errorf("field method_selector of message evermint.cpc.v1.CpcMethodGas is not mutable"))
+	case "evermint.cpc.v1.CpcMethodGas.gas":
+		panic(fmt.Errorf("field gas of message evermint.cpc.v1.CpcMethodGas is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.CpcMethodGas"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.CpcMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CpcMethodGas) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.CpcMethodGas.contract_type":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "evermint.cpc.v1.CpcMethodGas.method_selector":
+		return protoreflect.ValueOfString("")
+	case "evermint.cpc.v1.CpcMethodGas.gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.CpcMethodGas"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.CpcMethodGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CpcMethodGas) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evermint.cpc.v1.CpcMethodGas", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CpcMethodGas) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CpcMethodGas) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CpcMethodGas) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CpcMethodGas) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CpcMethodGas)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractType != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractType))
+		}
+		l = len(x.MethodSelector)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Gas != 0 {
+			n += 1 + runtime.Sov(uint64(x.Gas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CpcMethodGas)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Gas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MethodSelector) > 0 {
+			i -= len(x.MethodSelector)
+			copy(dAtA[i:], x.MethodSelector)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MethodSelector)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ContractType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CpcMethodGas)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CpcMethodGas: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CpcMethodGas: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+				}
+				x.ContractType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractType |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MethodSelector", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MethodSelector = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				}
+				x.Gas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Gas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Erc20CpcPermitNonce          protoreflect.MessageDescriptor
+	fd_Erc20CpcPermitNonce_contract protoreflect.FieldDescriptor
+	fd_Erc20CpcPermitNonce_owner    protoreflect.FieldDescriptor
+	fd_Erc20CpcPermitNonce_nonce    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evermint_cpc_v1_genesis_proto_init()
+	md_Erc20CpcPermitNonce = File_evermint_cpc_v1_genesis_proto.Messages().ByName("Erc20CpcPermitNonce")
+	fd_Erc20CpcPermitNonce_contract = md_Erc20CpcPermitNonce.Fields().ByName("contract")
+	fd_Erc20CpcPermitNonce_owner = md_Erc20CpcPermitNonce.Fields().ByName("owner")
+	fd_Erc20CpcPermitNonce_nonce = md_Erc20CpcPermitNonce.Fields().ByName("nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_Erc20CpcPermitNonce)(nil)
+
+type fastReflection_Erc20CpcPermitNonce Erc20CpcPermitNonce
+
+func (x *Erc20CpcPermitNonce) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Erc20CpcPermitNonce)(x)
+}
+
+func (x *Erc20CpcPermitNonce) slowProtoReflect() protoreflect.Message {
+	mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Erc20CpcPermitNonce_messageType fastReflection_Erc20CpcPermitNonce_messageType
+var _ protoreflect.MessageType = fastReflection_Erc20CpcPermitNonce_messageType{}
+
+type fastReflection_Erc20CpcPermitNonce_messageType struct{}
+
+func (x fastReflection_Erc20CpcPermitNonce_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Erc20CpcPermitNonce)(nil)
+}
+func (x fastReflection_Erc20CpcPermitNonce_messageType) New() protoreflect.Message {
+	return new(fastReflection_Erc20CpcPermitNonce)
+}
+func (x fastReflection_Erc20CpcPermitNonce_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Erc20CpcPermitNonce
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Erc20CpcPermitNonce) Descriptor() protoreflect.MessageDescriptor {
+	return md_Erc20CpcPermitNonce
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Erc20CpcPermitNonce) Type() protoreflect.MessageType {
+	return _fastReflection_Erc20CpcPermitNonce_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Erc20CpcPermitNonce) New() protoreflect.Message {
+	return new(fastReflection_Erc20CpcPermitNonce)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Erc20CpcPermitNonce) Interface() protoreflect.ProtoMessage {
+	return (*Erc20CpcPermitNonce)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Erc20CpcPermitNonce) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_Erc20CpcPermitNonce_contract, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_Erc20CpcPermitNonce_owner, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_Erc20CpcPermitNonce_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Erc20CpcPermitNonce) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.contract":
+		return x.Contract != ""
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.owner":
+		return x.Owner != ""
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.nonce":
+		return x.Nonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcPermitNonce"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcPermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Erc20CpcPermitNonce) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.contract":
+		x.Contract = ""
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.owner":
+		x.Owner = ""
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.nonce":
+		x.Nonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcPermitNonce"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcPermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Erc20CpcPermitNonce) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcPermitNonce"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcPermitNonce does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Erc20CpcPermitNonce) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.contract":
+		x.Contract = value.Interface().(string)
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.owner":
+		x.Owner = value.Interface().(string)
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.nonce":
+		x.Nonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcPermitNonce"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcPermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Erc20CpcPermitNonce) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.contract":
+		panic(fmt.Errorf("field contract of message evermint.cpc.v1.Erc20CpcPermitNonce is not mutable"))
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.owner":
+		panic(fmt.Errorf("field owner of message evermint.cpc.v1.Erc20CpcPermitNonce is not mutable"))
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.nonce":
+		panic(fmt.Errorf("field nonce of message evermint.cpc.v1.Erc20CpcPermitNonce is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcPermitNonce"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcPermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Erc20CpcPermitNonce) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.contract":
+		return protoreflect.ValueOfString("")
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.owner":
+		return protoreflect.ValueOfString("")
+	case "evermint.cpc.v1.Erc20CpcPermitNonce.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.cpc.v1.Erc20CpcPermitNonce"))
+		}
+		panic(fmt.Errorf("message evermint.cpc.v1.Erc20CpcPermitNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Erc20CpcPermitNonce) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evermint.cpc.v1.Erc20CpcPermitNonce", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Erc20CpcPermitNonce) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Erc20CpcPermitNonce) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Erc20CpcPermitNonce) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Erc20CpcPermitNonce) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Erc20CpcPermitNonce)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Erc20CpcPermitNonce)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Erc20CpcPermitNonce)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Erc20CpcPermitNonce: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Erc20CpcPermitNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: evermint/cpc/v1/genesis.proto
 
@@ -1110,6 +4215,15 @@ type GenesisState struct {
 	DeployErc20Native bool `protobuf:"varint,2,opt,name=deploy_erc20_native,json=deployErc20Native,proto3" json:"deploy_erc20_native,omitempty"`
 	// deploy_staking_contract defines if the module should deploy the staking contract.
 	DeployStakingContract bool `protobuf:"varint,3,opt,name=deploy_staking_contract,json=deployStakingContract,proto3" json:"deploy_staking_contract,omitempty"`
+	// deployed_contracts is the list of the deployed custom precompiled contracts.
+	DeployedContracts []*CustomPrecompiledContractMeta `protobuf:"bytes,4,rep,name=deployed_contracts,json=deployedContracts,proto3" json:"deployed_contracts,omitempty"`
+	// erc20_allowances is the list of the allowances of the ERC20 custom precompiled contracts.
+	Erc20Allowances []*Erc20CpcAllowance `protobuf:"bytes,5,rep,name=erc20_allowances,json=erc20Allowances,proto3" json:"erc20_allowances,omitempty"`
+	// module_account_nonce is the nonce of the module account,
+	// used to generate address for the dynamic custom precompiled contracts.
+	ModuleAccountNonce uint64 `protobuf:"varint,6,opt,name=module_account_nonce,json=moduleAccountNonce,proto3" json:"module_account_nonce,omitempty"`
+	// erc20_permit_nonces is the list of the EIP-2612 permit nonces of the ERC20 custom precompiled contracts.
+	Erc20PermitNonces []*Erc20CpcPermitNonce `protobuf:"bytes,7,rep,name=erc20_permit_nonces,json=erc20PermitNonces,proto3" json:"erc20_permit_nonces,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1153,6 +4267,98 @@ func (x *GenesisState) GetDeployStakingContract() bool {
 	return false
 }
 
+func (x *GenesisState) GetDeployedContracts() []*CustomPrecompiledContractMeta {
+	if x != nil {
+		return x.DeployedContracts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetErc20Allowances() []*Erc20CpcAllowance {
+	if x != nil {
+		return x.Erc20Allowances
+	}
+	return nil
+}
+
+func (x *GenesisState) GetModuleAccountNonce() uint64 {
+	if x != nil {
+		return x.ModuleAccountNonce
+	}
+	return 0
+}
+
+func (x *GenesisState) GetErc20PermitNonces() []*Erc20CpcPermitNonce {
+	if x != nil {
+		return x.Erc20PermitNonces
+	}
+	return nil
+}
+
+// Erc20CpcAllowance defines an allowance record of the ERC20 custom precompiled contract.
+type Erc20CpcAllowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract is the hex address of the ERC20 custom precompiled contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// owner is the hex address of the token owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the hex address of the spender.
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// amount is the allowance amount.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Erc20CpcAllowance) Reset() {
+	*x = Erc20CpcAllowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Erc20CpcAllowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Erc20CpcAllowance) ProtoMessage() {}
+
+// Deprecated: Use Erc20CpcAllowance.ProtoReflect.Descriptor instead.
+func (*Erc20CpcAllowance) Descriptor() ([]byte, []int) {
+	return file_evermint_cpc_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Erc20CpcAllowance) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *Erc20CpcAllowance) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Erc20CpcAllowance) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *Erc20CpcAllowance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 // Params defines the cpc module params
 type Params struct {
 	state         protoimpl.MessageState
@@ -1163,12 +4369,20 @@ type Params struct {
 	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 	// whitelisted_deployers is the address of the accounts permitted to deploy the Custom Precompiled Contracts
 	WhitelistedDeployers []string `protobuf:"bytes,2,rep,name=whitelisted_deployers,json=whitelistedDeployers,proto3" json:"whitelisted_deployers,omitempty"`
+	// method_gas_table overrides the gas required by the methods of the Custom Precompiled Contracts.
+	// Methods those are not in the table require the built-in gas.
+	MethodGasTable []*CpcMethodGas `protobuf:"bytes,3,rep,name=method_gas_table,json=methodGasTable,proto3" json:"method_gas_table,omitempty"`
+	// dynamic_gas_enabled defines if the SDK gas consumed inside the method executors,
+	// which exceeds the gas required by the method, is charged additionally to the transaction.
+	DynamicGasEnabled bool `protobuf:"varint,4,opt,name=dynamic_gas_enabled,json=dynamicGasEnabled,proto3" json:"dynamic_gas_enabled,omitempty"`
+	// erc20_auto_deploy defines the automatic deployment of the ERC20 Custom Precompiled Contracts for the bank denoms.
+	Erc20AutoDeploy *Erc20AutoDeploy `protobuf:"bytes,5,opt,name=erc20_auto_deploy,json=erc20AutoDeploy,proto3" json:"erc20_auto_deploy,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[1]
+		mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +4396,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_evermint_cpc_v1_genesis_proto_rawDescGZIP(), []int{1}
+	return file_evermint_cpc_v1_genesis_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Params) GetProtocolVersion() uint32 {
@@ -1199,6 +4413,204 @@ func (x *Params) GetWhitelistedDeployers() []string {
 	return nil
 }
 
+func (x *Params) GetMethodGasTable() []*CpcMethodGas {
+	if x != nil {
+		return x.MethodGasTable
+	}
+	return nil
+}
+
+func (x *Params) GetDynamicGasEnabled() bool {
+	if x != nil {
+		return x.DynamicGasEnabled
+	}
+	return false
+}
+
+func (x *Params) GetErc20AutoDeploy() *Erc20AutoDeploy {
+	if x != nil {
+		return x.Erc20AutoDeploy
+	}
+	return nil
+}
+
+// Erc20AutoDeploy defines the automatic deployment of the ERC20 Custom Precompiled Contracts for the bank denoms.
+// Name, symbol and decimals of the contracts are taken from the denom metadata of `x/bank`,
+// denoms without metadata are not deployed.
+type Erc20AutoDeploy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// on_ibc_receive enables the deployment when IBC vouchers of a new denom are received.
+	OnIbcReceive bool `protobuf:"varint,1,opt,name=on_ibc_receive,json=onIbcReceive,proto3" json:"on_ibc_receive,omitempty"`
+	// on_denom_metadata_set enables the deployment when the denom metadata is set into `x/bank`.
+	OnDenomMetadataSet bool `protobuf:"varint,2,opt,name=on_denom_metadata_set,json=onDenomMetadataSet,proto3" json:"on_denom_metadata_set,omitempty"`
+	// allowed_denoms is the patterns of the denoms those can be deployed, empty means all.
+	// A pattern is either an exact denom or a prefix followed by `*`, like `ibc/*`.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// denied_denoms is the patterns of the denoms those can not be deployed, takes precedence over the allowed_denoms.
+	DeniedDenoms []string `protobuf:"bytes,4,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+}
+
+func (x *Erc20AutoDeploy) Reset() {
+	*x = Erc20AutoDeploy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Erc20AutoDeploy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Erc20AutoDeploy) ProtoMessage() {}
+
+// Deprecated: Use Erc20AutoDeploy.ProtoReflect.Descriptor instead.
+func (*Erc20AutoDeploy) Descriptor() ([]byte, []int) {
+	return file_evermint_cpc_v1_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Erc20AutoDeploy) GetOnIbcReceive() bool {
+	if x != nil {
+		return x.OnIbcReceive
+	}
+	return false
+}
+
+func (x *Erc20AutoDeploy) GetOnDenomMetadataSet() bool {
+	if x != nil {
+		return x.OnDenomMetadataSet
+	}
+	return false
+}
+
+func (x *Erc20AutoDeploy) GetAllowedDenoms() []string {
+	if x != nil {
+		return x.AllowedDenoms
+	}
+	return nil
+}
+
+func (x *Erc20AutoDeploy) GetDeniedDenoms() []string {
+	if x != nil {
+		return x.DeniedDenoms
+	}
+	return nil
+}
+
+// CpcMethodGas defines the gas required by a method of a Custom Precompiled Contract type.
+type CpcMethodGas struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract_type is the type of the Custom Precompiled Contract.
+	ContractType uint32 `protobuf:"varint,1,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`
+	// method_selector is the 0x-prefixed lowercase hex of the 4 bytes method selector.
+	MethodSelector string `protobuf:"bytes,2,opt,name=method_selector,json=methodSelector,proto3" json:"method_selector,omitempty"`
+	// gas is the gas required by the method.
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (x *CpcMethodGas) Reset() {
+	*x = CpcMethodGas{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpcMethodGas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpcMethodGas) ProtoMessage() {}
+
+// Deprecated: Use CpcMethodGas.ProtoReflect.Descriptor instead.
+func (*CpcMethodGas) Descriptor() ([]byte, []int) {
+	return file_evermint_cpc_v1_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CpcMethodGas) GetContractType() uint32 {
+	if x != nil {
+		return x.ContractType
+	}
+	return 0
+}
+
+func (x *CpcMethodGas) GetMethodSelector() string {
+	if x != nil {
+		return x.MethodSelector
+	}
+	return ""
+}
+
+func (x *CpcMethodGas) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+// Erc20CpcPermitNonce defines an EIP-2612 permit nonce record of the ERC20 custom precompiled contract.
+type Erc20CpcPermitNonce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// contract is the hex address of the ERC20 custom precompiled contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// owner is the hex address of the token owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// nonce is the current permit nonce of the owner.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *Erc20CpcPermitNonce) Reset() {
+	*x = Erc20CpcPermitNonce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evermint_cpc_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Erc20CpcPermitNonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Erc20CpcPermitNonce) ProtoMessage() {}
+
+// Deprecated: Use Erc20CpcPermitNonce.ProtoReflect.Descriptor instead.
+func (*Erc20CpcPermitNonce) Descriptor() ([]byte, []int) {
+	return file_evermint_cpc_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Erc20CpcPermitNonce) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *Erc20CpcPermitNonce) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Erc20CpcPermitNonce) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
 var File_evermint_cpc_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_evermint_cpc_v1_genesis_proto_rawDesc = []byte{
@@ -1206,24 +4618,94 @@ var file_evermint_cpc_v1_genesis_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0f, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e,
-	0x0a, 0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x6e,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x36,
-	0x0a, 0x17, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x15, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x68, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x77,
-	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f,
-	0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x77, 0x68, 0x69, 0x74,
-	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
+	0x2f, 0x63, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x03, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x45, 0x72, 0x63, 0x32, 0x30, 0x4e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x15, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x63, 0x0a, 0x12, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x53,
+	0x0a, 0x10, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x63, 0x32, 0x30,
+	0x43, 0x70, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x63, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x70, 0x63, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x73, 0x22, 0x96, 0x01, 0x0a, 0x11, 0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x70, 0x63, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbb, 0x02, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x15, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x72, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x70, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x47, 0x61, 0x73, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x47, 0x61, 0x73, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x47, 0x61, 0x73, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x11, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x63, 0x32, 0x30, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x41, 0x75,
+	0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x45, 0x72, 0x63,
+	0x32, 0x30, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x24, 0x0a, 0x0e,
+	0x6f, 0x6e, 0x5f, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x6e, 0x49, 0x62, 0x63, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x53, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x22, 0x6e, 0x0a, 0x0c, 0x43, 0x70, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x47, 0x61,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61,
+	0x73, 0x22, 0x5d, 0x0a, 0x13, 0x45, 0x72, 0x63, 0x32, 0x30, 0x43, 0x70, 0x63, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
 	0x42, 0xa9, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e,
 	0x74, 0x2e, 0x63, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
 	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
@@ -1250,18 +4732,28 @@ func file_evermint_cpc_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_evermint_cpc_v1_genesis_proto_rawDescData
 }
 
-var file_evermint_cpc_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_evermint_cpc_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_evermint_cpc_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: evermint.cpc.v1.GenesisState
-	(*Params)(nil),       // 1: evermint.cpc.v1.Params
+	(*GenesisState)(nil),                  // 0: evermint.cpc.v1.GenesisState
+	(*Erc20CpcAllowance)(nil),             // 1: evermint.cpc.v1.Erc20CpcAllowance
+	(*Params)(nil),                        // 2: evermint.cpc.v1.Params
+	(*Erc20AutoDeploy)(nil),               // 3: evermint.cpc.v1.Erc20AutoDeploy
+	(*CpcMethodGas)(nil),                  // 4: evermint.cpc.v1.CpcMethodGas
+	(*Erc20CpcPermitNonce)(nil),           // 5: evermint.cpc.v1.Erc20CpcPermitNonce
+	(*CustomPrecompiledContractMeta)(nil), // 6: evermint.cpc.v1.CustomPrecompiledContractMeta
 }
 var file_evermint_cpc_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: evermint.cpc.v1.GenesisState.params:type_name -> evermint.cpc.v1.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: evermint.cpc.v1.GenesisState.params:type_name -> evermint.cpc.v1.Params
+	6, // 1: evermint.cpc.v1.GenesisState.deployed_contracts:type_name -> evermint.cpc.v1.CustomPrecompiledContractMeta
+	1, // 2: evermint.cpc.v1.GenesisState.erc20_allowances:type_name -> evermint.cpc.v1.Erc20CpcAllowance
+	5, // 3: evermint.cpc.v1.GenesisState.erc20_permit_nonces:type_name -> evermint.cpc.v1.Erc20CpcPermitNonce
+	4, // 4: evermint.cpc.v1.Params.method_gas_table:type_name -> evermint.cpc.v1.CpcMethodGas
+	3, // 5: evermint.cpc.v1.Params.erc20_auto_deploy:type_name -> evermint.cpc.v1.Erc20AutoDeploy
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_evermint_cpc_v1_genesis_proto_init() }
@@ -1269,6 +4761,7 @@ func file_evermint_cpc_v1_genesis_proto_init() {
 	if File_evermint_cpc_v1_genesis_proto != nil {
 		return
 	}
+	file_evermint_cpc_v1_precompiles_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_evermint_cpc_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1283,6 +4776,18 @@ func file_evermint_cpc_v1_genesis_proto_init() {
 			}
 		}
 		file_evermint_cpc_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Erc20CpcAllowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evermint_cpc_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -1294,6 +4799,42 @@ func file_evermint_cpc_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_evermint_cpc_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Erc20AutoDeploy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evermint_cpc_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpcMethodGas); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evermint_cpc_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Erc20CpcPermitNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1301,7 +4842,7 @@ func file_evermint_cpc_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evermint_cpc_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName          = "/evermint.cpc.v1.Msg/UpdateParams"
-	Msg_DeployErc20Contract_FullMethodName   = "/evermint.cpc.v1.Msg/DeployErc20Contract"
-	Msg_DeployStakingContract_FullMethodName = "/evermint.cpc.v1.Msg/DeployStakingContract"
+	Msg_UpdateParams_FullMethodName                     = "/evermint.cpc.v1.Msg/UpdateParams"
+	Msg_DeployErc20Contract_FullMethodName              = "/evermint.cpc.v1.Msg/DeployErc20Contract"
+	Msg_DeployStakingContract_FullMethodName            = "/evermint.cpc.v1.Msg/DeployStakingContract"
+	Msg_DeployIbcTransferContract_FullMethodName        = "/evermint.cpc.v1.Msg/DeployIbcTransferContract"
+	Msg_DisableCustomPrecompiledContract_FullMethodName = "/evermint.cpc.v1.Msg/DisableCustomPrecompiledContract"
+	Msg_EnableCustomPrecompiledContract_FullMethodName  = "/evermint.cpc.v1.Msg/EnableCustomPrecompiledContract"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +38,14 @@ type MsgClient interface {
 	DeployErc20Contract(ctx context.Context, in *MsgDeployErc20ContractRequest, opts ...grpc.CallOption) (*MsgDeployErc20ContractResponse, error)
 	// DeployStakingContract defines a method deploying a new staking contract.
 	DeployStakingContract(ctx context.Context, in *MsgDeployStakingContractRequest, opts ...grpc.CallOption) (*MsgDeployStakingContractResponse, error)
+	// DeployIbcTransferContract defines a method deploying a new IBC transfer contract.
+	DeployIbcTransferContract(ctx context.Context, in *MsgDeployIbcTransferContractRequest, opts ...grpc.CallOption) (*MsgDeployIbcTransferContractResponse, error)
+	// DisableCustomPrecompiledContract defines a governance operation for disabling a custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DisableCustomPrecompiledContract(ctx context.Context, in *MsgDisableCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgDisableCustomPrecompiledContractResponse, error)
+	// EnableCustomPrecompiledContract defines a governance operation for re-enabling a disabled custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	EnableCustomPrecompiledContract(ctx context.Context, in *MsgEnableCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgEnableCustomPrecompiledContractResponse, error)
 }
 
 type msgClient struct {
@@ -72,6 +83,33 @@ func (c *msgClient) DeployStakingContract(ctx context.Context, in *MsgDeployStak
 	return out, nil
 }
 
+func (c *msgClient) DeployIbcTransferContract(ctx context.Context, in *MsgDeployIbcTransferContractRequest, opts ...grpc.CallOption) (*MsgDeployIbcTransferContractResponse, error) {
+	out := new(MsgDeployIbcTransferContractResponse)
+	err := c.cc.Invoke(ctx, Msg_DeployIbcTransferContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableCustomPrecompiledContract(ctx context.Context, in *MsgDisableCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgDisableCustomPrecompiledContractResponse, error) {
+	out := new(MsgDisableCustomPrecompiledContractResponse)
+	err := c.cc.Invoke(ctx, Msg_DisableCustomPrecompiledContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableCustomPrecompiledContract(ctx context.Context, in *MsgEnableCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgEnableCustomPrecompiledContractResponse, error) {
+	out := new(MsgEnableCustomPrecompiledContractResponse)
+	err := c.cc.Invoke(ctx, Msg_EnableCustomPrecompiledContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -83,6 +121,14 @@ type MsgServer interface {
 	DeployErc20Contract(context.Context, *MsgDeployErc20ContractRequest) (*MsgDeployErc20ContractResponse, error)
 	// DeployStakingContract defines a method deploying a new staking contract.
 	DeployStakingContract(context.Context, *MsgDeployStakingContractRequest) (*MsgDeployStakingContractResponse, error)
+	// DeployIbcTransferContract defines a method deploying a new IBC transfer contract.
+	DeployIbcTransferContract(context.Context, *MsgDeployIbcTransferContractRequest) (*MsgDeployIbcTransferContractResponse, error)
+	// DisableCustomPrecompiledContract defines a governance operation for disabling a custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DisableCustomPrecompiledContract(context.Context, *MsgDisableCustomPrecompiledContract) (*MsgDisableCustomPrecompiledContractResponse, error)
+	// EnableCustomPrecompiledContract defines a governance operation for re-enabling a disabled custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	EnableCustomPrecompiledContract(context.Context, *MsgEnableCustomPrecompiledContract) (*MsgEnableCustomPrecompiledContractResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -99,6 +145,15 @@ func (UnimplementedMsgServer) DeployErc20Contract(context.Context, *MsgDeployErc
 func (UnimplementedMsgServer) DeployStakingContract(context.Context, *MsgDeployStakingContractRequest) (*MsgDeployStakingContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployStakingContract not implemented")
 }
+func (UnimplementedMsgServer) DeployIbcTransferContract(context.Context, *MsgDeployIbcTransferContractRequest) (*MsgDeployIbcTransferContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployIbcTransferContract not implemented")
+}
+func (UnimplementedMsgServer) DisableCustomPrecompiledContract(context.Context, *MsgDisableCustomPrecompiledContract) (*MsgDisableCustomPrecompiledContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableCustomPrecompiledContract not implemented")
+}
+func (UnimplementedMsgServer) EnableCustomPrecompiledContract(context.Context, *MsgEnableCustomPrecompiledContract) (*MsgEnableCustomPrecompiledContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableCustomPrecompiledContract not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +221,60 @@ func _Msg_DeployStakingContract_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeployIbcTransferContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeployIbcTransferContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeployIbcTransferContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeployIbcTransferContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeployIbcTransferContract(ctx, req.(*MsgDeployIbcTransferContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableCustomPrecompiledContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableCustomPrecompiledContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableCustomPrecompiledContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisableCustomPrecompiledContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableCustomPrecompiledContract(ctx, req.(*MsgDisableCustomPrecompiledContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableCustomPrecompiledContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableCustomPrecompiledContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableCustomPrecompiledContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EnableCustomPrecompiledContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableCustomPrecompiledContract(ctx, req.(*MsgEnableCustomPrecompiledContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +294,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeployStakingContract",
 			Handler:    _Msg_DeployStakingContract_Handler,
 		},
+		{
+			MethodName: "DeployIbcTransferContract",
+			Handler:    _Msg_DeployIbcTransferContract_Handler,
+		},
+		{
+			MethodName: "DisableCustomPrecompiledContract",
+			Handler:    _Msg_DisableCustomPrecompiledContract_Handler,
+		},
+		{
+			MethodName: "EnableCustomPrecompiledContract",
+			Handler:    _Msg_EnableCustomPrecompiledContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evermint/cpc/v1/tx.proto",
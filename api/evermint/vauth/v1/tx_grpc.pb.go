@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Msg_SubmitProofExternalOwnedAccount_FullMethodName = "/evermint.vauth.v1.Msg/SubmitProofExternalOwnedAccount"
+	Msg_RevokeProofExternalOwnedAccount_FullMethodName = "/evermint.vauth.v1.Msg/RevokeProofExternalOwnedAccount"
+	Msg_UpdateParams_FullMethodName                    = "/evermint.vauth.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +30,11 @@ const (
 type MsgClient interface {
 	// SubmitProofExternalOwnedAccount submit proof that an account is external owned account (EOA)
 	SubmitProofExternalOwnedAccount(ctx context.Context, in *MsgSubmitProofExternalOwnedAccount, opts ...grpc.CallOption) (*MsgSubmitProofExternalOwnedAccountResponse, error)
+	// RevokeProofExternalOwnedAccount revokes the proof of an account, signed by the account itself
+	RevokeProofExternalOwnedAccount(ctx context.Context, in *MsgRevokeProofExternalOwnedAccount, opts ...grpc.CallOption) (*MsgRevokeProofExternalOwnedAccountResponse, error)
+	// UpdateParams defined a governance operation for updating the x/vauth module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +54,35 @@ func (c *msgClient) SubmitProofExternalOwnedAccount(ctx context.Context, in *Msg
 	return out, nil
 }
 
+func (c *msgClient) RevokeProofExternalOwnedAccount(ctx context.Context, in *MsgRevokeProofExternalOwnedAccount, opts ...grpc.CallOption) (*MsgRevokeProofExternalOwnedAccountResponse, error) {
+	out := new(MsgRevokeProofExternalOwnedAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeProofExternalOwnedAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// SubmitProofExternalOwnedAccount submit proof that an account is external owned account (EOA)
 	SubmitProofExternalOwnedAccount(context.Context, *MsgSubmitProofExternalOwnedAccount) (*MsgSubmitProofExternalOwnedAccountResponse, error)
+	// RevokeProofExternalOwnedAccount revokes the proof of an account, signed by the account itself
+	RevokeProofExternalOwnedAccount(context.Context, *MsgRevokeProofExternalOwnedAccount) (*MsgRevokeProofExternalOwnedAccountResponse, error)
+	// UpdateParams defined a governance operation for updating the x/vauth module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +93,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) SubmitProofExternalOwnedAccount(context.Context, *MsgSubmitProofExternalOwnedAccount) (*MsgSubmitProofExternalOwnedAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofExternalOwnedAccount not implemented")
 }
+func (UnimplementedMsgServer) RevokeProofExternalOwnedAccount(context.Context, *MsgRevokeProofExternalOwnedAccount) (*MsgRevokeProofExternalOwnedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeProofExternalOwnedAccount not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +130,42 @@ func _Msg_SubmitProofExternalOwnedAccount_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeProofExternalOwnedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeProofExternalOwnedAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeProofExternalOwnedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeProofExternalOwnedAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeProofExternalOwnedAccount(ctx, req.(*MsgRevokeProofExternalOwnedAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +177,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitProofExternalOwnedAccount",
 			Handler:    _Msg_SubmitProofExternalOwnedAccount_Handler,
 		},
+		{
+			MethodName: "RevokeProofExternalOwnedAccount",
+			Handler:    _Msg_RevokeProofExternalOwnedAccount_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evermint/vauth/v1/tx.proto",
@@ -2,6 +2,7 @@
 package vauthv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -14,10 +15,12 @@ import (
 )
 
 var (
-	md_ProofExternalOwnedAccount           protoreflect.MessageDescriptor
-	fd_ProofExternalOwnedAccount_account   protoreflect.FieldDescriptor
-	fd_ProofExternalOwnedAccount_hash      protoreflect.FieldDescriptor
-	fd_ProofExternalOwnedAccount_signature protoreflect.FieldDescriptor
+	md_ProofExternalOwnedAccount               protoreflect.MessageDescriptor
+	fd_ProofExternalOwnedAccount_account       protoreflect.FieldDescriptor
+	fd_ProofExternalOwnedAccount_hash          protoreflect.FieldDescriptor
+	fd_ProofExternalOwnedAccount_signature     protoreflect.FieldDescriptor
+	fd_ProofExternalOwnedAccount_expiry_height protoreflect.FieldDescriptor
+	fd_ProofExternalOwnedAccount_kind          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +29,8 @@ func init() {
 	fd_ProofExternalOwnedAccount_account = md_ProofExternalOwnedAccount.Fields().ByName("account")
 	fd_ProofExternalOwnedAccount_hash = md_ProofExternalOwnedAccount.Fields().ByName("hash")
 	fd_ProofExternalOwnedAccount_signature = md_ProofExternalOwnedAccount.Fields().ByName("signature")
+	fd_ProofExternalOwnedAccount_expiry_height = md_ProofExternalOwnedAccount.Fields().ByName("expiry_height")
+	fd_ProofExternalOwnedAccount_kind = md_ProofExternalOwnedAccount.Fields().ByName("kind")
 }
 
 var _ protoreflect.Message = (*fastReflection_ProofExternalOwnedAccount)(nil)
@@ -111,6 +116,18 @@ func (x *fastReflection_ProofExternalOwnedAccount) Range(f func(protoreflect.Fie
 			return
 		}
 	}
+	if x.ExpiryHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpiryHeight)
+		if !f(fd_ProofExternalOwnedAccount_expiry_height, value) {
+			return
+		}
+	}
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_ProofExternalOwnedAccount_kind, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -132,6 +149,10 @@ func (x *fastReflection_ProofExternalOwnedAccount) Has(fd protoreflect.FieldDesc
 		return x.Hash != ""
 	case "evermint.vauth.v1.ProofExternalOwnedAccount.signature":
 		return x.Signature != ""
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.expiry_height":
+		return x.ExpiryHeight != uint64(0)
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.kind":
+		return x.Kind != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.ProofExternalOwnedAccount"))
@@ -154,6 +175,10 @@ func (x *fastReflection_ProofExternalOwnedAccount) Clear(fd protoreflect.FieldDe
 		x.Hash = ""
 	case "evermint.vauth.v1.ProofExternalOwnedAccount.signature":
 		x.Signature = ""
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.expiry_height":
+		x.ExpiryHeight = uint64(0)
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.kind":
+		x.Kind = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.ProofExternalOwnedAccount"))
@@ -179,6 +204,12 @@ func (x *fastReflection_ProofExternalOwnedAccount) Get(descriptor protoreflect.F
 	case "evermint.vauth.v1.ProofExternalOwnedAccount.signature":
 		value := x.Signature
 		return protoreflect.ValueOfString(value)
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfUint64(value)
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.ProofExternalOwnedAccount"))
@@ -205,6 +236,10 @@ func (x *fastReflection_ProofExternalOwnedAccount) Set(fd protoreflect.FieldDesc
 		x.Hash = value.Interface().(string)
 	case "evermint.vauth.v1.ProofExternalOwnedAccount.signature":
 		x.Signature = value.Interface().(string)
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.expiry_height":
+		x.ExpiryHeight = value.Uint()
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.kind":
+		x.Kind = (ProofKind)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.ProofExternalOwnedAccount"))
@@ -231,6 +266,10 @@ func (x *fastReflection_ProofExternalOwnedAccount) Mutable(fd protoreflect.Field
 		panic(fmt.Errorf("field hash of message evermint.vauth.v1.ProofExternalOwnedAccount is not mutable"))
 	case "evermint.vauth.v1.ProofExternalOwnedAccount.signature":
 		panic(fmt.Errorf("field signature of message evermint.vauth.v1.ProofExternalOwnedAccount is not mutable"))
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message evermint.vauth.v1.ProofExternalOwnedAccount is not mutable"))
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.kind":
+		panic(fmt.Errorf("field kind of message evermint.vauth.v1.ProofExternalOwnedAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.ProofExternalOwnedAccount"))
@@ -250,6 +289,10 @@ func (x *fastReflection_ProofExternalOwnedAccount) NewField(fd protoreflect.Fiel
 		return protoreflect.ValueOfString("")
 	case "evermint.vauth.v1.ProofExternalOwnedAccount.signature":
 		return protoreflect.ValueOfString("")
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.expiry_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "evermint.vauth.v1.ProofExternalOwnedAccount.kind":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.ProofExternalOwnedAccount"))
@@ -331,6 +374,12 @@ func (x *fastReflection_ProofExternalOwnedAccount) ProtoMethods() *protoiface.Me
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if x.Kind != 0 {
+			n += 1 + runtime.Sov(uint64(x.Kind))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -360,6 +409,16 @@ func (x *fastReflection_ProofExternalOwnedAccount) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Kind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Kind))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.Signature) > 0 {
 			i -= len(x.Signature)
 			copy(dAtA[i:], x.Signature)
@@ -526,6 +585,44 @@ func (x *fastReflection_ProofExternalOwnedAccount) ProtoMethods() *protoiface.Me
 				}
 				x.Signature = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				x.Kind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Kind |= ProofKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -561,133 +658,1585 @@ func (x *fastReflection_ProofExternalOwnedAccount) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: evermint/vauth/v1/vauth.proto
+var _ protoreflect.List = (*_Params_4_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+type _Params_4_list struct {
+	list *[]*EoaProofRule
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EoaProofRule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EoaProofRule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(EoaProofRule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(EoaProofRule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                            protoreflect.MessageDescriptor
+	fd_Params_submit_proof_fee           protoreflect.FieldDescriptor
+	fd_Params_fee_destination            protoreflect.FieldDescriptor
+	fd_Params_exempt_fee_self_submission protoreflect.FieldDescriptor
+	fd_Params_eoa_proof_rules            protoreflect.FieldDescriptor
 )
 
-// ProofExternalOwnedAccount store the proof that account is external owned account (EOA)
-type ProofExternalOwnedAccount struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_evermint_vauth_v1_vauth_proto_init()
+	md_Params = File_evermint_vauth_v1_vauth_proto.Messages().ByName("Params")
+	fd_Params_submit_proof_fee = md_Params.Fields().ByName("submit_proof_fee")
+	fd_Params_fee_destination = md_Params.Fields().ByName("fee_destination")
+	fd_Params_exempt_fee_self_submission = md_Params.Fields().ByName("exempt_fee_self_submission")
+	fd_Params_eoa_proof_rules = md_Params.Fields().ByName("eoa_proof_rules")
+}
 
-	// account is the cosmos bech32 address of the account that has proof
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// hash is the keccak256 of the message that was signed on
-	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	// signature is the signed message using private key
-	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
 }
 
-func (x *ProofExternalOwnedAccount) Reset() {
-	*x = ProofExternalOwnedAccount{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_evermint_vauth_v1_vauth_proto_msgTypes[0]
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_evermint_vauth_v1_vauth_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *ProofExternalOwnedAccount) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
-func (*ProofExternalOwnedAccount) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
 
-// Deprecated: Use ProofExternalOwnedAccount.ProtoReflect.Descriptor instead.
-func (*ProofExternalOwnedAccount) Descriptor() ([]byte, []int) {
-	return file_evermint_vauth_v1_vauth_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
 }
 
-func (x *ProofExternalOwnedAccount) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
 
-func (x *ProofExternalOwnedAccount) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
 }
 
-func (x *ProofExternalOwnedAccount) GetSignature() string {
-	if x != nil {
-		return x.Signature
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SubmitProofFee != nil {
+		value := protoreflect.ValueOfMessage(x.SubmitProofFee.ProtoReflect())
+		if !f(fd_Params_submit_proof_fee, value) {
+			return
+		}
+	}
+	if x.FeeDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.FeeDestination))
+		if !f(fd_Params_fee_destination, value) {
+			return
+		}
+	}
+	if x.ExemptFeeSelfSubmission != false {
+		value := protoreflect.ValueOfBool(x.ExemptFeeSelfSubmission)
+		if !f(fd_Params_exempt_fee_self_submission, value) {
+			return
+		}
+	}
+	if len(x.EoaProofRules) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.EoaProofRules})
+		if !f(fd_Params_eoa_proof_rules, value) {
+			return
+		}
 	}
-	return ""
 }
 
-var File_evermint_vauth_v1_vauth_proto protoreflect.FileDescriptor
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.Params.submit_proof_fee":
+		return x.SubmitProofFee != nil
+	case "evermint.vauth.v1.Params.fee_destination":
+		return x.FeeDestination != 0
+	case "evermint.vauth.v1.Params.exempt_fee_self_submission":
+		return x.ExemptFeeSelfSubmission != false
+	case "evermint.vauth.v1.Params.eoa_proof_rules":
+		return len(x.EoaProofRules) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
 
-var file_evermint_vauth_v1_vauth_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x61, 0x75, 0x74, 0x68,
-	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x11, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x42, 0xb5, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x74, 0x2e, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x56, 0x61, 0x75,
-	0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x72,
-	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x61,
-	0x75, 0x74, 0x68, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x56, 0x58, 0xaa, 0x02, 0x11, 0x45, 0x76,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x11, 0x45, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x61, 0x75, 0x74, 0x68,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x45, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x56,
-	0x61, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x45, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a,
-	0x56, 0x61, 0x75, 0x74, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.Params.submit_proof_fee":
+		x.SubmitProofFee = nil
+	case "evermint.vauth.v1.Params.fee_destination":
+		x.FeeDestination = 0
+	case "evermint.vauth.v1.Params.exempt_fee_self_submission":
+		x.ExemptFeeSelfSubmission = false
+	case "evermint.vauth.v1.Params.eoa_proof_rules":
+		x.EoaProofRules = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.Params does not contain field %s", fd.FullName()))
+	}
 }
 
-var (
-	file_evermint_vauth_v1_vauth_proto_rawDescOnce sync.Once
-	file_evermint_vauth_v1_vauth_proto_rawDescData = file_evermint_vauth_v1_vauth_proto_rawDesc
-)
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evermint.vauth.v1.Params.submit_proof_fee":
+		value := x.SubmitProofFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "evermint.vauth.v1.Params.fee_destination":
+		value := x.FeeDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "evermint.vauth.v1.Params.exempt_fee_self_submission":
+		value := x.ExemptFeeSelfSubmission
+		return protoreflect.ValueOfBool(value)
+	case "evermint.vauth.v1.Params.eoa_proof_rules":
+		if len(x.EoaProofRules) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.EoaProofRules}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
 
-func file_evermint_vauth_v1_vauth_proto_rawDescGZIP() []byte {
-	file_evermint_vauth_v1_vauth_proto_rawDescOnce.Do(func() {
-		file_evermint_vauth_v1_vauth_proto_rawDescData = protoimpl.X.CompressGZIP(file_evermint_vauth_v1_vauth_proto_rawDescData)
-	})
-	return file_evermint_vauth_v1_vauth_proto_rawDescData
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.Params.submit_proof_fee":
+		x.SubmitProofFee = value.Message().Interface().(*v1beta1.Coin)
+	case "evermint.vauth.v1.Params.fee_destination":
+		x.FeeDestination = (FeeDestination)(value.Enum())
+	case "evermint.vauth.v1.Params.exempt_fee_self_submission":
+		x.ExemptFeeSelfSubmission = value.Bool()
+	case "evermint.vauth.v1.Params.eoa_proof_rules":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.EoaProofRules = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.Params does not contain field %s", fd.FullName()))
+	}
 }
 
-var file_evermint_vauth_v1_vauth_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_evermint_vauth_v1_vauth_proto_goTypes = []interface{}{
-	(*ProofExternalOwnedAccount)(nil), // 0: evermint.vauth.v1.ProofExternalOwnedAccount
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.Params.submit_proof_fee":
+		if x.SubmitProofFee == nil {
+			x.SubmitProofFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SubmitProofFee.ProtoReflect())
+	case "evermint.vauth.v1.Params.eoa_proof_rules":
+		if x.EoaProofRules == nil {
+			x.EoaProofRules = []*EoaProofRule{}
+		}
+		value := &_Params_4_list{list: &x.EoaProofRules}
+		return protoreflect.ValueOfList(value)
+	case "evermint.vauth.v1.Params.fee_destination":
+		panic(fmt.Errorf("field fee_destination of message evermint.vauth.v1.Params is not mutable"))
+	case "evermint.vauth.v1.Params.exempt_fee_self_submission":
+		panic(fmt.Errorf("field exempt_fee_self_submission of message evermint.vauth.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.Params does not contain field %s", fd.FullName()))
+	}
 }
-var file_evermint_vauth_v1_vauth_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.Params.submit_proof_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "evermint.vauth.v1.Params.fee_destination":
+		return protoreflect.ValueOfEnum(0)
+	case "evermint.vauth.v1.Params.exempt_fee_self_submission":
+		return protoreflect.ValueOfBool(false)
+	case "evermint.vauth.v1.Params.eoa_proof_rules":
+		list := []*EoaProofRule{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.Params"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.Params does not contain field %s", fd.FullName()))
+	}
 }
 
-func init() { file_evermint_vauth_v1_vauth_proto_init() }
-func file_evermint_vauth_v1_vauth_proto_init() {
-	if File_evermint_vauth_v1_vauth_proto != nil {
-		return
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evermint.vauth.v1.Params", d.FullName()))
 	}
-	if !protoimpl.UnsafeEnabled {
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.SubmitProofFee != nil {
+			l = options.Size(x.SubmitProofFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeDestination))
+		}
+		if x.ExemptFeeSelfSubmission {
+			n += 2
+		}
+		if len(x.EoaProofRules) > 0 {
+			for _, e := range x.EoaProofRules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EoaProofRules) > 0 {
+			for iNdEx := len(x.EoaProofRules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EoaProofRules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.ExemptFeeSelfSubmission {
+			i--
+			if x.ExemptFeeSelfSubmission {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.FeeDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeDestination))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.SubmitProofFee != nil {
+			encoded, err := options.Marshal(x.SubmitProofFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitProofFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SubmitProofFee == nil {
+					x.SubmitProofFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitProofFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+				}
+				x.FeeDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeDestination |= FeeDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExemptFeeSelfSubmission", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ExemptFeeSelfSubmission = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EoaProofRules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EoaProofRules = append(x.EoaProofRules, &EoaProofRule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EoaProofRules[len(x.EoaProofRules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EoaProofRule               protoreflect.MessageDescriptor
+	fd_EoaProofRule_msg_type_url  protoreflect.FieldDescriptor
+	fd_EoaProofRule_address_field protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_evermint_vauth_v1_vauth_proto_init()
+	md_EoaProofRule = File_evermint_vauth_v1_vauth_proto.Messages().ByName("EoaProofRule")
+	fd_EoaProofRule_msg_type_url = md_EoaProofRule.Fields().ByName("msg_type_url")
+	fd_EoaProofRule_address_field = md_EoaProofRule.Fields().ByName("address_field")
+}
+
+var _ protoreflect.Message = (*fastReflection_EoaProofRule)(nil)
+
+type fastReflection_EoaProofRule EoaProofRule
+
+func (x *EoaProofRule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EoaProofRule)(x)
+}
+
+func (x *EoaProofRule) slowProtoReflect() protoreflect.Message {
+	mi := &file_evermint_vauth_v1_vauth_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EoaProofRule_messageType fastReflection_EoaProofRule_messageType
+var _ protoreflect.MessageType = fastReflection_EoaProofRule_messageType{}
+
+type fastReflection_EoaProofRule_messageType struct{}
+
+func (x fastReflection_EoaProofRule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EoaProofRule)(nil)
+}
+func (x fastReflection_EoaProofRule_messageType) New() protoreflect.Message {
+	return new(fastReflection_EoaProofRule)
+}
+func (x fastReflection_EoaProofRule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EoaProofRule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EoaProofRule) Descriptor() protoreflect.MessageDescriptor {
+	return md_EoaProofRule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EoaProofRule) Type() protoreflect.MessageType {
+	return _fastReflection_EoaProofRule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EoaProofRule) New() protoreflect.Message {
+	return new(fastReflection_EoaProofRule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EoaProofRule) Interface() protoreflect.ProtoMessage {
+	return (*EoaProofRule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EoaProofRule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_EoaProofRule_msg_type_url, value) {
+			return
+		}
+	}
+	if x.AddressField != "" {
+		value := protoreflect.ValueOfString(x.AddressField)
+		if !f(fd_EoaProofRule_address_field, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EoaProofRule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.EoaProofRule.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "evermint.vauth.v1.EoaProofRule.address_field":
+		return x.AddressField != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.EoaProofRule"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.EoaProofRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EoaProofRule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.EoaProofRule.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "evermint.vauth.v1.EoaProofRule.address_field":
+		x.AddressField = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.EoaProofRule"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.EoaProofRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EoaProofRule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evermint.vauth.v1.EoaProofRule.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "evermint.vauth.v1.EoaProofRule.address_field":
+		value := x.AddressField
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.EoaProofRule"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.EoaProofRule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EoaProofRule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.EoaProofRule.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "evermint.vauth.v1.EoaProofRule.address_field":
+		x.AddressField = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.EoaProofRule"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.EoaProofRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EoaProofRule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.EoaProofRule.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message evermint.vauth.v1.EoaProofRule is not mutable"))
+	case "evermint.vauth.v1.EoaProofRule.address_field":
+		panic(fmt.Errorf("field address_field of message evermint.vauth.v1.EoaProofRule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.EoaProofRule"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.EoaProofRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EoaProofRule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evermint.vauth.v1.EoaProofRule.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "evermint.vauth.v1.EoaProofRule.address_field":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evermint.vauth.v1.EoaProofRule"))
+		}
+		panic(fmt.Errorf("message evermint.vauth.v1.EoaProofRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EoaProofRule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evermint.vauth.v1.EoaProofRule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EoaProofRule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EoaProofRule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EoaProofRule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EoaProofRule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EoaProofRule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AddressField)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EoaProofRule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AddressField) > 0 {
+			i -= len(x.AddressField)
+			copy(dAtA[i:], x.AddressField)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AddressField)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EoaProofRule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EoaProofRule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EoaProofRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressField", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressField = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: evermint/vauth/v1/vauth.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProofKind defines how the signature of a proof is verified
+type ProofKind int32
+
+const (
+	// PROOF_KIND_SECP256K1 is the signature signed by the private key of the account, verified by ecrecover
+	ProofKind_PROOF_KIND_SECP256K1 ProofKind = 0
+	// PROOF_KIND_EIP1271 is the signature of a contract wallet,
+	// verified by calling `isValidSignature(bytes32,bytes)` on the account's contract code (EIP-1271)
+	ProofKind_PROOF_KIND_EIP1271 ProofKind = 1
+)
+
+// Enum value maps for ProofKind.
+var (
+	ProofKind_name = map[int32]string{
+		0: "PROOF_KIND_SECP256K1",
+		1: "PROOF_KIND_EIP1271",
+	}
+	ProofKind_value = map[string]int32{
+		"PROOF_KIND_SECP256K1": 0,
+		"PROOF_KIND_EIP1271":   1,
+	}
+)
+
+func (x ProofKind) Enum() *ProofKind {
+	p := new(ProofKind)
+	*p = x
+	return p
+}
+
+func (x ProofKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProofKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_evermint_vauth_v1_vauth_proto_enumTypes[0].Descriptor()
+}
+
+func (ProofKind) Type() protoreflect.EnumType {
+	return &file_evermint_vauth_v1_vauth_proto_enumTypes[0]
+}
+
+func (x ProofKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProofKind.Descriptor instead.
+func (ProofKind) EnumDescriptor() ([]byte, []int) {
+	return file_evermint_vauth_v1_vauth_proto_rawDescGZIP(), []int{0}
+}
+
+// FeeDestination defines where the submission fee goes to
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_UNSPECIFIED is invalid
+	FeeDestination_FEE_DESTINATION_UNSPECIFIED FeeDestination = 0
+	// FEE_DESTINATION_BURN burns the fee
+	FeeDestination_FEE_DESTINATION_BURN FeeDestination = 1
+	// FEE_DESTINATION_COMMUNITY_POOL funds the fee to the community pool
+	FeeDestination_FEE_DESTINATION_COMMUNITY_POOL FeeDestination = 2
+	// FEE_DESTINATION_FEE_COLLECTOR sends the fee to the fee collector, to be distributed like tx fees
+	FeeDestination_FEE_DESTINATION_FEE_COLLECTOR FeeDestination = 3
+)
+
+// Enum value maps for FeeDestination.
+var (
+	FeeDestination_name = map[int32]string{
+		0: "FEE_DESTINATION_UNSPECIFIED",
+		1: "FEE_DESTINATION_BURN",
+		2: "FEE_DESTINATION_COMMUNITY_POOL",
+		3: "FEE_DESTINATION_FEE_COLLECTOR",
+	}
+	FeeDestination_value = map[string]int32{
+		"FEE_DESTINATION_UNSPECIFIED":    0,
+		"FEE_DESTINATION_BURN":           1,
+		"FEE_DESTINATION_COMMUNITY_POOL": 2,
+		"FEE_DESTINATION_FEE_COLLECTOR":  3,
+	}
+)
+
+func (x FeeDestination) Enum() *FeeDestination {
+	p := new(FeeDestination)
+	*p = x
+	return p
+}
+
+func (x FeeDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_evermint_vauth_v1_vauth_proto_enumTypes[1].Descriptor()
+}
+
+func (FeeDestination) Type() protoreflect.EnumType {
+	return &file_evermint_vauth_v1_vauth_proto_enumTypes[1]
+}
+
+func (x FeeDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeDestination.Descriptor instead.
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return file_evermint_vauth_v1_vauth_proto_rawDescGZIP(), []int{1}
+}
+
+// ProofExternalOwnedAccount store the proof that account is external owned account (EOA)
+type ProofExternalOwnedAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account is the cosmos bech32 address of the account that has proof
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// hash is the keccak256 of the message that was signed on
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// signature is the signed message using private key
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// expiry_height is the block height from which the proof is no longer valid, zero means never expire
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// kind is the kind of the proof, defines how the signature was verified
+	Kind ProofKind `protobuf:"varint,5,opt,name=kind,proto3,enum=evermint.vauth.v1.ProofKind" json:"kind,omitempty"`
+}
+
+func (x *ProofExternalOwnedAccount) Reset() {
+	*x = ProofExternalOwnedAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evermint_vauth_v1_vauth_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofExternalOwnedAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofExternalOwnedAccount) ProtoMessage() {}
+
+// Deprecated: Use ProofExternalOwnedAccount.ProtoReflect.Descriptor instead.
+func (*ProofExternalOwnedAccount) Descriptor() ([]byte, []int) {
+	return file_evermint_vauth_v1_vauth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProofExternalOwnedAccount) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *ProofExternalOwnedAccount) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ProofExternalOwnedAccount) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ProofExternalOwnedAccount) GetExpiryHeight() uint64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *ProofExternalOwnedAccount) GetKind() ProofKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ProofKind_PROOF_KIND_SECP256K1
+}
+
+// Params defines the vauth module params
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// submit_proof_fee is the fee charged from the submitter for each submission of proof of external owned account (EOA)
+	SubmitProofFee *v1beta1.Coin `protobuf:"bytes,1,opt,name=submit_proof_fee,json=submitProofFee,proto3" json:"submit_proof_fee,omitempty"`
+	// fee_destination is where the submission fee goes to
+	FeeDestination FeeDestination `protobuf:"varint,2,opt,name=fee_destination,json=feeDestination,proto3,enum=evermint.vauth.v1.FeeDestination" json:"fee_destination,omitempty"`
+	// exempt_fee_self_submission exempts the submission fee when the submitter is the account to prove
+	ExemptFeeSelfSubmission bool `protobuf:"varint,3,opt,name=exempt_fee_self_submission,json=exemptFeeSelfSubmission,proto3" json:"exempt_fee_self_submission,omitempty"`
+	// eoa_proof_rules is the list of rules, each rule requires the account at the address field of the matching message
+	// to have proof of external owned account (EOA), otherwise the transaction will be rejected
+	EoaProofRules []*EoaProofRule `protobuf:"bytes,4,rep,name=eoa_proof_rules,json=eoaProofRules,proto3" json:"eoa_proof_rules,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evermint_vauth_v1_vauth_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_evermint_vauth_v1_vauth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Params) GetSubmitProofFee() *v1beta1.Coin {
+	if x != nil {
+		return x.SubmitProofFee
+	}
+	return nil
+}
+
+func (x *Params) GetFeeDestination() FeeDestination {
+	if x != nil {
+		return x.FeeDestination
+	}
+	return FeeDestination_FEE_DESTINATION_UNSPECIFIED
+}
+
+func (x *Params) GetExemptFeeSelfSubmission() bool {
+	if x != nil {
+		return x.ExemptFeeSelfSubmission
+	}
+	return false
+}
+
+func (x *Params) GetEoaProofRules() []*EoaProofRule {
+	if x != nil {
+		return x.EoaProofRules
+	}
+	return nil
+}
+
+// EoaProofRule defines a message which requires the account at the given address field to have proof of EOA
+type EoaProofRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_type_url is the type url of the message, e.g. `/cosmos.vesting.v1beta1.MsgCreateVestingAccount`
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// address_field is the proto field name of the address to check, e.g. `to_address`.
+	// Nested field is separated by dot, e.g. `grant.grantee`.
+	// Both account address and validator operator address are supported.
+	AddressField string `protobuf:"bytes,2,opt,name=address_field,json=addressField,proto3" json:"address_field,omitempty"`
+}
+
+func (x *EoaProofRule) Reset() {
+	*x = EoaProofRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evermint_vauth_v1_vauth_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EoaProofRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EoaProofRule) ProtoMessage() {}
+
+// Deprecated: Use EoaProofRule.ProtoReflect.Descriptor instead.
+func (*EoaProofRule) Descriptor() ([]byte, []int) {
+	return file_evermint_vauth_v1_vauth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EoaProofRule) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *EoaProofRule) GetAddressField() string {
+	if x != nil {
+		return x.AddressField
+	}
+	return ""
+}
+
+var File_evermint_vauth_v1_vauth_proto protoreflect.FileDescriptor
+
+var file_evermint_vauth_v1_vauth_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x61, 0x75, 0x74, 0x68,
+	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x11, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x01, 0x0a, 0x19, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xab, 0x02, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x65, 0x65, 0x12,
+	0x4a, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x65,
+	0x78, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x17, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x46, 0x65, 0x65, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x6f, 0x61, 0x5f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6f, 0x61, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x75,
+	0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x65, 0x6f, 0x61, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x0c, 0x45, 0x6f, 0x61, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2a, 0x43,
+	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x50,
+	0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x43, 0x50, 0x32, 0x35,
+	0x36, 0x4b, 0x31, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x45, 0x49, 0x50, 0x31, 0x32, 0x37, 0x31, 0x10, 0x01, 0x1a, 0x04, 0x88,
+	0xa3, 0x1e, 0x00, 0x2a, 0x98, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45,
+	0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x45, 0x5f, 0x44,
+	0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10,
+	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50,
+	0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x43, 0x4f, 0x4c,
+	0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xb5,
+	0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e,
+	0x76, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x56, 0x61, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x74, 0x2f, 0x76, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x61, 0x75, 0x74, 0x68,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x56, 0x58, 0xaa, 0x02, 0x11, 0x45, 0x76, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x56, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x45,
+	0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x61, 0x75, 0x74, 0x68, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1d, 0x45, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x61, 0x75, 0x74,
+	0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x13, 0x45, 0x76, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x61, 0x75,
+	0x74, 0x68, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_evermint_vauth_v1_vauth_proto_rawDescOnce sync.Once
+	file_evermint_vauth_v1_vauth_proto_rawDescData = file_evermint_vauth_v1_vauth_proto_rawDesc
+)
+
+func file_evermint_vauth_v1_vauth_proto_rawDescGZIP() []byte {
+	file_evermint_vauth_v1_vauth_proto_rawDescOnce.Do(func() {
+		file_evermint_vauth_v1_vauth_proto_rawDescData = protoimpl.X.CompressGZIP(file_evermint_vauth_v1_vauth_proto_rawDescData)
+	})
+	return file_evermint_vauth_v1_vauth_proto_rawDescData
+}
+
+var file_evermint_vauth_v1_vauth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_evermint_vauth_v1_vauth_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_evermint_vauth_v1_vauth_proto_goTypes = []interface{}{
+	(ProofKind)(0),                    // 0: evermint.vauth.v1.ProofKind
+	(FeeDestination)(0),               // 1: evermint.vauth.v1.FeeDestination
+	(*ProofExternalOwnedAccount)(nil), // 2: evermint.vauth.v1.ProofExternalOwnedAccount
+	(*Params)(nil),                    // 3: evermint.vauth.v1.Params
+	(*EoaProofRule)(nil),              // 4: evermint.vauth.v1.EoaProofRule
+	(*v1beta1.Coin)(nil),              // 5: cosmos.base.v1beta1.Coin
+}
+var file_evermint_vauth_v1_vauth_proto_depIdxs = []int32{
+	0, // 0: evermint.vauth.v1.ProofExternalOwnedAccount.kind:type_name -> evermint.vauth.v1.ProofKind
+	5, // 1: evermint.vauth.v1.Params.submit_proof_fee:type_name -> cosmos.base.v1beta1.Coin
+	1, // 2: evermint.vauth.v1.Params.fee_destination:type_name -> evermint.vauth.v1.FeeDestination
+	4, // 3: evermint.vauth.v1.Params.eoa_proof_rules:type_name -> evermint.vauth.v1.EoaProofRule
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_evermint_vauth_v1_vauth_proto_init() }
+func file_evermint_vauth_v1_vauth_proto_init() {
+	if File_evermint_vauth_v1_vauth_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
 		file_evermint_vauth_v1_vauth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProofExternalOwnedAccount); i {
 			case 0:
@@ -700,19 +2249,44 @@ func file_evermint_vauth_v1_vauth_proto_init() {
 				return nil
 			}
 		}
+		file_evermint_vauth_v1_vauth_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evermint_vauth_v1_vauth_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EoaProofRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evermint_vauth_v1_vauth_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_evermint_vauth_v1_vauth_proto_goTypes,
 		DependencyIndexes: file_evermint_vauth_v1_vauth_proto_depIdxs,
+		EnumInfos:         file_evermint_vauth_v1_vauth_proto_enumTypes,
 		MessageInfos:      file_evermint_vauth_v1_vauth_proto_msgTypes,
 	}.Build()
 	File_evermint_vauth_v1_vauth_proto = out.File
@@ -46,6 +46,7 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			cosmoslane.NewCosmosLaneRejectEthereumMsgsDecorator(),
 			cosmoslane.NewCosmosLaneRejectAuthzMsgsDecorator(options.DisabledNestedMsgs),
 			cosmoslane.NewCosmosLaneVestingMessagesAuthorizationDecorator(*options.VAuthKeeper),
+			cosmoslane.NewCosmosLaneEmitCosmosTxLogsDecorator(*options.EvmKeeper), // must be the last Cosmos-lane Ante
 		}
 
 		anteHandler := sdk.ChainAnteDecorators(anteDecorators...)
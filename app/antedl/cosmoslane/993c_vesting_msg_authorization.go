@@ -4,10 +4,9 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	dlanteutils "github.com/EscanBE/evermint/app/antedl/utils"
 	vauthkeeper "github.com/EscanBE/evermint/x/vauth/keeper"
-	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 type CLVestingMessagesAuthorizationDecorator struct {
@@ -16,11 +15,13 @@ type CLVestingMessagesAuthorizationDecorator struct {
 
 // NewCosmosLaneVestingMessagesAuthorizationDecorator returns CLVestingMessagesAuthorizationDecorator, is a Cosmos-only-lane decorator.
 //   - If the input transaction is an Ethereum transaction, it calls next ante handler.
-//   - If the input transaction is a Cosmos transaction, it performs authorization for the vesting account creation messages.
+//   - If the input transaction is a Cosmos transaction, it performs authorization for the messages
+//     matching the EOA proof rules defined in `x/vauth` params, by default, the vesting account creation messages.
 //
 // Rules:
-//   - If the target account has proof of EOA via `x/vauth`, the message can keep going.
+//   - If the account at the address field of the message has proof of EOA via `x/vauth`, the message can keep going.
 //   - Otherwise, the message will be rejected.
+//   - Messages nested inside `authz.MsgExec` are evaluated the same way.
 func NewCosmosLaneVestingMessagesAuthorizationDecorator(vak vauthkeeper.Keeper) CLVestingMessagesAuthorizationDecorator {
 	return CLVestingMessagesAuthorizationDecorator{
 		vak: vak,
@@ -32,27 +33,30 @@ func (vmd CLVestingMessagesAuthorizationDecorator) AnteHandle(ctx sdk.Context, t
 		return next(ctx, tx, simulate)
 	}
 
-	for _, msg := range tx.GetMsgs() {
-		var account string
-		if m, ok := msg.(*vestingtypes.MsgCreateVestingAccount); ok {
-			account = m.ToAddress
-		} else if m, ok := msg.(*vestingtypes.MsgCreatePeriodicVestingAccount); ok {
-			account = m.ToAddress
-		} else if m, ok := msg.(*vestingtypes.MsgCreatePermanentLockedAccount); ok {
-			account = m.ToAddress
-		} else {
-			continue
-		}
+	if err := vmd.checkEoaProofRules(ctx, tx.GetMsgs(), 1); err != nil {
+		return ctx, err
+	}
 
-		if vmd.vak.HasProofExternalOwnedAccount(ctx, sdk.MustAccAddressFromBech32(account)) {
-			continue
-		}
+	return next(ctx, tx, simulate)
+}
 
-		return ctx, errorsmod.Wrapf(
-			sdkerrors.ErrUnauthorized,
-			"must prove account is external owned account (EOA) via `x/%s` module before able to create vesting account: %s", vauthtypes.ModuleName, account,
-		)
+func (vmd CLVestingMessagesAuthorizationDecorator) checkEoaProofRules(ctx sdk.Context, msgs []sdk.Msg, nestedLvl int) error {
+	if nestedLvl > maxNestedLevelsCount {
+		return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "nested level: %d/%d", nestedLvl, maxNestedLevelsCount)
 	}
 
-	return next(ctx, tx, simulate)
+	for _, msg := range msgs {
+		if m, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := vmd.checkEoaProofRules(ctx, innerMsgs, nestedLvl+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	return vmd.vak.CheckEoaProofRules(ctx, msgs)
 }
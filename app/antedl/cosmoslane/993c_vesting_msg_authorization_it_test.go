@@ -8,6 +8,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -31,6 +33,12 @@ func (s *CLTestSuite) Test_CLVestingMessagesAuthorizationDecorator() {
 
 	baseFee := s.BaseFee(s.Ctx())
 
+	setEoaProofRules := func(ctx sdk.Context, rules ...vauthtypes.EoaProofRule) {
+		params := s.App().VAuthKeeper().GetParams(ctx)
+		params.EoaProofRules = rules
+		s.Require().NoError(s.App().VAuthKeeper().SetParams(ctx, params))
+	}
+
 	tests := []struct {
 		name          string
 		tx            func(ctx sdk.Context) sdk.Tx
@@ -166,6 +174,136 @@ func (s *CLTestSuite) Test_CLVestingMessagesAuthorizationDecorator() {
 			anteSpec:      ts().WantsErrMsgContains("must prove account is external owned account"),
 			decoratorSpec: ts().WantsErrMsgContains("must prove account is external owned account"),
 		},
+		{
+			name: "fail - single-Cosmos - custom rule, without EOA proof, should reject and explain the rule",
+			tx: func(ctx sdk.Context) sdk.Tx {
+				setEoaProofRules(ctx, vauthtypes.EoaProofRule{
+					MsgTypeUrl:   sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					AddressField: "to_address",
+				})
+
+				tb := s.TxB().SetMsgs(&banktypes.MsgSend{
+					FromAddress: acc1.GetCosmosAddress().String(),
+					ToAddress:   proof.Account,
+					Amount:      sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(1))),
+				}).SetGasLimit(500_000).BigFeeAmount(1)
+
+				_, err := s.SignCosmosTx(ctx, acc1, tb)
+				s.Require().NoError(err)
+				return tb.Tx()
+			},
+			anteSpec:      ts().WantsErrMsgContains("required by rule /cosmos.bank.v1beta1.MsgSend:to_address"),
+			decoratorSpec: ts().WantsErrMsgContains("required by rule /cosmos.bank.v1beta1.MsgSend:to_address"),
+		},
+		{
+			name: "pass - single-Cosmos - custom rule, with EOA proof, should allow",
+			tx: func(ctx sdk.Context) sdk.Tx {
+				setEoaProofRules(ctx, vauthtypes.EoaProofRule{
+					MsgTypeUrl:   sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					AddressField: "to_address",
+				})
+
+				err := s.App().VAuthKeeper().SaveProofExternalOwnedAccount(ctx, proof)
+				s.Require().NoError(err)
+
+				tb := s.TxB().SetMsgs(&banktypes.MsgSend{
+					FromAddress: acc1.GetCosmosAddress().String(),
+					ToAddress:   proof.Account,
+					Amount:      sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(1))),
+				}).SetGasLimit(500_000).BigFeeAmount(1)
+
+				_, err = s.SignCosmosTx(ctx, acc1, tb)
+				s.Require().NoError(err)
+				return tb.Tx()
+			},
+			anteSpec:      ts().WantsSuccess(),
+			decoratorSpec: ts().WantsSuccess(),
+		},
+		{
+			name: "fail - single-Cosmos - custom rule on authz grantee, without EOA proof, should reject",
+			tx: func(ctx sdk.Context) sdk.Tx {
+				setEoaProofRules(ctx, vauthtypes.EoaProofRule{
+					MsgTypeUrl:   sdk.MsgTypeURL(&authz.MsgGrant{}),
+					AddressField: "grantee",
+				})
+
+				expiration := ctx.BlockTime().Add(24 * time.Hour)
+				msgGrant, err := authz.NewMsgGrant(
+					acc1.GetCosmosAddress(),
+					sdk.MustAccAddressFromBech32(proof.Account),
+					authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})),
+					&expiration,
+				)
+				s.Require().NoError(err)
+
+				tb := s.TxB().SetMsgs(msgGrant).SetGasLimit(500_000).BigFeeAmount(1)
+
+				_, err = s.SignCosmosTx(ctx, acc1, tb)
+				s.Require().NoError(err)
+				return tb.Tx()
+			},
+			anteSpec:      ts().WantsErrMsgContains("required by rule /cosmos.authz.v1beta1.MsgGrant:grantee"),
+			decoratorSpec: ts().WantsErrMsgContains("required by rule /cosmos.authz.v1beta1.MsgGrant:grantee"),
+		},
+		{
+			name: "fail - single-Cosmos - nested message inside authz MsgExec is evaluated",
+			tx: func(ctx sdk.Context) sdk.Tx {
+				setEoaProofRules(ctx, vauthtypes.EoaProofRule{
+					MsgTypeUrl:   sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					AddressField: "to_address",
+				})
+
+				msgExec := authz.NewMsgExec(acc1.GetCosmosAddress(), []sdk.Msg{&banktypes.MsgSend{
+					FromAddress: acc1.GetCosmosAddress().String(),
+					ToAddress:   proof.Account,
+					Amount:      sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(1))),
+				}})
+
+				tb := s.TxB().SetMsgs(&msgExec).SetGasLimit(500_000).BigFeeAmount(1)
+
+				_, err := s.SignCosmosTx(ctx, acc1, tb)
+				s.Require().NoError(err)
+				return tb.Tx()
+			},
+			anteSpec:      ts().WantsErrMsgContains("required by rule /cosmos.bank.v1beta1.MsgSend:to_address"),
+			decoratorSpec: ts().WantsErrMsgContains("required by rule /cosmos.bank.v1beta1.MsgSend:to_address"),
+		},
+		{
+			name: "fail - single-Cosmos - rule with missing field, should reject and explain the rule",
+			tx: func(ctx sdk.Context) sdk.Tx {
+				setEoaProofRules(ctx, vauthtypes.EoaProofRule{
+					MsgTypeUrl:   sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					AddressField: "recipient",
+				})
+
+				tb := s.TxB().SetBankSendMsg(acc1, acc2, 1).SetGasLimit(500_000).BigFeeAmount(1)
+				_, err := s.SignCosmosTx(ctx, acc1, tb)
+				s.Require().NoError(err)
+				return tb.Tx()
+			},
+			anteSpec:      ts().WantsErrMsgContains("failed to evaluate EOA proof rule /cosmos.bank.v1beta1.MsgSend:recipient"),
+			decoratorSpec: ts().WantsErrMsgContains("failed to evaluate EOA proof rule /cosmos.bank.v1beta1.MsgSend:recipient"),
+		},
+		{
+			name: "pass - single-Cosmos - no rule, vesting is not restricted",
+			tx: func(ctx sdk.Context) sdk.Tx {
+				setEoaProofRules(ctx)
+
+				tb := s.TxB().SetMsgs(&vestingtypes.MsgCreateVestingAccount{
+					FromAddress: acc1.GetCosmosAddress().String(),
+					ToAddress:   proof.Account,
+					Amount:      sdk.NewCoins(sdk.NewCoin(constants.BaseDenom, sdkmath.NewInt(1e18))),
+					EndTime:     time.Now().Add(24 * time.Hour).Unix(),
+					Delayed:     true,
+				}).SetGasLimit(500_000).BigFeeAmount(1)
+
+				_, err := s.SignCosmosTx(ctx, acc1, tb)
+				s.Require().NoError(err)
+				return tb.Tx()
+			},
+			anteSpec:      ts().WantsSuccess(),
+			decoratorSpec: ts().WantsSuccess(),
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
@@ -0,0 +1,37 @@
+package cosmoslane
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dlanteutils "github.com/EscanBE/evermint/app/antedl/utils"
+	evmkeeper "github.com/EscanBE/evermint/x/evm/keeper"
+)
+
+type CLEmitCosmosTxLogsDecorator struct {
+	ek evmkeeper.Keeper
+}
+
+// NewCosmosLaneEmitCosmosTxLogsDecorator returns CLEmitCosmosTxLogsDecorator, is a Cosmos-only-lane decorator.
+//   - If the input transaction is an Ethereum transaction, it calls next ante handler.
+//   - If the input transaction is a Cosmos transaction, it emits the EVM logs recorded during the AnteHandler, like the fee deduction.
+//
+// The state changes made by AnteHandler are kept even if the messages failed, but the PostHandler effects are not,
+// so the logs must be emitted here to be included in the events of the failed txs.
+// This must be the last Cosmos-lane decorator.
+func NewCosmosLaneEmitCosmosTxLogsDecorator(ek evmkeeper.Keeper) CLEmitCosmosTxLogsDecorator {
+	return CLEmitCosmosTxLogsDecorator{
+		ek: ek,
+	}
+}
+
+func (ecd CLEmitCosmosTxLogsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if dlanteutils.HasSingleEthereumMessage(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := ecd.ek.EmitCosmosTxLogs(ctx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
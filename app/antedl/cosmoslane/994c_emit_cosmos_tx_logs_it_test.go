@@ -0,0 +1,134 @@
+package cosmoslane_test
+
+import (
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/EscanBE/evermint/app/antedl/cosmoslane"
+	"github.com/EscanBE/evermint/constants"
+	itutiltypes "github.com/EscanBE/evermint/integration_test_util/types"
+	rpcbackend "github.com/EscanBE/evermint/rpc/backend"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+)
+
+func (s *CLTestSuite) Test_CLEmitCosmosTxLogsDecorator() {
+	acc1 := s.ATS.CITS.WalletAccounts.Number(1)
+	acc2 := s.ATS.CITS.WalletAccounts.Number(2)
+
+	baseFee := s.BaseFee(s.Ctx())
+
+	feeCollector := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+
+	getLogs := func(ctx sdk.Context) []*ethtypes.Log {
+		var logs []*ethtypes.Log
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != evmtypes.EventTypeCosmosTxLogs {
+				continue
+			}
+
+			eventLogs, err := rpcbackend.ParseCosmosTxLogsFromEvent(abci.Event(event))
+			s.Require().NoError(err)
+			logs = append(logs, eventLogs...)
+		}
+		return logs
+	}
+
+	// pendingLogFrom is the sender of the log recorded before running the ante handler
+	pendingLogFrom := common.BytesToAddress([]byte("pending"))
+
+	hasTransferLog := func(logs []*ethtypes.Log, from, to common.Address) bool {
+		for _, log := range logs {
+			if common.BytesToAddress(log.Topics[1].Bytes()) == from && common.BytesToAddress(log.Topics[2].Bytes()) == to {
+				return true
+			}
+		}
+		return false
+	}
+
+	tests := []struct {
+		name          string
+		tx            func(ctx sdk.Context) sdk.Tx
+		anteSpec      *itutiltypes.AnteTestSpec
+		decoratorSpec *itutiltypes.AnteTestSpec
+	}{
+		{
+			name: "pass - single-ETH - should not emit logs",
+			tx: func(ctx sdk.Context) sdk.Tx {
+				ctb, err := s.SignEthereumTx(ctx, acc1, &ethtypes.DynamicFeeTx{
+					Nonce:     0,
+					GasFeeCap: baseFee.BigInt(),
+					GasTipCap: big.NewInt(1),
+					Gas:       21000,
+					To:        acc2.GetEthAddressP(),
+					Value:     big.NewInt(1),
+				}, s.TxB())
+				s.Require().NoError(err)
+				return ctb.GetTx()
+			},
+			anteSpec: ts().WantsSuccess().OnSuccess(func(ctx sdk.Context, _ sdk.Tx) {
+				s.Empty(getLogs(ctx))
+			}),
+			decoratorSpec: ts().WantsSuccess().OnSuccess(func(ctx sdk.Context, _ sdk.Tx) {
+				s.Empty(getLogs(ctx))
+			}),
+		},
+		{
+			name: "pass - single-Cosmos - should emit logs recorded during ante handler",
+			tx: func(ctx sdk.Context) sdk.Tx {
+				tb := s.TxB().SetBankSendMsg(acc1, acc2, 1).SetGasLimit(500_000).BigFeeAmount(1)
+				_, err := s.SignCosmosTx(ctx, acc1, tb)
+				s.Require().NoError(err)
+				return tb.Tx()
+			},
+			anteSpec: ts().WantsSuccess().OnSuccess(func(ctx sdk.Context, _ sdk.Tx) {
+				logs := getLogs(ctx)
+				s.True(hasTransferLog(logs, pendingLogFrom, acc2.GetEthAddress()), "missing pending log")
+				s.True(hasTransferLog(logs, acc1.GetEthAddress(), feeCollector), "missing log of fee deduction")
+				s.False(hasTransferLog(logs, acc1.GetEthAddress(), acc2.GetEthAddress()), "log of the message must not be emitted by ante handler")
+			}),
+			decoratorSpec: ts().WantsSuccess().OnSuccess(func(ctx sdk.Context, _ sdk.Tx) {
+				logs := getLogs(ctx)
+				s.Require().Len(logs, 1)
+				s.True(hasTransferLog(logs, pendingLogFrom, acc2.GetEthAddress()), "missing pending log")
+			}),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			cachedCtx, _ := s.Ctx().CacheContext()
+
+			_, err := s.App().CpcKeeper().DeployErc20CustomPrecompiledContract(cachedCtx, constants.DisplayDenom, cpctypes.Erc20CustomPrecompiledContractMeta{
+				Symbol:   constants.DisplayDenom,
+				Decimals: constants.BaseDenomExponent,
+				MinDenom: constants.BaseDenom,
+			})
+			s.Require().NoError(err)
+
+			tt.decoratorSpec.WithDecorator(
+				cosmoslane.NewCosmosLaneEmitCosmosTxLogsDecorator(*s.App().EvmKeeper()),
+			)
+
+			tx := tt.tx(cachedCtx)
+
+			bzTx, err := s.ATS.CITS.EncodingConfig.TxConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+			cachedCtx = cachedCtx.WithTxBytes(bzTx)
+
+			s.App().EvmKeeper().AddCosmosTxTransferLogs(
+				cachedCtx,
+				pendingLogFrom.Bytes(), acc2.GetCosmosAddress(),
+				sdk.NewCoins(sdk.NewInt64Coin(constants.BaseDenom, 1)),
+			)
+
+			s.ATS.RunTestSpec(cachedCtx, tx, tt.anteSpec, false)
+
+			s.ATS.RunTestSpec(cachedCtx, tx, tt.decoratorSpec, true)
+		})
+	}
+}
@@ -29,6 +29,7 @@ func NewDualLaneSetupContextDecorator(ek evmkeeper.Keeper, cd sdkauthante.SetUpC
 
 func (scd DLSetupContextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	if !dlanteutils.HasSingleEthereumMessage(tx) {
+		scd.ek.SetFlagEthereumTx(utils.UseZeroGasConfig(ctx), false)
 		return scd.cd.AnteHandle(ctx, tx, simulate, next)
 	}
 
@@ -42,6 +43,7 @@ func (scd DLSetupContextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	newCtx = utils.UseZeroGasConfig(newCtx)
 
 	// reset previous run
+	scd.ek.SetFlagEthereumTx(newCtx, true)
 	scd.ek.SetFlagSenderNonceIncreasedByAnteHandle(newCtx, false)
 	scd.ek.SetFlagSenderPaidTxFeeInAnteHandle(newCtx, false)
 
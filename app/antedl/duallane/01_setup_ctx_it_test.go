@@ -62,11 +62,14 @@ func (s *DLTestSuite) Test_DLSetupContextDecorator() {
 
 				s.False(s.App().EvmKeeper().IsSenderNonceIncreasedByAnteHandle(ctx), "this decorator should reset this flag")
 				s.False(s.App().EvmKeeper().IsSenderPaidTxFeeInAnteHandle(ctx), "this decorator should reset this flag")
+				s.True(s.App().EvmKeeper().IsEthereumTx(ctx), "this decorator should mark the tx as Ethereum tx")
 			}),
 		},
 		{
 			name: "pass - single-Cosmos - setup correctly",
 			tx: func(ctx sdk.Context) sdk.Tx {
+				s.App().EvmKeeper().SetFlagEthereumTx(ctx, true)
+
 				tb := s.TxB().SetBankSendMsg(acc1, acc2, 1).SetGasLimit(500_000).BigFeeAmount(1)
 				ctb, err := s.SignCosmosTx(ctx, acc1, tb)
 				s.Require().NoError(err)
@@ -76,6 +79,7 @@ func (s *DLTestSuite) Test_DLSetupContextDecorator() {
 				s.Equal(500_000, int(ctx.GasMeter().Limit()))
 				s.NotEqual(storetypes.GasConfig{}, ctx.KVGasConfig())
 				s.NotEqual(storetypes.GasConfig{}, ctx.TransientKVGasConfig())
+				s.False(s.App().EvmKeeper().IsEthereumTx(ctx), "this decorator should reset this flag")
 			},
 			anteSpec:      ts().WantsSuccess(),
 			decoratorSpec: ts().WantsSuccess(),
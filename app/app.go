@@ -235,8 +235,10 @@ func NewEvermint(
 	// chainApp.setAnteHandler(txConfig)
 	chainApp.setDualLaneAnteHandler(txConfig)
 	chainApp.setPostHandler()
+	chainApp.setEvmTxReplayer(txConfig)
 
 	chainApp.SetInitChainer(chainApp.InitChainer)
+	chainApp.SetPreBlocker(chainApp.PreBlocker)
 	chainApp.SetBeginBlocker(chainApp.BeginBlocker)
 	chainApp.SetEndBlocker(chainApp.EndBlocker)
 
@@ -255,6 +257,13 @@ func NewEvermint(
 // Name returns the name of the App
 func (app *Evermint) Name() string { return app.BaseApp.Name() }
 
+// PreBlocker runs before BeginBlocker, it records the txs of the block
+// which are used to compute the tx index of the EVM logs emitted by Cosmos txs.
+func (app *Evermint) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	app.EvmKeeper.SetBlockTxsTransient(ctx, req.Txs)
+	return &sdk.ResponsePreBlock{}, nil
+}
+
 // BeginBlocker runs the CometBFT ABCI BeginBlock logic. It executes state changes at the beginning
 // of the new block for every registered module. If there is a registered fork at the current height,
 // BeginBlocker will schedule the upgrade plan and perform the state migration (if any).
@@ -403,7 +412,7 @@ func (app *Evermint) setDualLaneAnteHandler(txConfig client.TxConfig) {
 }
 
 func (app *Evermint) setPostHandler() {
-	postHandler, err := NewPostHandler()
+	postHandler, err := NewPostHandler(app.EvmKeeper)
 	if err != nil {
 		panic(err)
 	}
@@ -411,6 +420,17 @@ func (app *Evermint) setPostHandler() {
 	app.SetPostHandler(postHandler)
 }
 
+// setEvmTxReplayer provides the EVM keeper the components used to begin a block and execute txs,
+// so the txs of a block can be replayed the same way when tracing.
+func (app *Evermint) setEvmTxReplayer(txConfig client.TxConfig) {
+	postHandler, err := NewPostHandler(app.EvmKeeper)
+	if err != nil {
+		panic(err)
+	}
+
+	app.EvmKeeper.WithTxReplayer(app.PreBlocker, app.BeginBlocker, txConfig.TxDecoder(), app.AnteHandler(), postHandler, app.MsgServiceRouter())
+}
+
 func (app *Evermint) setupUpgradeHandlers() {
 	for _, upgrade := range Upgrades {
 		app.UpgradeKeeper.SetUpgradeHandler(
@@ -3,6 +3,7 @@ package keepers
 import (
 	"os"
 
+	"github.com/EscanBE/evermint/x/cpc"
 	cpckeeper "github.com/EscanBE/evermint/x/cpc/keeper"
 	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
 
@@ -85,6 +86,7 @@ type AppKeepers struct {
 	// keepers
 	AccountKeeper    authkeeper.AccountKeeper
 	BankKeeper       bankkeeper.Keeper
+	BaseBankKeeper   bankkeeper.BaseKeeper // the original bank keeper, BankKeeper wraps it
 	CapabilityKeeper *capabilitykeeper.Keeper
 	StakingKeeper    *stakingkeeper.Keeper
 	SlashingKeeper   slashingkeeper.Keeper
@@ -196,7 +198,10 @@ func NewAppKeeper(
 		authAddr,
 	)
 
-	appKeepers.BankKeeper = bankkeeper.NewBaseKeeper(
+	// the EVM and the custom precompiled contracts use the original bank keeper,
+	// other modules use the wrapped one which records ERC-20 `Transfer` logs of the bank movements
+	// and deploys the ERC-20 custom precompiled contracts when the denom metadata is set.
+	appKeepers.BaseBankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
 		appKeepers.AccountKeeper,
@@ -204,6 +209,9 @@ func NewAppKeeper(
 		authAddr,
 		logger,
 	)
+	bankKeeperWithTransferLogs := evmkeeper.NewBankKeeperWithTransferLogs(appKeepers.BaseBankKeeper)
+	bankKeeperWithErc20AutoDeploy := cpckeeper.NewBankKeeperWithErc20AutoDeploy(bankKeeperWithTransferLogs)
+	appKeepers.BankKeeper = bankKeeperWithErc20AutoDeploy
 
 	appKeepers.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
@@ -373,7 +381,7 @@ func NewAppKeeper(
 				tkeys[evmtypes.TransientKey],
 				authtypes.NewModuleAddress(govtypes.ModuleName),
 				appKeepers.AccountKeeper,
-				appKeepers.BankKeeper,
+				appKeepers.BaseBankKeeper,
 				appKeepers.StakingKeeper,
 				appKeepers.FeeMarketKeeper,
 				tracer,
@@ -381,6 +389,7 @@ func NewAppKeeper(
 			)
 
 			appKeepers.EvmKeeper = evmKeeper
+			bankKeeperWithTransferLogs.WithEvmKeeper(evmKeeper)
 		}
 
 		appKeepers.FeeMarketKeeper = appKeepers.FeeMarketKeeper.WithEvmKeeper(appKeepers.EvmKeeper)
@@ -390,8 +399,10 @@ func NewAppKeeper(
 		appKeepers.VAuthKeeper = vauthkeeper.NewKeeper(
 			appCodec,
 			keys[vauthtypes.StoreKey],
+			authtypes.NewModuleAddress(govtypes.ModuleName),
 			appKeepers.BankKeeper,
-			*appKeepers.EvmKeeper,
+			appKeepers.DistrKeeper,
+			appKeepers.EvmKeeper,
 		)
 
 		appKeepers.CPCKeeper = cpckeeper.NewKeeper(
@@ -399,19 +410,27 @@ func NewAppKeeper(
 			keys[cpctypes.StoreKey],
 			authtypes.NewModuleAddress(govtypes.ModuleName),
 			appKeepers.AccountKeeper,
-			appKeepers.BankKeeper,
+			appKeepers.BaseBankKeeper,
 			*appKeepers.StakingKeeper,
 			appKeepers.DistrKeeper,
+			appKeepers.GovKeeper,
+			appKeepers.TransferKeeper,
+			appKeepers.VAuthKeeper,
 		)
 
 		appKeepers.EvmKeeper.WithCpcKeeper(appKeepers.CPCKeeper)
+		bankKeeperWithErc20AutoDeploy.WithCpcKeeper(appKeepers.CPCKeeper)
 	}
 
 	{ // Create static IBC router, add transfer route, then set and seal it
 		ibcRouter := porttypes.NewRouter()
 		ibcRouter.
 			AddRoute(icahosttypes.SubModuleName, icahost.NewIBCModule(appKeepers.ICAHostKeeper)).
-			AddRoute(ibctransfertypes.ModuleName, ibctransfer.NewIBCModule(appKeepers.TransferKeeper))
+			AddRoute(ibctransfertypes.ModuleName, cpc.NewIBCTransferMiddleware(
+				ibctransfer.NewIBCModule(appKeepers.TransferKeeper),
+				appKeepers.CPCKeeper,
+				appKeepers.EvmKeeper,
+			))
 
 		appKeepers.IBCKeeper.SetRouter(ibcRouter)
 	}
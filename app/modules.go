@@ -122,7 +122,8 @@ func appModules(
 		),
 		auth.NewAppModule(appCodec, chainApp.AccountKeeper, authsims.RandomGenesisAccounts, chainApp.GetSubspace(authtypes.ModuleName)),
 		vesting.NewAppModule(chainApp.AccountKeeper, chainApp.BankKeeper),
-		bank.NewAppModule(appCodec, chainApp.BankKeeper, chainApp.AccountKeeper, chainApp.GetSubspace(banktypes.ModuleName)),
+		// x/bank module requires the original keeper, transfers are still recorded via the send restriction
+		bank.NewAppModule(appCodec, chainApp.BaseBankKeeper, chainApp.AccountKeeper, chainApp.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *chainApp.CapabilityKeeper, false),
 		crisis.NewAppModule(chainApp.CrisisKeeper, skipGenesisInvariants, chainApp.GetSubspace(crisistypes.ModuleName)),
 		gov.NewAppModule(appCodec, chainApp.GovKeeper, chainApp.AccountKeeper, chainApp.BankKeeper, chainApp.GetSubspace(govtypes.ModuleName)),
@@ -273,10 +274,11 @@ func orderInitBlockers() []string {
 		crisistypes.ModuleName,
 		// Evermint modules
 		evmtypes.ModuleName,
-		vauthtypes.ModuleName,
 		cpctypes.ModuleName,
 		// NOTE: fee market module needs to be initialized before genutil module as gentx transactions use MinGasPriceDecorator.AnteHandle
 		feemarkettypes.ModuleName,
+		// NOTE: vauth module needs to be initialized after the EVM is ready, to verify the EIP-1271 proofs of the contract wallets
+		vauthtypes.ModuleName,
 		// end of Evermint modules
 		genutiltypes.ModuleName,
 		ibctransfertypes.ModuleName,
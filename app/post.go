@@ -1,13 +1,47 @@
 package app
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+
+	evmkeeper "github.com/EscanBE/evermint/x/evm/keeper"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
 )
 
-// NewPostHandler returns a no-op PostHandler
-func NewPostHandler() (sdk.PostHandler, error) {
-	return posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
-	)
+// NewPostHandler returns the PostHandler, which emits the EVM logs recorded during the Cosmos tx execution.
+func NewPostHandler(evmKeeper *evmkeeper.Keeper) (sdk.PostHandler, error) {
+	if evmKeeper == nil {
+		return nil, errors.New("evm keeper is required for post handler")
+	}
+
+	return sdk.ChainPostDecorators(
+		emitCosmosTxLogsDecorator{evmKeeper: evmKeeper},
+	), nil
+}
+
+// emitCosmosTxLogsDecorator emits the ERC-20 `Transfer` logs of the bank movements made by the messages of the Cosmos tx.
+// The logs recorded during the AnteHandler were already emitted by the last Cosmos-lane AnteHandler decorator.
+// Ethereum txs are skipped, because the logs are emitted by the EVM itself.
+type emitCosmosTxLogsDecorator struct {
+	evmKeeper *evmkeeper.Keeper
+}
+
+func (d emitCosmosTxLogsDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if success && !isEthereumTx(tx) {
+		if err := d.evmKeeper.EmitCosmosTxLogs(ctx); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+func isEthereumTx(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return true
+		}
+	}
+	return false
 }
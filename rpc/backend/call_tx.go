@@ -283,7 +283,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -299,26 +299,25 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// The optional state override and block overrides are applied before the estimation.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber,
+	stateOverride *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
 	}
 
-	bz, err := json.Marshal(&args)
+	req, err := b.newEthCallRequest(args, stateOverride, blockOverrides)
 	if err != nil {
 		return 0, err
 	}
 
-	req := evmtypes.EthCallRequest{
-		Args:   bz,
-		GasCap: b.RPCGasCap(),
-	}
-
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), req)
 	if err != nil {
 		return 0, err
 	}
@@ -327,19 +326,16 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
+// The optional state override and block overrides are applied before the execution.
 func (b *Backend) DoCall(
 	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	stateOverride *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
-	bz, err := json.Marshal(&args)
+	req, err := b.newEthCallRequest(args, stateOverride, blockOverrides)
 	if err != nil {
 		return nil, err
 	}
 
-	req := evmtypes.EthCallRequest{
-		Args:   bz,
-		GasCap: b.RPCGasCap(),
-	}
-
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
@@ -359,7 +355,7 @@ func (b *Backend) DoCall(
 	// this makes sure resources are cleaned up.
 	defer cancel()
 
-	res, err := b.queryClient.EthCall(ctx, &req)
+	res, err := b.queryClient.EthCall(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -374,6 +370,214 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CreateAccessList returns the access list of the accounts and storage slots touched by the given call,
+// along with the gas used by the call when the access list is applied.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+) (*rpctypes.AccessListResult, error) {
+	req, err := b.newEthCallRequest(args, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	accessList := evmtypes.AccessList(res.AccessList).ToEthAccessList()
+	if *accessList == nil {
+		*accessList = ethtypes.AccessList{}
+	}
+
+	return &rpctypes.AccessListResult{
+		AccessList: accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// SimulateV1 simulates a sequence of calls across one or more blocks on top of the given block,
+// the state changes are carried between calls and blocks.
+func (b *Backend) SimulateV1(
+	opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber,
+) ([]*rpctypes.SimulatedBlockResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.CometBFTBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr.Int64())
+	}
+	height := resBlock.Block.Height
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(rpctypes.ContextWithHeight(height), b.clientCtx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := rpctypes.ContextWithHeight(height)
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &evmtypes.SimulateV1Request{
+		Opts:   bz,
+		GasCap: b.RPCGasCap(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parentHash := common.BytesToHash(resBlock.Block.Hash())
+	results := make([]*rpctypes.SimulatedBlockResult, 0, len(res.Blocks))
+	for _, block := range res.Blocks {
+		header := &ethtypes.Header{
+			ParentHash: parentHash,
+			UncleHash:  ethtypes.EmptyUncleHash,
+			Coinbase:   common.HexToAddress(block.Coinbase),
+			Difficulty: big.NewInt(0),
+			Number:     big.NewInt(block.Number),
+			GasLimit:   uint64(gasLimit), //#nosec G701 -- block gas limit is non-negative
+			GasUsed:    block.GasUsed,
+			Time:       uint64(block.Time), //#nosec G701 -- simulated block time is non-negative
+			BaseFee:    block.BaseFee.BigInt(),
+		}
+		blockHash := header.Hash()
+
+		result := &rpctypes.SimulatedBlockResult{
+			Number:        hexutil.Uint64(header.Number.Uint64()),
+			Hash:          blockHash,
+			ParentHash:    parentHash,
+			Timestamp:     hexutil.Uint64(header.Time),
+			GasLimit:      hexutil.Uint64(header.GasLimit),
+			GasUsed:       hexutil.Uint64(header.GasUsed),
+			Miner:         header.Coinbase,
+			BaseFeePerGas: (*hexutil.Big)(header.BaseFee),
+			Calls:         make([]rpctypes.SimulatedCallResult, 0, len(block.Calls)),
+		}
+
+		var logIndex uint
+		for txIndex, call := range block.Calls {
+			callResult, err := newSimulatedCallResult(call, header.Number.Uint64(), blockHash, uint(txIndex), &logIndex)
+			if err != nil {
+				return nil, err
+			}
+			result.Calls = append(result.Calls, *callResult)
+		}
+
+		results = append(results, result)
+		parentHash = blockHash
+	}
+
+	return results, nil
+}
+
+// newSimulatedCallResult converts the response of a simulated call into the `eth_simulateV1` call result.
+func newSimulatedCallResult(
+	res *evmtypes.MsgEthereumTxResponse, blockNumber uint64, blockHash common.Hash, txIndex uint, logIndex *uint,
+) (*rpctypes.SimulatedCallResult, error) {
+	var receipt ethtypes.Receipt
+	if err := receipt.UnmarshalBinary(res.MarshalledReceipt); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unmarshal receipt")
+	}
+
+	logs := make([]*ethtypes.Log, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		log.BlockNumber = blockNumber
+		log.BlockHash = blockHash
+		log.TxHash = common.HexToHash(res.Hash)
+		log.TxIndex = txIndex
+		log.Index = *logIndex
+		*logIndex++
+		logs = append(logs, log)
+	}
+
+	result := &rpctypes.SimulatedCallResult{
+		ReturnData: res.Ret,
+		Logs:       logs,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		if res.VmError == corevm.ErrExecutionReverted.Error() {
+			revertErr := evmtypes.NewExecErrorWithReason(res.Ret)
+			result.Error = &rpctypes.SimulatedCallError{
+				Code:    revertErr.ErrorCode(),
+				Message: revertErr.Error(),
+				Data:    revertErr.ErrorData().(string),
+			}
+		} else {
+			result.Error = &rpctypes.SimulatedCallError{
+				Code:    rpctypes.SimulateVMErrorCode,
+				Message: res.VmError,
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// newEthCallRequest builds the request for the `EthCall/EstimateGas` gRPC queries,
+// the overrides are encoded only if provided.
+func (b *Backend) newEthCallRequest(
+	args evmtypes.TransactionArgs, stateOverride *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides,
+) (*evmtypes.EthCallRequest, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.EthCallRequest{
+		Args:   bz,
+		GasCap: b.RPCGasCap(),
+	}
+
+	if stateOverride != nil {
+		req.StateOverrides, err = json.Marshal(stateOverride)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if blockOverrides != nil {
+		req.BlockOverrides, err = json.Marshal(blockOverrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
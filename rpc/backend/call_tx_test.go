@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -425,13 +426,30 @@ func (suite *BackendTestSuite) TestDoCall() {
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
 
+	nonce := hexutil.Uint64(1)
+	stateOverride := rpctypes.StateOverride{
+		toAddr: rpctypes.OverrideAccount{
+			Nonce: &nonce,
+		},
+	}
+	stateOverrideBz, err := json.Marshal(stateOverride)
+	suite.Require().NoError(err)
+
+	blockOverrides := rpctypes.BlockOverrides{
+		Number: (*hexutil.Big)(big.NewInt(2)),
+	}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	suite.Require().NoError(err)
+
 	testCases := []struct {
-		name         string
-		registerMock func()
-		blockNum     rpctypes.BlockNumber
-		callArgs     evmtypes.TransactionArgs
-		expEthTx     *evmtypes.MsgEthereumTxResponse
-		expPass      bool
+		name           string
+		registerMock   func()
+		blockNum       rpctypes.BlockNumber
+		callArgs       evmtypes.TransactionArgs
+		stateOverride  *rpctypes.StateOverride
+		blockOverrides *rpctypes.BlockOverrides
+		expEthTx       *evmtypes.MsgEthereumTxResponse
+		expPass        bool
 	}{
 		{
 			name: "fail - Invalid request",
@@ -455,6 +473,23 @@ func (suite *BackendTestSuite) TestDoCall() {
 			expEthTx: &evmtypes.MsgEthereumTxResponse{},
 			expPass:  true,
 		},
+		{
+			name: "pass - overrides are forwarded",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{
+					Args:           argsBz,
+					StateOverrides: stateOverrideBz,
+					BlockOverrides: blockOverridesBz,
+				})
+			},
+			blockNum:       rpctypes.BlockNumber(1),
+			callArgs:       callArgs,
+			stateOverride:  &stateOverride,
+			blockOverrides: &blockOverrides,
+			expEthTx:       &evmtypes.MsgEthereumTxResponse{},
+			expPass:        true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -462,7 +497,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, tc.stateOverride, tc.blockOverrides)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -473,6 +508,309 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestEstimateGas() {
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	nonce := hexutil.Uint64(1)
+	stateOverride := rpctypes.StateOverride{
+		toAddr: rpctypes.OverrideAccount{
+			Nonce: &nonce,
+		},
+	}
+	stateOverrideBz, err := json.Marshal(stateOverride)
+	suite.Require().NoError(err)
+
+	blockOverrides := rpctypes.BlockOverrides{
+		Number: (*hexutil.Big)(big.NewInt(2)),
+	}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	suite.Require().NoError(err)
+
+	blockNum := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		stateOverride  *rpctypes.StateOverride
+		blockOverrides *rpctypes.BlockOverrides
+		expGas         hexutil.Uint64
+	}{
+		{
+			name: "pass - without overrides",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterEstimateGasWithRequest(queryClient, &evmtypes.EthCallRequest{Args: argsBz}, 21000)
+			},
+			expGas: 21000,
+		},
+		{
+			name: "pass - overrides are forwarded",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterEstimateGasWithRequest(queryClient, &evmtypes.EthCallRequest{
+					Args:           argsBz,
+					StateOverrides: stateOverrideBz,
+					BlockOverrides: blockOverridesBz,
+				}, 30000)
+			},
+			stateOverride:  &stateOverride,
+			blockOverrides: &blockOverrides,
+			expGas:         30000,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			gas, err := suite.backend.EstimateGas(callArgs, &blockNum, tc.stateOverride, tc.blockOverrides)
+			suite.Require().NoError(err)
+			suite.Equal(tc.expGas, gas)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To: &toAddr,
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	slot := common.BigToHash(big.NewInt(1))
+
+	testCases := []struct {
+		name          string
+		registerMock  func()
+		expAccessList ethtypes.AccessList
+		expError      string
+		expGasUsed    hexutil.Uint64
+		expPass       bool
+	}{
+		{
+			name: "fail - query error",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCreateAccessListError(queryClient, &evmtypes.EthCallRequest{Args: argsBz})
+			},
+			expPass: false,
+		},
+		{
+			name: "pass - empty access list",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCreateAccessList(queryClient, &evmtypes.EthCallRequest{Args: argsBz}, &evmtypes.CreateAccessListResponse{
+					GasUsed: 21_000,
+				})
+			},
+			expAccessList: ethtypes.AccessList{},
+			expGasUsed:    21_000,
+			expPass:       true,
+		},
+		{
+			name: "pass - access list with vm error",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterCreateAccessList(queryClient, &evmtypes.EthCallRequest{Args: argsBz}, &evmtypes.CreateAccessListResponse{
+					AccessList: evmtypes.NewAccessList(&ethtypes.AccessList{
+						{Address: toAddr, StorageKeys: []common.Hash{slot}},
+					}),
+					GasUsed: 30_000,
+					VmError: corevm.ErrExecutionReverted.Error(),
+				})
+			},
+			expAccessList: ethtypes.AccessList{
+				{Address: toAddr, StorageKeys: []common.Hash{slot}},
+			},
+			expError:   corevm.ErrExecutionReverted.Error(),
+			expGasUsed: 30_000,
+			expPass:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.CreateAccessList(callArgs, rpctypes.BlockNumber(1))
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res.AccessList)
+			suite.Equal(tc.expAccessList, *res.AccessList)
+			suite.Equal(tc.expError, res.Error)
+			suite.Equal(tc.expGasUsed, res.GasUsed)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSimulateV1() {
+	toAddr := utiltx.GenerateAddress()
+	opts := evmtypes.SimOpts{
+		BlockStateCalls: []evmtypes.SimBlock{
+			{
+				Calls: []evmtypes.TransactionArgs{{To: &toAddr}, {To: &toAddr}},
+			},
+			{
+				Calls: []evmtypes.TransactionArgs{{To: &toAddr}},
+			},
+		},
+	}
+	optsBz, err := json.Marshal(opts)
+	suite.Require().NoError(err)
+
+	newCall := func(numberOfLogs int, vmError string, ret []byte) *evmtypes.MsgEthereumTxResponse {
+		receipt := ethtypes.Receipt{
+			Type:   ethtypes.DynamicFeeTxType,
+			Status: ethtypes.ReceiptStatusSuccessful,
+		}
+		if vmError != "" {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		for i := 0; i < numberOfLogs; i++ {
+			receipt.Logs = append(receipt.Logs, &ethtypes.Log{
+				Address: toAddr,
+				Topics:  []common.Hash{common.BigToHash(big.NewInt(int64(i)))},
+			})
+		}
+		bz, err := receipt.MarshalBinary()
+		suite.Require().NoError(err)
+
+		return &evmtypes.MsgEthereumTxResponse{
+			Hash:              common.BigToHash(big.NewInt(int64(numberOfLogs))).Hex(),
+			Ret:               ret,
+			VmError:           vmError,
+			GasUsed:           21_000,
+			MarshalledReceipt: bz,
+		}
+	}
+
+	response := &evmtypes.SimulateV1Response{
+		Blocks: []evmtypes.SimulatedBlock{
+			{
+				Number:   2,
+				Time:     12,
+				Coinbase: toAddr.Hex(),
+				BaseFee:  sdkmath.NewInt(1),
+				GasUsed:  42_000,
+				Calls: []*evmtypes.MsgEthereumTxResponse{
+					newCall(2, "", []byte{0x1}),
+					newCall(1, "", nil),
+				},
+			},
+			{
+				Number:   3,
+				Time:     24,
+				Coinbase: toAddr.Hex(),
+				BaseFee:  sdkmath.NewInt(1),
+				GasUsed:  21_000,
+				Calls: []*evmtypes.MsgEthereumTxResponse{
+					newCall(0, corevm.ErrExecutionReverted.Error(), []byte{0x2}),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		opts         evmtypes.SimOpts
+		expPass      bool
+	}{
+		{
+			name:         "fail - invalid options",
+			registerMock: func() {},
+			opts:         evmtypes.SimOpts{},
+			expPass:      false,
+		},
+		{
+			name: "fail - query error",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterSimulateV1Error(queryClient, &evmtypes.SimulateV1Request{Opts: optsBz})
+			},
+			opts:    opts,
+			expPass: false,
+		},
+		{
+			name: "pass - blocks are chained and logs are indexed",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterSimulateV1(queryClient, &evmtypes.SimulateV1Request{Opts: optsBz}, response)
+			},
+			opts:    opts,
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			blocks, err := suite.backend.SimulateV1(tc.opts, rpctypes.BlockNumber(1))
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(blocks, 2)
+
+			resBlock, err := suite.backend.CometBFTBlockByNumber(rpctypes.BlockNumber(1))
+			suite.Require().NoError(err)
+			suite.Equal(common.BytesToHash(resBlock.Block.Hash()), blocks[0].ParentHash)
+			suite.Equal(blocks[0].Hash, blocks[1].ParentHash)
+			suite.NotEqual(blocks[0].Hash, blocks[1].Hash)
+
+			suite.Equal(hexutil.Uint64(2), blocks[0].Number)
+			suite.Equal(hexutil.Uint64(24), blocks[1].Timestamp)
+			suite.Equal(toAddr, blocks[0].Miner)
+			suite.Equal(hexutil.Uint64(42_000), blocks[0].GasUsed)
+
+			suite.Require().Len(blocks[0].Calls, 2)
+			suite.Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), blocks[0].Calls[0].Status)
+			suite.Equal(hexutil.Bytes{0x1}, blocks[0].Calls[0].ReturnData)
+			suite.Nil(blocks[0].Calls[0].Error)
+			suite.Require().Len(blocks[0].Calls[0].Logs, 2)
+			suite.Require().Len(blocks[0].Calls[1].Logs, 1)
+			for i, log := range append(blocks[0].Calls[0].Logs, blocks[0].Calls[1].Logs...) {
+				suite.Equal(uint(i), log.Index)
+				suite.Equal(uint64(2), log.BlockNumber)
+				suite.Equal(blocks[0].Hash, log.BlockHash)
+			}
+			suite.Equal(uint(1), blocks[0].Calls[1].Logs[0].TxIndex)
+
+			suite.Require().Len(blocks[1].Calls, 1)
+			call := blocks[1].Calls[0]
+			suite.Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), call.Status)
+			suite.Require().NotNil(call.Error)
+			suite.Equal(3, call.Error.Code)
+			suite.Equal("0x02", call.Error.Data)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -91,6 +91,12 @@ func RegisterStatusError(client *mocks.Client) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Validators
+func RegisterValidators(client *mocks.Client, height int64, validators []*cmttypes.Validator) {
+	client.On("Validators", rpc.ContextWithHeight(1), &height, mock.AnythingOfType("*int"), mock.AnythingOfType("*int")).
+		Return(&cmtrpctypes.ResultValidators{BlockHeight: height, Validators: validators, Count: len(validators), Total: len(validators)}, nil)
+}
+
 // Block
 func RegisterBlockMultipleTxs(
 	client *mocks.Client,
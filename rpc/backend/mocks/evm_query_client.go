@@ -167,6 +167,36 @@ func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *evmtypes.QueryC
 	return r0, r1
 }
 
+// CreateAccessList provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CreateAccessList(ctx context.Context, in *evmtypes.EthCallRequest, opts ...grpc.CallOption) (*evmtypes.CreateAccessListResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evmtypes.CreateAccessListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *evmtypes.EthCallRequest, ...grpc.CallOption) *evmtypes.CreateAccessListResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.CreateAccessListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evmtypes.EthCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGas provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *evmtypes.EthCallRequest, opts ...grpc.CallOption) (*evmtypes.EstimateGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -257,6 +287,36 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *evmtypes.QueryParamsRe
 	return r0, r1
 }
 
+// SimulateV1 provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) SimulateV1(ctx context.Context, in *evmtypes.SimulateV1Request, opts ...grpc.CallOption) (*evmtypes.SimulateV1Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evmtypes.SimulateV1Response
+	if rf, ok := ret.Get(0).(func(context.Context, *evmtypes.SimulateV1Request, ...grpc.CallOption) *evmtypes.SimulateV1Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.SimulateV1Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evmtypes.SimulateV1Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *evmtypes.QueryStorageRequest, opts ...grpc.CallOption) (*evmtypes.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -317,6 +377,36 @@ func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *evmtypes.QueryTrac
 	return r0, r1
 }
 
+// TraceCall provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TraceCall(ctx context.Context, in *evmtypes.QueryTraceCallRequest, opts ...grpc.CallOption) (*evmtypes.QueryTraceCallResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evmtypes.QueryTraceCallResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *evmtypes.QueryTraceCallRequest, ...grpc.CallOption) *evmtypes.QueryTraceCallResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.QueryTraceCallResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evmtypes.QueryTraceCallRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TraceTx provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceTx(ctx context.Context, in *evmtypes.QueryTraceTxRequest, opts ...grpc.CallOption) (*evmtypes.QueryTraceTxResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -8,6 +8,8 @@ import (
 	rpctypes "github.com/EscanBE/evermint/rpc/types"
 	evmtypes "github.com/EscanBE/evermint/x/evm/types"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmtstate "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -70,13 +72,23 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		return nil, fmt.Errorf("invalid transaction type %T", tx)
 	}
 
+	decidedLastCommit, misbehavior, err := b.blockCometInfo(blk.Block)
+	if err != nil {
+		b.logger.Debug("failed to get comet info of block", "height", blk.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
 	traceTxRequest := evmtypes.QueryTraceTxRequest{
-		Msg:             ethMessage,
-		Predecessors:    predecessors,
-		BlockNumber:     blk.Block.Height,
-		BlockTime:       blk.Block.Time,
-		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
-		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		Msg:                ethMessage,
+		Predecessors:       predecessors,
+		BlockNumber:        blk.Block.Height,
+		BlockTime:          blk.Block.Time,
+		BlockHash:          common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress:    sdk.ConsAddress(blk.Block.ProposerAddress),
+		BlockTxs:           blockTxsBytes(blk.Block.Txs),
+		DecidedLastCommit:  decidedLastCommit,
+		Misbehavior:        misbehavior,
+		NextValidatorsHash: blk.Block.NextValidatorsHash,
 	}
 
 	if config != nil {
@@ -148,13 +160,23 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	}
 	ctxWithHeight := rpctypes.ContextWithHeight(int64(contextHeight))
 
+	decidedLastCommit, misbehavior, err := b.blockCometInfo(block.Block)
+	if err != nil {
+		b.logger.Debug("failed to get comet info of block", "height", block.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
 	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
-		Txs:             txsMessages,
-		TraceConfig:     config,
-		BlockNumber:     block.Block.Height,
-		BlockTime:       block.Block.Time,
-		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
-		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		Txs:                txsMessages,
+		TraceConfig:        config,
+		BlockNumber:        block.Block.Height,
+		BlockTime:          block.Block.Time,
+		BlockHash:          common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress:    sdk.ConsAddress(block.Block.ProposerAddress),
+		BlockTxs:           blockTxsBytes(txs),
+		DecidedLastCommit:  decidedLastCommit,
+		Misbehavior:        misbehavior,
+		NextValidatorsHash: block.Block.NextValidatorsHash,
 	}
 
 	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
@@ -169,3 +191,115 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// blockTxsBytes returns the raw bytes of the given block txs,
+// to replay all the txs of the block, including the Cosmos txs, when tracing.
+func blockTxsBytes(txs cmttypes.Txs) [][]byte {
+	bzTxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bzTxs[i] = tx
+	}
+	return bzTxs
+}
+
+// blockCometInfo returns the proto-encoded last commit info, including the power of the validators,
+// and the proto-encoded misbehavior of the given block, the same as provided by CometBFT when finalizing the block,
+// to begin the block the same way when it is replayed for tracing.
+func (b *Backend) blockCometInfo(block *cmttypes.Block) (decidedLastCommit []byte, misbehavior [][]byte, err error) {
+	if block.LastCommit != nil && block.LastCommit.Size() > 0 {
+		// the votes of the last commit are signed by the validators of the previous block
+		validators, err := b.validatorsAtHeight(block.Height - 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(validators) != block.LastCommit.Size() {
+			return nil, nil, fmt.Errorf(
+				"commit size (%d) doesn't match validator set length (%d) at height %d",
+				block.LastCommit.Size(), len(validators), block.Height,
+			)
+		}
+
+		commitInfo := cmtstate.BuildLastCommitInfo(block, &cmttypes.ValidatorSet{Validators: validators}, 0)
+		decidedLastCommit, err = commitInfo.Marshal()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, ev := range block.Evidence.Evidence {
+		for _, mb := range ev.ABCI() {
+			bz, err := mb.Marshal()
+			if err != nil {
+				return nil, nil, err
+			}
+			misbehavior = append(misbehavior, bz)
+		}
+	}
+
+	return decidedLastCommit, misbehavior, nil
+}
+
+// validatorsAtHeight returns all the validators of the given height, in the order of the validator set.
+func (b *Backend) validatorsAtHeight(height int64) ([]*cmttypes.Validator, error) {
+	var validators []*cmttypes.Validator
+	page, perPage := 1, 100
+	for {
+		res, err := b.clientCtx.Client.Validators(b.ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			return validators, nil
+		}
+		page++
+	}
+}
+
+// TraceCall returns the structured logs created during the execution of the given call
+// on top of the state of the given block, with the optional overrides applied.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:   bz,
+		GasCap: b.RPCGasCap(),
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+
+		if config.StateOverrides != nil {
+			traceCallRequest.StateOverrides, err = json.Marshal(config.StateOverrides)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if config.BlockOverrides != nil {
+			traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNr.Int64()), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	err = json.Unmarshal(traceResult.Data, &decodedResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
@@ -1,19 +1,27 @@
 package backend
 
 import (
+	"encoding/json"
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"cosmossdk.io/log"
 	"github.com/EscanBE/evermint/crypto/ethsecp256k1"
 	"github.com/EscanBE/evermint/indexer"
 	"github.com/EscanBE/evermint/rpc/backend/mocks"
+	rpctypes "github.com/EscanBE/evermint/rpc/types"
+	utiltx "github.com/EscanBE/evermint/testutil/tx"
 	evmtypes "github.com/EscanBE/evermint/x/evm/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 	sdkdb "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -127,7 +135,7 @@ func (suite *BackendTestSuite) TestTraceTransaction() {
 				var height int64 = 1
 				_, err := RegisterBlockMultipleTxs(client, height, []cmttypes.Tx{txBz, txBz2})
 				suite.Require().NoError(err)
-				RegisterTraceTransactionWithPredecessors(queryClient, msgEthereumTx, []*evmtypes.MsgEthereumTx{msgEthereumTx})
+				RegisterTraceTransactionWithPredecessors(queryClient, msgEthereumTx, []*evmtypes.MsgEthereumTx{msgEthereumTx}, [][]byte{txBz, txBz2})
 			},
 			block: &cmttypes.Block{Header: cmttypes.Header{Height: 1, ChainID: ChainID}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz, txBz2}}},
 			responseBlock: []*abci.ExecTxResult{
@@ -183,7 +191,7 @@ func (suite *BackendTestSuite) TestTraceTransaction() {
 				var height int64 = 1
 				_, err := RegisterBlock(client, height, txBz)
 				suite.Require().NoError(err)
-				RegisterTraceTransaction(queryClient, msgEthereumTx)
+				RegisterTraceTransaction(queryClient, msgEthereumTx, [][]byte{txBz})
 			},
 			block: &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
 			responseBlock: []*abci.ExecTxResult{
@@ -266,7 +274,7 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 			name: "fail - cannot unmarshal data",
 			registerMock: func() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
-				RegisterTraceBlock(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx})
+				RegisterTraceBlock(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, [][]byte{bz})
 			},
 			expTraceResults: []*evmtypes.TxTraceResult{},
 			resBlock:        &resBlockFilled,
@@ -291,3 +299,172 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestBlockCometInfo() {
+	val1 := cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+	val2 := cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 5)
+
+	lastCommit := &cmttypes.Commit{
+		Height: 1,
+		Round:  2,
+		Signatures: []cmttypes.CommitSig{
+			{BlockIDFlag: cmttypes.BlockIDFlagCommit, ValidatorAddress: val1.Address},
+			{BlockIDFlag: cmttypes.BlockIDFlagAbsent},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		registerMock         func()
+		block                *cmttypes.Block
+		expDecidedLastCommit *abci.CommitInfo
+		expPass              bool
+	}{
+		{
+			name:                 "pass - no last commit",
+			registerMock:         func() {},
+			block:                cmttypes.MakeBlock(1, []cmttypes.Tx{}, nil, nil),
+			expDecidedLastCommit: nil,
+			expPass:              true,
+		},
+		{
+			name: "pass - votes of the last commit with the validators power",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterValidators(client, 1, []*cmttypes.Validator{val1, val2})
+			},
+			block: cmttypes.MakeBlock(2, []cmttypes.Tx{}, lastCommit, nil),
+			expDecidedLastCommit: &abci.CommitInfo{
+				Round: 2,
+				Votes: []abci.VoteInfo{
+					{Validator: abci.Validator{Address: val1.Address, Power: 10}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+					{Validator: abci.Validator{Address: val2.Address, Power: 5}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - validators do not match the last commit",
+			registerMock: func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterValidators(client, 1, []*cmttypes.Validator{val1})
+			},
+			block:   cmttypes.MakeBlock(2, []cmttypes.Tx{}, lastCommit, nil),
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			decidedLastCommit, misbehavior, err := suite.backend.blockCometInfo(tc.block)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(misbehavior)
+				if tc.expDecidedLastCommit == nil {
+					suite.Require().Empty(decidedLastCommit)
+					return
+				}
+				var commitInfo abci.CommitInfo
+				suite.Require().NoError(commitInfo.Unmarshal(decidedLastCommit))
+				suite.Require().Equal(*tc.expDecidedLastCommit, commitInfo)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To: &toAddr,
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	nonce := hexutil.Uint64(1)
+	stateOverride := rpctypes.StateOverride{
+		toAddr: rpctypes.OverrideAccount{
+			Nonce: &nonce,
+		},
+	}
+	stateOverrideBz, err := json.Marshal(stateOverride)
+	suite.Require().NoError(err)
+
+	blockOverrides := rpctypes.BlockOverrides{
+		Number: (*hexutil.Big)(big.NewInt(2)),
+	}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		config         *rpctypes.TraceCallConfig
+		expTraceResult interface{}
+		expPass        bool
+	}{
+		{
+			name: "fail - query error",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz})
+			},
+			config:  nil,
+			expPass: false,
+		},
+		{
+			name: "pass - without config",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz})
+			},
+			config:         nil,
+			expTraceResult: map[string]interface{}{"test": "hello"},
+			expPass:        true,
+		},
+		{
+			name: "pass - trace config and overrides are forwarded",
+			registerMock: func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args: argsBz,
+					TraceConfig: &evmtypes.TraceConfig{
+						Tracer: evmtypes.TracerCall,
+					},
+					StateOverrides: stateOverrideBz,
+					BlockOverrides: blockOverridesBz,
+				})
+			},
+			config: &rpctypes.TraceCallConfig{
+				TraceConfig: evmtypes.TraceConfig{
+					Tracer: evmtypes.TracerCall,
+				},
+				StateOverrides: &stateOverride,
+				BlockOverrides: &blockOverrides,
+			},
+			expTraceResult: map[string]interface{}{"test": "hello"},
+			expPass:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traceResult, err := suite.backend.TraceCall(callArgs, rpctypes.BlockNumber(1), tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraceResult, traceResult)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -196,10 +197,21 @@ func (b *Backend) processBlock(
 	return nil
 }
 
-// AllTxLogsFromEvents parses all ethereum logs from cosmos events
+// AllTxLogsFromEvents parses all ethereum logs from cosmos events,
+// including the logs emitted by Cosmos txs.
 func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
 	allLogs := make([][]*ethtypes.Log, 0, 4)
 	for _, event := range events {
+		if event.Type == evmtypes.EventTypeCosmosTxLogs {
+			logs, err := ParseCosmosTxLogsFromEvent(event)
+			if err != nil {
+				return nil, err
+			}
+
+			allLogs = append(allLogs, logs)
+			continue
+		}
+
 		if event.Type != evmtypes.EventTypeTxReceipt {
 			continue
 		}
@@ -350,6 +362,70 @@ func ParseTxReceiptFromEvent(event abci.Event) (*InCompletedEthReceipt, error) {
 	}, nil
 }
 
+// ParseCosmosTxLogsFromEvent parses the logs emitted by a Cosmos tx from one event.
+// The output logs will be missing block hash.
+func ParseCosmosTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
+	if event.Type != evmtypes.EventTypeCosmosTxLogs {
+		panic(fmt.Sprintf("wrong event, expected: %s, got: %s", evmtypes.EventTypeCosmosTxLogs, event.Type))
+	}
+
+	marshalledLogsRaw, found := findAttribute(event.Attributes, evmtypes.AttributeKeyReceiptMarshalled)
+	if !found {
+		return nil, fmt.Errorf("missing event attribute: %s", evmtypes.AttributeKeyReceiptMarshalled)
+	}
+	bzLogs, err := hexutil.Decode(marshalledLogsRaw)
+	if err != nil {
+		return nil, err
+	}
+	var logs []*ethtypes.Log
+	if err := rlp.DecodeBytes(bzLogs, &logs); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unmarshal logs")
+	}
+
+	txHashRaw, found := findAttribute(event.Attributes, evmtypes.AttributeKeyReceiptCometBFTTxHash)
+	if !found {
+		return nil, fmt.Errorf("missing event attribute: %s", evmtypes.AttributeKeyReceiptCometBFTTxHash)
+	}
+	txHash := common.HexToHash(txHashRaw)
+
+	blockNumberRaw, found := findAttribute(event.Attributes, evmtypes.AttributeKeyReceiptBlockNumber)
+	if !found {
+		return nil, fmt.Errorf("missing event attribute: %s", evmtypes.AttributeKeyReceiptBlockNumber)
+	}
+	blockNumber, err := strconv.ParseUint(blockNumberRaw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad event attribute value: %s = %s", evmtypes.AttributeKeyReceiptBlockNumber, blockNumberRaw)
+	}
+
+	txIndexRaw, found := findAttribute(event.Attributes, evmtypes.AttributeKeyReceiptTxIndex)
+	if !found {
+		return nil, fmt.Errorf("missing event attribute: %s", evmtypes.AttributeKeyReceiptTxIndex)
+	}
+	txIndex, err := strconv.ParseUint(txIndexRaw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad event attribute value: %s = %s", evmtypes.AttributeKeyReceiptTxIndex, txIndexRaw)
+	}
+
+	startLogIndexRaw, found := findAttribute(event.Attributes, evmtypes.AttributeKeyReceiptStartLogIndex)
+	if !found {
+		return nil, fmt.Errorf("missing event attribute: %s", evmtypes.AttributeKeyReceiptStartLogIndex)
+	}
+	startLogIndex, err := strconv.ParseUint(startLogIndexRaw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad event attribute value: %s = %s", evmtypes.AttributeKeyReceiptStartLogIndex, startLogIndexRaw)
+	}
+
+	// fill data
+	for i, log := range logs {
+		log.BlockNumber = blockNumber
+		log.TxHash = txHash
+		log.TxIndex = uint(txIndex)
+		log.Index = uint(startLogIndex + uint64(i))
+	}
+
+	return logs, nil
+}
+
 func findAttribute(attrs []abci.EventAttribute, key string) (value string, found bool) {
 	for _, attr := range attrs {
 		if attr.Key == key {
@@ -3,8 +3,12 @@ package backend
 import (
 	sdkmath "cosmossdk.io/math"
 
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
 	feemarkettypes "github.com/EscanBE/evermint/x/feemarket/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func init() {
@@ -55,3 +59,50 @@ func (suite *BackendTestSuite) TestGetHexProofs() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestAllTxLogsFromEvents_CosmosTxLogs() {
+	contractAddr := common.BytesToAddress([]byte("contract"))
+	txHash := common.BytesToHash([]byte("tx"))
+
+	logs := []*ethtypes.Log{
+		{
+			Address:     contractAddr,
+			Topics:      []common.Hash{common.BytesToHash([]byte("topic"))},
+			Data:        []byte{1},
+			BlockNumber: 10,
+			TxHash:      txHash,
+			TxIndex:     2,
+			Index:       5,
+		},
+		{
+			Address:     contractAddr,
+			Topics:      []common.Hash{},
+			Data:        []byte{2},
+			BlockNumber: 10,
+			TxHash:      txHash,
+			TxIndex:     2,
+			Index:       6,
+		},
+	}
+
+	sdkEvent, err := evmtypes.GetSdkEventForCosmosTxLogs(logs)
+	suite.Require().NoError(err)
+
+	allLogs, err := AllTxLogsFromEvents([]abci.Event{abci.Event(sdkEvent)})
+	suite.Require().NoError(err)
+	suite.Require().Len(allLogs, 1)
+	suite.Require().Len(allLogs[0], len(logs))
+
+	for i, log := range allLogs[0] {
+		suite.Equal(logs[i].Address, log.Address)
+		suite.Equal(logs[i].Topics, log.Topics)
+		suite.Equal(logs[i].Data, log.Data)
+		suite.Equal(logs[i].BlockNumber, log.BlockNumber)
+		suite.Equal(logs[i].TxHash, log.TxHash)
+		suite.Equal(logs[i].TxIndex, log.TxIndex)
+		suite.Equal(logs[i].Index, log.Index)
+	}
+
+	_, err = evmtypes.GetSdkEventForCosmosTxLogs(nil)
+	suite.Require().Error(err)
+}
@@ -1068,14 +1068,40 @@ func (suite *EthRpcTestSuite) Test_EthCall() {
 	// begin test
 
 	testCases := []struct {
-		name       string
-		txArgs     evmtypes.TransactionArgs
-		preRunFunc func(suite *EthRpcTestSuite)
-		wantErr    bool
+		name          string
+		txArgs        evmtypes.TransactionArgs
+		stateOverride *rpctypes.StateOverride
+		preRunFunc    func(suite *EthRpcTestSuite)
+		wantNumber    byte
+		wantErr       bool
 	}{
 		{
-			name:   "pass - can do eth_call",
+			name:       "pass - can do eth_call",
+			txArgs:     defaultTxArgs,
+			wantNumber: number,
+		},
+		{
+			name:   "pass - can do eth_call with state override",
 			txArgs: defaultTxArgs,
+			stateOverride: &rpctypes.StateOverride{
+				contractAddr: rpctypes.OverrideAccount{
+					StateDiff: &map[common.Hash]common.Hash{
+						{}: common.BigToHash(big.NewInt(0x9)),
+					},
+				},
+			},
+			wantNumber: 0x9,
+		},
+		{
+			name:   "fail - reject state override with both state and state diff",
+			txArgs: defaultTxArgs,
+			stateOverride: &rpctypes.StateOverride{
+				contractAddr: rpctypes.OverrideAccount{
+					State:     &map[common.Hash]common.Hash{},
+					StateDiff: &map[common.Hash]common.Hash{},
+				},
+			},
+			wantErr: true,
 		},
 	}
 	for _, tc := range testCases {
@@ -1109,7 +1135,7 @@ func (suite *EthRpcTestSuite) Test_EthCall() {
 			blockNumber := rpctypes.BlockNumber(suite.CITS.GetLatestBlockHeight())
 			bz, err := suite.GetEthPublicAPI().Call(tc.txArgs, rpctypes.BlockNumberOrHash{
 				BlockNumber: &blockNumber,
-			}, nil)
+			}, tc.stateOverride, nil)
 			if tc.wantErr {
 				suite.Require().Error(err)
 				return
@@ -1119,7 +1145,7 @@ func (suite *EthRpcTestSuite) Test_EthCall() {
 			suite.Require().NotNil(bz)
 
 			wantRes := make([]byte, 32)
-			wantRes[31] = number
+			wantRes[31] = tc.wantNumber
 			suite.Equal(bz, hexutil.Bytes(wantRes))
 		})
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmtjrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -19,6 +20,7 @@ import (
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/client"
 
+	"github.com/EscanBE/evermint/rpc/backend"
 	rpctypes "github.com/EscanBE/evermint/rpc/types"
 	evmtypes "github.com/EscanBE/evermint/x/evm/types"
 )
@@ -64,6 +66,15 @@ type filter struct {
 	crit     filters.FilterCriteria
 	logs     []*ethtypes.Log
 	s        *Subscription // associated subscription in event system
+	cosmosS  *Subscription // associated subscription of logs emitted by Cosmos txs, only for logs filter
+}
+
+// unsubscribe unsubscribes all the associated subscriptions in event system.
+func (f *filter) unsubscribe(es *EventSystem) {
+	f.s.Unsubscribe(es)
+	if f.cosmosS != nil {
+		f.cosmosS.Unsubscribe(es)
+	}
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -106,7 +117,7 @@ func (api *PublicFilterAPI) timeoutLoop() {
 		for id, f := range api.filters {
 			select {
 			case <-f.deadline.C:
-				f.s.Unsubscribe(api.events)
+				f.unsubscribe(api.events)
 				delete(api.filters, id)
 			default:
 				continue
@@ -377,58 +388,59 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 		return &rpc.Subscription{}, err
 	}
 
-	go func(logsCh <-chan cmtrpctypes.ResultEvent) {
+	cosmosTxLogsSub, cancelCosmosTxLogsSubs, err := api.events.SubscribeCosmosTxLogs(crit)
+	if err != nil {
+		cancelSubs()
+		logsSub.Unsubscribe(api.events)
+		return &rpc.Subscription{}, err
+	}
+
+	go func(logsCh, cosmosTxLogsCh <-chan cmtrpctypes.ResultEvent) {
 		defer cancelSubs()
+		defer cancelCosmosTxLogsSubs()
+
+		unsubscribe := func() {
+			logsSub.Unsubscribe(api.events)
+			cosmosTxLogsSub.Unsubscribe(api.events)
+		}
+
+		notify := func(ev cmtrpctypes.ResultEvent, eventType string) {
+			logs, err := api.filterLogsFromEvent(ev, eventType, crit)
+			if err != nil {
+				api.logger.Error("fail to parse logs from tx events", "error", err)
+				return
+			}
+
+			for _, log := range logs {
+				_ = notifier.Notify(rpcSub.ID, log) // #nosec G703
+			}
+		}
 
 		for {
 			select {
 			case ev, ok := <-logsCh:
 				if !ok {
-					logsSub.Unsubscribe(api.events)
-					return
-				}
-
-				// filter only events from EVM module txs
-				_, isMsgEthereumTx := ev.Events[evmtypes.TypeMsgEthereumTx]
-
-				if !isMsgEthereumTx {
-					// ignore transaction as it's not from the evm module
+					unsubscribe()
 					return
 				}
 
-				// get transaction result data
-				dataTx, ok := ev.Data.(cmttypes.EventDataTx)
+				notify(ev, evmtypes.EventTypeTxReceipt)
+			case ev, ok := <-cosmosTxLogsCh:
 				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
-
-				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
-				if err != nil {
-					api.logger.Error("fail to decode tx response", "error", err)
-					return
-				}
-
-				receipt := &ethtypes.Receipt{}
-				if err := receipt.UnmarshalBinary(txResponse.MarshalledReceipt); err != nil {
-					api.logger.Error("fail to unmarshal receipt from tx response", "error", err)
+					unsubscribe()
 					return
 				}
 
-				logs := FilterLogs(receipt.Logs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-
-				for _, log := range logs {
-					_ = notifier.Notify(rpcSub.ID, log) // #nosec G703
-				}
+				notify(ev, evmtypes.EventTypeCosmosTxLogs)
 			case <-rpcSub.Err(): // client send an unsubscribe request
-				logsSub.Unsubscribe(api.events)
+				unsubscribe()
 				return
 			case <-notifier.Closed(): // connection dropped
-				logsSub.Unsubscribe(api.events)
+				unsubscribe()
 				return
 			}
 		}
-	}(logsSub.eventCh)
+	}(logsSub.eventCh, cosmosTxLogsSub.eventCh)
 
 	return rpcSub, err
 }
@@ -464,6 +476,13 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 		return rpc.ID(""), err
 	}
 
+	cosmosTxLogsSub, cancelCosmosTxLogsSubs, err := api.events.SubscribeCosmosTxLogs(criteria)
+	if err != nil {
+		cancelSubs()
+		logsSub.Unsubscribe(api.events)
+		return rpc.ID(""), err
+	}
+
 	filterID = logsSub.ID()
 
 	api.filters[filterID] = &filter{
@@ -472,57 +491,93 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 		deadline: time.NewTimer(deadline),
 		hashes:   []common.Hash{},
 		s:        logsSub,
+		cosmosS:  cosmosTxLogsSub,
 	}
 
-	go func(eventCh <-chan cmtrpctypes.ResultEvent) {
+	go func(eventCh, cosmosTxLogsCh <-chan cmtrpctypes.ResultEvent) {
 		defer cancelSubs()
+		defer cancelCosmosTxLogsSubs()
+
+		deleteFilter := func() {
+			api.filtersMu.Lock()
+			delete(api.filters, filterID)
+			api.filtersMu.Unlock()
+		}
+
+		appendLogs := func(ev cmtrpctypes.ResultEvent, eventType string) {
+			logs, err := api.filterLogsFromEvent(ev, eventType, criteria)
+			if err != nil {
+				api.logger.Error("fail to parse logs from tx events", "error", err)
+				return
+			}
+
+			api.filtersMu.Lock()
+			if f, found := api.filters[filterID]; found {
+				f.logs = append(f.logs, logs...)
+			}
+			api.filtersMu.Unlock()
+		}
 
 		for {
 			select {
 			case ev, ok := <-eventCh:
 				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, filterID)
-					api.filtersMu.Unlock()
-					return
-				}
-				dataTx, ok := ev.Data.(cmttypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
-
-				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
-				if err != nil {
-					api.logger.Error("fail to decode tx response", "error", err)
+					deleteFilter()
 					return
 				}
 
-				receipt := &ethtypes.Receipt{}
-				if err := receipt.UnmarshalBinary(txResponse.MarshalledReceipt); err != nil {
-					api.logger.Error("fail to unmarshal receipt from tx response", "error", err)
+				appendLogs(ev, evmtypes.EventTypeTxReceipt)
+			case ev, ok := <-cosmosTxLogsCh:
+				if !ok {
+					deleteFilter()
 					return
 				}
 
-				logs := FilterLogs(receipt.Logs, criteria.FromBlock, criteria.ToBlock, criteria.Addresses, criteria.Topics)
-
-				api.filtersMu.Lock()
-				if f, found := api.filters[filterID]; found {
-					f.logs = append(f.logs, logs...)
-				}
-				api.filtersMu.Unlock()
+				appendLogs(ev, evmtypes.EventTypeCosmosTxLogs)
 			case <-logsSub.Err():
-				api.filtersMu.Lock()
-				delete(api.filters, filterID)
-				api.filtersMu.Unlock()
+				deleteFilter()
+				return
+			case <-cosmosTxLogsSub.Err():
+				deleteFilter()
 				return
 			}
 		}
-	}(logsSub.eventCh)
+	}(logsSub.eventCh, cosmosTxLogsSub.eventCh)
 
 	return filterID, err
 }
 
+// filterLogsFromEvent returns the logs, matching the given criteria, from the tx result event.
+// Only the logs from the tx events of the given type are returned,
+// since a tx can match both the subscriptions of Ethereum tx logs and Cosmos tx logs.
+func (api *PublicFilterAPI) filterLogsFromEvent(ev cmtrpctypes.ResultEvent, eventType string, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+	// get transaction result data
+	dataTx, ok := ev.Data.(cmttypes.EventDataTx)
+	if !ok {
+		api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+		return nil, nil
+	}
+
+	var events []abci.Event
+	for _, event := range dataTx.TxResult.Result.Events {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+
+	txLogs, err := backend.AllTxLogsFromEvents(events)
+	if err != nil {
+		return nil, err
+	}
+
+	var unfiltered []*ethtypes.Log
+	for _, logs := range txLogs {
+		unfiltered = append(unfiltered, logs...)
+	}
+
+	return FilterLogs(unfiltered, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics), nil
+}
+
 // GetLogs returns logs matching the given argument that are stored within the state.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
@@ -568,7 +623,7 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	if !found {
 		return false
 	}
-	f.s.Unsubscribe(api.events)
+	f.unsubscribe(api.events)
 	return true
 }
 
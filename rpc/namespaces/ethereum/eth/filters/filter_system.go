@@ -33,6 +33,11 @@ var (
 		cmttypes.EventTx,
 		sdk.EventTypeMessage,
 		sdk.AttributeKeyModule, evmtypes.ModuleName)).String()
+	cosmosTxLogsEvents = cmtquery.MustCompile(fmt.Sprintf("%s='%s' AND %s.%s EXISTS",
+		cmttypes.EventTypeKey,
+		cmttypes.EventTx,
+		evmtypes.EventTypeCosmosTxLogs,
+		evmtypes.AttributeKeyReceiptCometBFTTxHash)).String()
 	headerEvents = cmttypes.QueryForEvent(cmttypes.EventNewBlockHeader).String()
 )
 
@@ -150,6 +155,24 @@ func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.Unsub
 // given criteria to the given logs channel. Default value for the from and to
 // block is "latest". If the fromBlock > toBlock an error is returned.
 func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription, pubsub.UnsubscribeFunc, error) {
+	if err := validateLogsCriteria(crit); err != nil {
+		return nil, nil, err
+	}
+	return es.subscribeLogs(crit, evmEvents)
+}
+
+// SubscribeCosmosTxLogs creates a subscription that will write all logs, emitted by Cosmos txs, matching the
+// given criteria to the given logs channel. Default value for the from and to
+// block is "latest". If the fromBlock > toBlock an error is returned.
+func (es *EventSystem) SubscribeCosmosTxLogs(crit filters.FilterCriteria) (*Subscription, pubsub.UnsubscribeFunc, error) {
+	if err := validateLogsCriteria(crit); err != nil {
+		return nil, nil, err
+	}
+	return es.subscribeLogs(crit, cosmosTxLogsEvents)
+}
+
+// validateLogsCriteria returns error if the from and to block combination of the criteria is invalid.
+func validateLogsCriteria(crit filters.FilterCriteria) error {
 	var from, to rpc.BlockNumber
 	if crit.FromBlock == nil {
 		from = rpc.LatestBlockNumber
@@ -168,20 +191,20 @@ func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription
 	case (from == rpc.LatestBlockNumber && to == rpc.LatestBlockNumber),
 		(from >= 0 && to >= 0 && to >= from),
 		(from >= 0 && to == rpc.LatestBlockNumber):
-		return es.subscribeLogs(crit)
+		return nil
 
 	default:
-		return nil, nil, fmt.Errorf("invalid from and to block combination: from > to (%d > %d)", from, to)
+		return fmt.Errorf("invalid from and to block combination: from > to (%d > %d)", from, to)
 	}
 }
 
-// subscribeLogs creates a subscription that will write all logs matching the
+// subscribeLogs creates a subscription to the given event that will write all logs matching the
 // given criteria to the given logs channel.
-func (es *EventSystem) subscribeLogs(crit filters.FilterCriteria) (*Subscription, pubsub.UnsubscribeFunc, error) {
+func (es *EventSystem) subscribeLogs(crit filters.FilterCriteria, event string) (*Subscription, pubsub.UnsubscribeFunc, error) {
 	sub := &Subscription{
 		id:        rpc.NewID(),
 		typ:       filters.LogsSubscription,
-		event:     evmEvents,
+		event:     event,
 		logsCrit:  crit,
 		created:   time.Now().UTC(),
 		logs:      make(chan []*ethtypes.Log),
@@ -10,7 +10,11 @@ import (
 
 	"cosmossdk.io/log"
 	"github.com/EscanBE/evermint/rpc/ethereum/pubsub"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -81,3 +85,45 @@ func newSubscription(id string, event string) *Subscription {
 		err:       make(chan error),
 	}
 }
+
+func TestCosmosTxLogsEventsQuery(t *testing.T) {
+	query := cmtquery.MustCompile(cosmosTxLogsEvents)
+
+	tests := []struct {
+		name   string
+		events map[string][]string
+		want   bool
+	}{
+		{
+			name: "match Cosmos tx emitted logs",
+			events: map[string][]string{
+				cmttypes.EventTypeKey: {cmttypes.EventTx},
+				fmt.Sprintf("%s.%s", evmtypes.EventTypeCosmosTxLogs, evmtypes.AttributeKeyReceiptCometBFTTxHash): {"0x1"},
+			},
+			want: true,
+		},
+		{
+			name: "not match Cosmos tx without logs",
+			events: map[string][]string{
+				cmttypes.EventTypeKey: {cmttypes.EventTx},
+				fmt.Sprintf("%s.%s", sdk.EventTypeMessage, sdk.AttributeKeyModule): {"bank"},
+			},
+			want: false,
+		},
+		{
+			name: "not match Ethereum tx",
+			events: map[string][]string{
+				cmttypes.EventTypeKey: {cmttypes.EventTx},
+				fmt.Sprintf("%s.%s", sdk.EventTypeMessage, sdk.AttributeKeyModule): {evmtypes.ModuleName},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := query.Matches(tt.events)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, matches)
+		})
+	}
+}
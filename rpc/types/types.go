@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -82,20 +84,58 @@ type RPCReceipt struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type StateOverride = evmtypes.StateOverride
+
+// OverrideAccount indicates the overriding fields of account during the execution of a message call.
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override during the execution of a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config of the `debug_traceCall`, it extends the TraceConfig with the overrides.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+}
+
+// AccessListResult is the result of the `eth_createAccessList`.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// SimulatedBlockResult is the result of a simulated block of the `eth_simulateV1`.
+type SimulatedBlockResult struct {
+	Number        hexutil.Uint64        `json:"number"`
+	Hash          common.Hash           `json:"hash"`
+	ParentHash    common.Hash           `json:"parentHash"`
+	Timestamp     hexutil.Uint64        `json:"timestamp"`
+	GasLimit      hexutil.Uint64        `json:"gasLimit"`
+	GasUsed       hexutil.Uint64        `json:"gasUsed"`
+	Miner         common.Address        `json:"miner"`
+	BaseFeePerGas *hexutil.Big          `json:"baseFeePerGas"`
+	Calls         []SimulatedCallResult `json:"calls"`
+}
+
+// SimulatedCallResult is the result of a simulated call of the `eth_simulateV1`.
+type SimulatedCallResult struct {
+	ReturnData hexutil.Bytes       `json:"returnData"`
+	Logs       []*ethtypes.Log     `json:"logs"`
+	GasUsed    hexutil.Uint64      `json:"gasUsed"`
+	Status     hexutil.Uint64      `json:"status"`
+	Error      *SimulatedCallError `json:"error,omitempty"`
+}
+
+// SimulateVMErrorCode is the JSON error code of a simulated call failed with an EVM error other than revert.
+const SimulateVMErrorCode = -32015
+
+// SimulatedCallError is the error of a failed simulated call of the `eth_simulateV1`.
+type SimulatedCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
 }
 
 type FeeHistoryResult struct {
@@ -27,6 +27,7 @@ import (
 	cmtjrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/EscanBE/evermint/rpc/backend"
 	"github.com/EscanBE/evermint/rpc/ethereum/pubsub"
 	rpcfilters "github.com/EscanBE/evermint/rpc/namespaces/ethereum/eth/filters"
 	"github.com/EscanBE/evermint/rpc/types"
@@ -560,9 +561,41 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		return nil, err
 	}
 
+	cosmosTxLogsSub, unsubCosmosTxLogsFn, err := api.events.SubscribeCosmosTxLogs(crit)
+	if err != nil {
+		unsubFn()
+		api.logger.Error("failed to subscribe logs of Cosmos txs", "error", err.Error())
+		return nil, err
+	}
+
+	writeLogs := func(unfiltered []*ethtypes.Log) {
+		logs := rpcfilters.FilterLogs(unfiltered, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
+		for _, ethLog := range logs {
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       ethLog,
+				},
+			}
+
+			err := wsConn.WriteJSON(res)
+			if err != nil {
+				try(func() {
+					if err != websocket.ErrCloseSent {
+						_ = wsConn.Close() // #nosec G703
+					}
+				}, api.logger, "closing websocket peer sub")
+			}
+		}
+	}
+
 	go func() {
 		ch := sub.Event()
 		errCh := sub.Err()
+		cosmosTxLogsCh := cosmosTxLogsSub.Event()
+		cosmosTxLogsErrCh := cosmosTxLogsSub.Err()
 		for {
 			select {
 			case event, ok := <-ch:
@@ -588,40 +621,49 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					return
 				}
 
-				logs := rpcfilters.FilterLogs(receipt.Logs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-				if len(logs) == 0 {
+				writeLogs(receipt.Logs)
+			case event, ok := <-cosmosTxLogsCh:
+				if !ok {
+					return
+				}
+
+				dataTx, ok := event.Data.(cmttypes.EventDataTx)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
 					continue
 				}
 
-				for _, ethLog := range logs {
-					res := &SubscriptionNotification{
-						Jsonrpc: "2.0",
-						Method:  "eth_subscription",
-						Params: &SubscriptionResult{
-							Subscription: subID,
-							Result:       ethLog,
-						},
+				for _, txEvent := range dataTx.TxResult.Result.Events {
+					if txEvent.Type != evmtypes.EventTypeCosmosTxLogs {
+						continue
 					}
 
-					err = wsConn.WriteJSON(res)
+					logs, err := backend.ParseCosmosTxLogsFromEvent(txEvent)
 					if err != nil {
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close() // #nosec G703
-							}
-						}, api.logger, "closing websocket peer sub")
+						api.logger.Error("failed to parse logs of Cosmos tx", "error", err.Error())
+						continue
 					}
+
+					writeLogs(logs)
 				}
 			case err, ok := <-errCh:
 				if !ok {
 					return
 				}
 				api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			case err, ok := <-cosmosTxLogsErrCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
 			}
 		}
 	}()
 
-	return unsubFn, nil
+	return func() {
+		unsubFn()
+		unsubCosmosTxLogsFn()
+	}, nil
 }
 
 func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
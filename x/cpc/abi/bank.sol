@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct Coin {
+    string denom;
+    uint256 amount;
+}
+
+struct DenomUnit {
+    string denom;
+    uint32 exponent;
+    string[] aliases;
+}
+
+struct DenomMetadata {
+    string description;
+    DenomUnit[] denomUnits;
+    string base;
+    string display;
+    string name;
+    string symbol;
+    string uri;
+    string uriHash;
+}
+
+/**
+ * Access to all the balances of `x/bank` module, including the IBC vouchers `ibc/{hash}`,
+ * without deploying an ERC-20 contract per denom.
+ */
+interface IBankCPC {
+    /**
+     * @dev Emitted when `amount` of `denom` are moved from one account (`from`) to another (`to`).
+     */
+    event Send(address indexed from, address indexed to, string denom, uint256 amount);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns all the balances of the account.
+     */
+    function balances(address account) external view returns (Coin[] memory);
+
+    /**
+     * @dev Returns the balance of the account for the given denom.
+     */
+    function balanceOf(address account, string memory denom) external view returns (uint256);
+
+    /**
+     * @dev Returns the total supply of the given denom.
+     */
+    function supplyOf(string memory denom) external view returns (uint256);
+
+    /**
+     * @dev Returns the metadata of the given denom.
+     */
+    function denomMetadata(string memory denom) external view returns (DenomMetadata memory);
+
+    /**
+     * @dev Moves the `coins` from the caller's account to `to`.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Send} event per coin,
+     * plus the {IERC20-Transfer} event of the ERC-20 contract if the denom is backed by one.
+     */
+    function send(address to, Coin[] memory coins) external returns (bool);
+}
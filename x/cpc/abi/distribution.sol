@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+/**
+ * Access to the `x/distribution` module, complements the staking contract
+ * with withdraw address, validator commission and community pool operations.
+ * All the amounts are in the bond denom.
+ */
+interface IDistributionCPC {
+    /**
+     * @dev Emitted when the withdraw address of the delegator is changed.
+     */
+    event SetWithdrawAddress(address indexed delegator, address indexed withdrawAddress);
+
+    /**
+     * @dev Emitted when the validator withdraws the accumulated commission.
+     */
+    event WithdrawValidatorCommission(address indexed validator, uint256 amount);
+
+    /**
+     * @dev Emitted when the depositor funds the community pool.
+     */
+    event FundCommunityPool(address indexed depositor, uint256 amount);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Sets the address which receives the staking rewards of the caller.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {SetWithdrawAddress} event.
+     */
+    function setWithdrawAddress(address withdrawAddress) external returns (bool);
+
+    /**
+     * @dev Withdraws the accumulated commission of the validator operated by the caller.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {WithdrawValidatorCommission} event.
+     */
+    function withdrawValidatorCommission() external returns (bool);
+
+    /**
+     * @dev Moves `amount` from the caller's account to the community pool.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {FundCommunityPool} event.
+     */
+    function fundCommunityPool(uint256 amount) external returns (bool);
+
+    /**
+     * @dev Returns the accumulated commission of the validator, not yet withdrawn.
+     */
+    function validatorCommission(address validator) external view returns (uint256);
+
+    /**
+     * @dev Returns the outstanding rewards of the validator, include commission and rewards of delegators.
+     */
+    function validatorOutstandingRewards(address validator) external view returns (uint256);
+}
@@ -4,7 +4,7 @@ pragma solidity >=0.7.0 <0.9.0;
 
 /**
  * @dev Interface of the ERC-20 Custom-Precompiled-Contracts, follows standard as defined in the ERC,
- * plus Burnable.
+ * plus Burnable and Permit (EIP-2612).
  */
 interface IERC20CPC {
     // Standard
@@ -79,6 +79,33 @@ interface IERC20CPC {
      */
     function transferFrom(address from, address to, uint256 value) external returns (bool);
 
+    // Allowance, from protocol version 2
+
+    /**
+     * @dev Atomically increases the allowance granted to `spender` by the caller,
+     * by `addedValue`.
+     *
+     * Emits an {Approval} event indicating the updated allowance.
+     *
+     * Requirements:
+     *
+     * - `spender` cannot be the zero address.
+     */
+    function increaseAllowance(address spender, uint256 addedValue) external returns (bool);
+
+    /**
+     * @dev Atomically decreases the allowance granted to `spender` by the caller,
+     * by `requestedDecrease`.
+     *
+     * Emits an {Approval} event indicating the updated allowance.
+     *
+     * Requirements:
+     *
+     * - `spender` cannot be the zero address.
+     * - `spender` must have allowance for the caller of at least `requestedDecrease`.
+     */
+    function decreaseAllowance(address spender, uint256 requestedDecrease) external returns (bool);
+
     // Meta
 
     /**
@@ -115,4 +142,45 @@ interface IERC20CPC {
      * `value`.
      */
     function burnFrom(address account, uint256 value) external;
+
+    // Permit (EIP-2612)
+
+    /**
+     * @dev Sets `value` as the allowance of `spender` over ``owner``'s tokens,
+     * given ``owner``'s signed approval.
+     *
+     * Emits an {Approval} event.
+     *
+     * Requirements:
+     *
+     * - `spender` cannot be the zero address.
+     * - `deadline` must be a timestamp in the future.
+     * - `v`, `r` and `s` must be a valid `secp256k1` signature from `owner`
+     * over the EIP712-formatted function arguments.
+     * - the signature must use ``owner``'s current nonce (see {nonces}).
+     */
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /**
+     * @dev Returns the current nonce for `owner`. This value must be
+     * included whenever a signature is generated for {permit}.
+     *
+     * Every successful call to {permit} increases ``owner``'s nonce by one. This
+     * prevents a signature from being used multiple times.
+     */
+    function nonces(address owner) external view returns (uint256);
+
+    /**
+     * @dev Returns the domain separator used in the encoding of the signature for {permit}, as defined by {EIP712}.
+     */
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() external view returns (bytes32);
 }
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct WeightedVoteOption {
+    uint8 option;
+    uint256 weight;
+}
+
+struct Proposal {
+    uint64 id;
+    uint8 status;
+    address proposer;
+    string title;
+    string summary;
+    string metadata;
+    bool expedited;
+    uint64 submitTime;
+    uint64 depositEndTime;
+    uint64 votingStartTime;
+    uint64 votingEndTime;
+    uint256 totalDeposit;
+}
+
+struct GovMessage {
+    string action;
+    address account;
+    uint64 proposalId;
+    string options;
+    uint256 amount;
+    string denom;
+}
+
+/**
+ * Vote options:
+ * - 1: Yes
+ * - 2: Abstain
+ * - 3: No
+ * - 4: No with veto
+ *
+ * Proposal status:
+ * - 1: Deposit period
+ * - 2: Voting period
+ * - 3: Passed
+ * - 4: Rejected
+ * - 5: Failed
+ *
+ * Weights have 18 decimals places, 1e18 means 100%.
+ * Deposit amounts are in the staking denom.
+ */
+interface IGovCPC {
+    /**
+     * @dev Emitted when a new proposal is submitted by the proposer.
+     */
+    event SubmitProposal(address indexed proposer, uint64 indexed proposalId);
+
+    /**
+     * @dev Emitted when the voter voted on a proposal, one event per vote option.
+     */
+    event Vote(address indexed voter, uint64 indexed proposalId, uint8 option, uint256 weight);
+
+    /**
+     * @dev Emitted when the depositor deposited to a proposal.
+     * `value` is deposit amount.
+     */
+    event Deposit(address indexed depositor, uint64 indexed proposalId, uint256 value);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns the proposal by id.
+     */
+    function proposal(uint64 proposalId) external view returns (Proposal memory);
+
+    /**
+     * @dev Returns the tally result of a proposal.
+     * When the proposal is in voting period, the current tally is returned.
+     */
+    function tally(uint64 proposalId) external view returns (uint256 yes, uint256 abstain, uint256 no, uint256 noWithVeto);
+
+    /**
+     * @dev Submit a new proposal with the caller's account as proposer.
+     * `messages` is the JSON array of the proposal messages, same format as the `messages` field of the proposal file used by CLI.
+     *
+     * Returns the id of the new proposal.
+     *
+     * Emits a {SubmitProposal} + {?Deposit} events.
+     */
+    function submitProposal(string memory messages, string memory metadata, string memory title, string memory summary, uint256 initialDeposit) external returns (uint64);
+
+    /**
+     * @dev Vote on a proposal using the caller's account.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Vote} event.
+     */
+    function vote(uint64 proposalId, uint8 option) external returns (bool);
+
+    /**
+     * @dev Vote on a proposal with multiple weighted options using the caller's account.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits multiple {Vote} events, one per option.
+     */
+    function voteWeighted(uint64 proposalId, WeightedVoteOption[] memory options) external returns (bool);
+
+    /**
+     * @dev Deposit a `value` amount of staking coin from the caller's account to a proposal.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {Deposit} event.
+     */
+    function deposit(uint64 proposalId, uint256 value) external returns (bool);
+
+    /**
+     * @dev Vote/Deposit using EIP-712:
+     * - Vote: `options` is the weighted vote options, like `yes` or `yes=0.6,no=0.4`. `amount` must be zero and `denom` must be `-`.
+     * - Deposit: `amount` of `denom` will be deposited. `options` must be `-`.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits {Vote} or {Deposit} events.
+     */
+    function voteOrDepositByActionMessage(GovMessage memory message, bytes32 r, bytes32 s, uint8 v) external returns (bool);
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct Height {
+    uint64 revisionNumber;
+    uint64 revisionHeight;
+}
+
+/**
+ * ICS-20 fungible token transfer over IBC.
+ *
+ * Denoms are the Cosmos denoms, like the bond denom or the IBC vouchers `ibc/{hash}`.
+ * Either `timeoutHeight` or `timeoutTimestamp` (nanoseconds) must be set, zero means disabled.
+ *
+ * When the transfer failed on the counterparty chain or timed out, the tokens are refunded to the sender
+ * and a {IbcTransferRefund} event is emitted, by the Cosmos tx which relayed the acknowledgement or timeout.
+ */
+interface IIbcTransferCPC {
+    /**
+     * @dev Emitted when the sender started an IBC transfer.
+     */
+    event IbcTransfer(address indexed sender, uint64 indexed sequence, string sourcePort, string sourceChannel, string denom, uint256 amount, string receiver, string memo);
+
+    /**
+     * @dev Emitted when the tokens of a failed or timed out IBC transfer were refunded to the sender.
+     */
+    event IbcTransferRefund(address indexed sender, uint64 indexed sequence, string sourcePort, string sourceChannel, string denom, uint256 amount);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Transfer `amount` of `denom` from the caller's account to the `receiver` on the counterparty chain.
+     *
+     * Returns the sequence of the sent packet.
+     *
+     * Emits a {IbcTransfer} event.
+     */
+    function transfer(string memory sourcePort, string memory sourceChannel, string memory denom, uint256 amount, string memory receiver, Height memory timeoutHeight, uint64 timeoutTimestamp, string memory memo) external returns (uint64 sequence);
+
+    /**
+     * @dev Returns the trace of an IBC voucher denom, like `ibc/{hash}` or the hash itself.
+     */
+    function denomTrace(string memory denom) external view returns (string memory path, string memory baseDenom);
+
+    /**
+     * @dev Returns the escrow address of the given port and channel.
+     */
+    function escrowAddress(string memory sourcePort, string memory sourceChannel) external view returns (address);
+}
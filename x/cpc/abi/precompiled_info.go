@@ -19,6 +19,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 type CustomPrecompiledContractInfo struct {
@@ -75,6 +78,36 @@ var (
 	bech32Json []byte
 
 	Bech32CpcInfo CustomPrecompiledContractInfo
+
+	//go:embed gov.abi.json
+	govJson []byte
+
+	GovCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed ibc_transfer.abi.json
+	ibcTransferJson []byte
+
+	IbcTransferCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed bank.abi.json
+	bankJson []byte
+
+	BankCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed distribution.abi.json
+	distributionJson []byte
+
+	DistributionCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed vesting.abi.json
+	vestingJson []byte
+
+	VestingCpcInfo CustomPrecompiledContractInfo
+
+	//go:embed vauth.abi.json
+	vauthJson []byte
+
+	VAuthCpcInfo CustomPrecompiledContractInfo
 )
 
 func init() {
@@ -97,6 +130,42 @@ func init() {
 		panic(err)
 	}
 	Bech32CpcInfo.Name = "Bech32"
+
+	err = json.Unmarshal(govJson, &GovCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	GovCpcInfo.Name = "Gov"
+
+	err = json.Unmarshal(ibcTransferJson, &IbcTransferCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	IbcTransferCpcInfo.Name = "IBC Transfer"
+
+	err = json.Unmarshal(bankJson, &BankCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	BankCpcInfo.Name = "Bank"
+
+	err = json.Unmarshal(distributionJson, &DistributionCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	DistributionCpcInfo.Name = "Distribution"
+
+	err = json.Unmarshal(vestingJson, &VestingCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	VestingCpcInfo.Name = "Vesting"
+
+	err = json.Unmarshal(vauthJson, &VAuthCpcInfo)
+	if err != nil {
+		panic(err)
+	}
+	VAuthCpcInfo.Name = "VAuth"
 }
 
 // EIP-712 typed messages
@@ -250,3 +319,204 @@ func (m WithdrawRewardMessage) ToTypedData(chainId *big.Int) apitypes.TypedData
 		},
 	}
 }
+
+var _ eip712.TypedMessage = (*PermitMessage)(nil)
+
+// PermitMessage is the EIP-2612 permit message of the ERC-20 custom precompiled contracts.
+type PermitMessage struct {
+	TokenName string         `json:"-"`
+	Contract  common.Address `json:"-"`
+	Owner     common.Address `json:"owner"`
+	Spender   common.Address `json:"spender"`
+	Value     *big.Int       `json:"value"`
+	Nonce     *big.Int       `json:"nonce"`
+	Deadline  *big.Int       `json:"deadline"`
+}
+
+func (m PermitMessage) ToTypedData(chainId *big.Int) apitypes.TypedData {
+	const primaryTypeName = "Permit"
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			eip712.PrimaryTypeNameEIP712Domain: eip712.GetEip2612DomainTypes(),
+			primaryTypeName: []apitypes.Type{
+				{"owner", "address"},
+				{"spender", "address"},
+				{"value", "uint256"},
+				{"nonce", "uint256"},
+				{"deadline", "uint256"},
+			},
+		},
+		PrimaryType: primaryTypeName,
+		Domain:      eip712.GetEip2612Domain(m.TokenName, m.Contract, chainId),
+		Message: apitypes.TypedDataMessage{
+			"owner":    m.Owner.String(),
+			"spender":  m.Spender.String(),
+			"value":    (*cmath.HexOrDecimal256)(m.Value),
+			"nonce":    (*cmath.HexOrDecimal256)(m.Nonce),
+			"deadline": (*cmath.HexOrDecimal256)(m.Deadline),
+		},
+	}
+}
+
+var _ eip712.TypedMessage = (*GovMessage)(nil)
+
+const (
+	GovMessageActionVote    = "Vote"
+	GovMessageActionDeposit = "Deposit"
+
+	govMessageEmptyValue = "-"
+)
+
+type GovMessage struct {
+	Action     string         `json:"action"`
+	Account    common.Address `json:"account"`
+	ProposalId uint64         `json:"proposalId"`
+	Options    string         `json:"options"`
+	Amount     *big.Int       `json:"amount"`
+	Denom      string         `json:"denom"`
+}
+
+func (m *GovMessage) FromUnpackedStruct(v any) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, m)
+}
+
+func (m GovMessage) Validate(bondDenom string) error {
+	if m.Account == (common.Address{}) {
+		return fmt.Errorf("account cannot be empty")
+	}
+
+	if m.ProposalId == 0 {
+		return fmt.Errorf("proposal id cannot be zero")
+	}
+
+	switch m.Action {
+	case GovMessageActionVote:
+		if _, err := m.WeightedVoteOptions(); err != nil {
+			return errorsmod.Wrapf(err, "invalid options: %s", m.Options)
+		}
+
+		if m.Amount != nil && m.Amount.Sign() != 0 {
+			return fmt.Errorf("amount must be zero for action: %s", m.Action)
+		}
+
+		if m.Denom != govMessageEmptyValue {
+			return fmt.Errorf("denom must be empty for action: %s", m.Action)
+		}
+	case GovMessageActionDeposit:
+		if m.Options != govMessageEmptyValue {
+			return fmt.Errorf("options must be empty for action: %s", m.Action)
+		}
+
+		if m.Amount == nil || m.Amount.Sign() != 1 {
+			return fmt.Errorf("amount must be positive")
+		}
+
+		if m.Denom != bondDenom {
+			return fmt.Errorf("denom must be: %s", bondDenom)
+		}
+	default:
+		return fmt.Errorf("unknown action: %s", m.Action)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptions parses the options, accept the same format as CLI, like `yes` or `yes=0.6,no=0.4`.
+func (m GovMessage) WeightedVoteOptions() (govv1.WeightedVoteOptions, error) {
+	return govv1.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(m.Options))
+}
+
+func (m GovMessage) ToTypedData(chainId *big.Int) apitypes.TypedData {
+	const primaryTypeName = "GovMessage"
+	amount := m.Amount
+	if amount == nil {
+		amount = new(big.Int)
+	}
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			eip712.PrimaryTypeNameEIP712Domain: eip712.GetDomainTypes(),
+			primaryTypeName: []apitypes.Type{
+				{"action", "string"},
+				{"account", "address"},
+				{"proposalId", "uint64"},
+				{"options", "string"},
+				{"amount", "uint256"},
+				{"denom", "string"},
+			},
+		},
+		PrimaryType: primaryTypeName,
+		Domain:      eip712.GetDomain(cpctypes.CpcGovFixedAddress, chainId),
+		Message: apitypes.TypedDataMessage{
+			"action":     m.Action,
+			"account":    m.Account.String(),
+			"proposalId": (*cmath.HexOrDecimal256)(new(big.Int).SetUint64(m.ProposalId)),
+			"options":    m.Options,
+			"amount":     (*cmath.HexOrDecimal256)(amount),
+			"denom":      m.Denom,
+		},
+	}
+}
+
+// GovWeightedVoteOption is the weighted vote option of the gov custom precompiled contract,
+// weight has 18 decimals places.
+type GovWeightedVoteOption struct {
+	Option uint8    `json:"option"`
+	Weight *big.Int `json:"weight"`
+}
+
+// GovProposal is the output of the `proposal` method of the gov custom precompiled contract.
+type GovProposal struct {
+	Id              uint64
+	Status          uint8
+	Proposer        common.Address
+	Title           string
+	Summary         string
+	Metadata        string
+	Expedited       bool
+	SubmitTime      uint64
+	DepositEndTime  uint64
+	VotingStartTime uint64
+	VotingEndTime   uint64
+	TotalDeposit    *big.Int
+}
+
+// IbcTransferHeight is the timeout height input of the `transfer` method of the IBC transfer custom precompiled contract.
+type IbcTransferHeight struct {
+	RevisionNumber uint64 `json:"revisionNumber"`
+	RevisionHeight uint64 `json:"revisionHeight"`
+}
+
+// BankCoin is the coin of the bank custom precompiled contract.
+type BankCoin struct {
+	Denom  string   `json:"denom"`
+	Amount *big.Int `json:"amount"`
+}
+
+// BankDenomUnit is the denom unit of the bank custom precompiled contract.
+type BankDenomUnit struct {
+	Denom    string
+	Exponent uint32
+	Aliases  []string
+}
+
+// BankDenomMetadata is the output of the `denomMetadata` method of the bank custom precompiled contract.
+type BankDenomMetadata struct {
+	Description string
+	DenomUnits  []BankDenomUnit
+	Base        string
+	Display     string
+	Name        string
+	Symbol      string
+	Uri         string
+	UriHash     string
+}
+
+// VestingPeriod is the period input of the `createPeriodicVestingAccount` method of the vesting custom precompiled contract.
+type VestingPeriod struct {
+	Length int64      `json:"length"`
+	Amount []BankCoin `json:"amount"`
+}
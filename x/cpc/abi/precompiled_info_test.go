@@ -2,6 +2,7 @@ package abi
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -671,6 +672,154 @@ func Test_Bech32(t *testing.T) {
 	})
 }
 
+func Test_Gov(t *testing.T) {
+	cpcInfo := GovCpcInfo
+
+	t.Run("voteWeighted(uint64,(uint8,uint256)[])", func(t *testing.T) {
+		options := []GovWeightedVoteOption{
+			{Option: 1, Weight: big.NewInt(6e17)},
+			{Option: 3, Weight: big.NewInt(4e17)},
+		}
+		bz, err := cpcInfo.ABI.Methods["voteWeighted"].Inputs.Pack(uint64(1), options)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"voteWeighted",
+			append([]byte{0xb3, 0x55, 0xa9, 0x1e}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, uint64(1), ret[0].(uint64))
+	})
+
+	t.Run("voteOrDepositByActionMessage(GovMessage,bytes32,bytes32,uint8)", func(t *testing.T) {
+		messages := []GovMessage{
+			{
+				Action:     GovMessageActionVote,
+				Account:    common.BytesToAddress([]byte("account")),
+				ProposalId: math.MaxUint64,
+				Options:    "yes=0.6,no_with_veto=0.4",
+				Amount:     big.NewInt(0),
+				Denom:      "-",
+			},
+			{
+				Action:     GovMessageActionDeposit,
+				Account:    common.BytesToAddress([]byte("account")),
+				ProposalId: 1,
+				Options:    "-",
+				Amount:     bigIntMaxUint64,
+				Denom:      constants.BaseDenom,
+			},
+		}
+		for _, message := range messages {
+			require.Nil(t, message.Validate(constants.BaseDenom))
+			bz, err := cpcInfo.ABI.Methods["voteOrDepositByActionMessage"].Inputs.Pack(message, toByte32(bigIntMaxInt64Bz), toByte32(bigIntMaxUint64Bz), uint8(math.MaxUint8))
+			require.NoError(t, err)
+
+			ret, err := cpcInfo.UnpackMethodInput(
+				"voteOrDepositByActionMessage",
+				append([]byte{0xf4, 0x13, 0x99, 0x46}, bz...),
+			)
+			require.NoError(t, err)
+			require.Len(t, ret, 4)
+			decodedMessage := &GovMessage{}
+			require.NoError(t, decodedMessage.FromUnpackedStruct(ret[0]))
+			require.Equal(t, message, *decodedMessage)
+			require.Equal(t, toByte32(bigIntMaxInt64Bz), ret[1].([32]byte))
+			require.Equal(t, toByte32(bigIntMaxUint64Bz), ret[2].([32]byte))
+			require.Equal(t, uint8(math.MaxUint8), ret[3].(uint8))
+		}
+	})
+
+	t.Run("GovMessage validation", func(t *testing.T) {
+		validVote := GovMessage{
+			Action:     GovMessageActionVote,
+			Account:    common.BytesToAddress([]byte("account")),
+			ProposalId: 1,
+			Options:    "yes",
+			Amount:     big.NewInt(0),
+			Denom:      "-",
+		}
+		validDeposit := GovMessage{
+			Action:     GovMessageActionDeposit,
+			Account:    common.BytesToAddress([]byte("account")),
+			ProposalId: 1,
+			Options:    "-",
+			Amount:     big.NewInt(1),
+			Denom:      constants.BaseDenom,
+		}
+
+		tests := []struct {
+			name            string
+			modifier        func(GovMessage) GovMessage
+			base            GovMessage
+			wantErrContains string
+		}{
+			{
+				name:            "unknown action",
+				base:            validVote,
+				modifier:        func(m GovMessage) GovMessage { m.Action = "Cancel"; return m },
+				wantErrContains: "unknown action",
+			},
+			{
+				name:            "empty account",
+				base:            validVote,
+				modifier:        func(m GovMessage) GovMessage { m.Account = common.Address{}; return m },
+				wantErrContains: "account cannot be empty",
+			},
+			{
+				name:            "zero proposal id",
+				base:            validVote,
+				modifier:        func(m GovMessage) GovMessage { m.ProposalId = 0; return m },
+				wantErrContains: "proposal id cannot be zero",
+			},
+			{
+				name:            "vote with invalid options",
+				base:            validVote,
+				modifier:        func(m GovMessage) GovMessage { m.Options = "maybe"; return m },
+				wantErrContains: "invalid options",
+			},
+			{
+				name:            "vote with amount",
+				base:            validVote,
+				modifier:        func(m GovMessage) GovMessage { m.Amount = big.NewInt(1); return m },
+				wantErrContains: "amount must be zero",
+			},
+			{
+				name:            "vote with denom",
+				base:            validVote,
+				modifier:        func(m GovMessage) GovMessage { m.Denom = constants.BaseDenom; return m },
+				wantErrContains: "denom must be empty",
+			},
+			{
+				name:            "deposit with options",
+				base:            validDeposit,
+				modifier:        func(m GovMessage) GovMessage { m.Options = "yes"; return m },
+				wantErrContains: "options must be empty",
+			},
+			{
+				name:            "deposit zero amount",
+				base:            validDeposit,
+				modifier:        func(m GovMessage) GovMessage { m.Amount = big.NewInt(0); return m },
+				wantErrContains: "amount must be positive",
+			},
+			{
+				name:            "deposit non-bond denom",
+				base:            validDeposit,
+				modifier:        func(m GovMessage) GovMessage { m.Denom = "uatom"; return m },
+				wantErrContains: "denom must be",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := tt.modifier(tt.base).Validate(constants.BaseDenom)
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.wantErrContains)
+			})
+		}
+	})
+}
+
 func simpleBuildMethodInput(sig []byte, args ...any) []byte {
 	if len(sig) != 4 {
 		panic("signature must be 4 bytes")
@@ -701,3 +850,303 @@ func toByte32(bz []byte) [32]byte {
 	copy(ret[:], bz)
 	return ret
 }
+
+func Test_IbcTransfer(t *testing.T) {
+	cpcInfo := IbcTransferCpcInfo
+
+	t.Run("transfer(string,string,string,uint256,string,(uint64,uint64),uint64,string)", func(t *testing.T) {
+		timeoutHeight := IbcTransferHeight{
+			RevisionNumber: 1,
+			RevisionHeight: math.MaxUint64,
+		}
+		bz, err := cpcInfo.ABI.Methods["transfer"].Inputs.Pack(
+			"transfer", "channel-0", constants.BaseDenom, bigIntMaxUint64, "receiver", timeoutHeight, uint64(math.MaxUint64), text,
+		)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"transfer",
+			append([]byte{0x39, 0x66, 0x9b, 0xdb}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 8)
+		require.Equal(t, "transfer", ret[0].(string))
+		require.Equal(t, "channel-0", ret[1].(string))
+		require.Equal(t, constants.BaseDenom, ret[2].(string))
+		require.Equal(t, bigIntMaxUint64, ret[3].(*big.Int))
+		require.Equal(t, "receiver", ret[4].(string))
+		require.Equal(t, uint64(math.MaxUint64), ret[6].(uint64))
+		require.Equal(t, text, ret[7].(string))
+
+		var gotTimeoutHeight IbcTransferHeight
+		bz, err = json.Marshal(ret[5])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &gotTimeoutHeight))
+		require.Equal(t, timeoutHeight, gotTimeoutHeight)
+	})
+
+	t.Run("denomTrace(string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["denomTrace"].Inputs.Pack(text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"denomTrace",
+			append([]byte{0xa8, 0x15, 0xcd, 0xd9}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, text, ret[0].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("denomTrace", "transfer/channel-0", constants.BaseDenom)
+		require.NoError(t, err)
+		rets, err := cpcInfo.ABI.Methods["denomTrace"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Equal(t, []any{"transfer/channel-0", constants.BaseDenom}, rets)
+	})
+
+	t.Run("escrowAddress(string,string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["escrowAddress"].Inputs.Pack("transfer", "channel-0")
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"escrowAddress",
+			append([]byte{0x74, 0x07, 0x76, 0xce}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, "transfer", ret[0].(string))
+		require.Equal(t, "channel-0", ret[1].(string))
+	})
+}
+
+func Test_Bank(t *testing.T) {
+	cpcInfo := BankCpcInfo
+
+	t.Run("send(address,(string,uint256)[])", func(t *testing.T) {
+		coins := []BankCoin{
+			{Denom: constants.BaseDenom, Amount: bigIntMaxUint64},
+			{Denom: text, Amount: big.NewInt(1)},
+		}
+		bz, err := cpcInfo.ABI.Methods["send"].Inputs.Pack(common.BytesToAddress([]byte("receiver")), coins)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"send",
+			append([]byte{0x8f, 0x7f, 0x2b, 0x20}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, common.BytesToAddress([]byte("receiver")), ret[0].(common.Address))
+
+		var gotCoins []BankCoin
+		bz, err = json.Marshal(ret[1])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &gotCoins))
+		require.Equal(t, coins, gotCoins)
+	})
+
+	t.Run("balanceOf(address,string)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["balanceOf"].Inputs.Pack(common.BytesToAddress([]byte("account")), text)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"balanceOf",
+			append([]byte{0xb9, 0xb0, 0x92, 0xc8}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, common.BytesToAddress([]byte("account")), ret[0].(common.Address))
+		require.Equal(t, text, ret[1].(string))
+
+		bz, err = cpcInfo.PackMethodOutput("balanceOf", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
+
+	t.Run("denomMetadata(string)", func(t *testing.T) {
+		metadata := BankDenomMetadata{
+			Description: text,
+			DenomUnits: []BankDenomUnit{
+				{Denom: constants.BaseDenom, Exponent: 0, Aliases: []string{text}},
+				{Denom: constants.DisplayDenom, Exponent: constants.BaseDenomExponent, Aliases: []string{}},
+			},
+			Base:    constants.BaseDenom,
+			Display: constants.DisplayDenom,
+			Name:    text,
+			Symbol:  constants.DisplayDenom,
+			Uri:     text,
+			UriHash: text,
+		}
+
+		bz, err := cpcInfo.PackMethodOutput("denomMetadata", metadata)
+		require.NoError(t, err)
+		rets, err := cpcInfo.ABI.Methods["denomMetadata"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, rets, 1)
+
+		var gotMetadata BankDenomMetadata
+		bz, err = json.Marshal(rets[0])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &gotMetadata))
+		require.Equal(t, metadata, gotMetadata)
+	})
+}
+
+func Test_Distribution(t *testing.T) {
+	cpcInfo := DistributionCpcInfo
+
+	t.Run("setWithdrawAddress(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"setWithdrawAddress",
+			simpleBuildMethodInput([]byte{0x3a, 0xb1, 0xa4, 0x94}, common.BytesToAddress([]byte("withdraw"))),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("withdraw")), ret[0].(common.Address))
+	})
+
+	t.Run("withdrawValidatorCommission()", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"withdrawValidatorCommission",
+			[]byte{0x0b, 0xde, 0x07, 0x6d},
+		)
+		require.NoError(t, err)
+		require.Empty(t, ret)
+	})
+
+	t.Run("fundCommunityPool(uint256)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"fundCommunityPool",
+			simpleBuildMethodInput([]byte{0xde, 0xe6, 0x86, 0x23}, bigIntMaxUint64),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, bigIntMaxUint64, ret[0].(*big.Int))
+	})
+
+	t.Run("validatorCommission(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"validatorCommission",
+			simpleBuildMethodInput([]byte{0x83, 0xa2, 0x50, 0x78}, common.BytesToAddress([]byte("validator"))),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("validator")), ret[0].(common.Address))
+
+		bz, err := cpcInfo.PackMethodOutput("validatorCommission", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
+
+	t.Run("validatorOutstandingRewards(address)", func(t *testing.T) {
+		ret, err := cpcInfo.UnpackMethodInput(
+			"validatorOutstandingRewards",
+			simpleBuildMethodInput([]byte{0xf2, 0x8e, 0x9b, 0x39}, common.BytesToAddress([]byte("validator"))),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 1)
+		require.Equal(t, common.BytesToAddress([]byte("validator")), ret[0].(common.Address))
+
+		bz, err := cpcInfo.PackMethodOutput("validatorOutstandingRewards", bigIntMaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, bigIntMaxUint64Bz, bz)
+	})
+}
+
+func Test_Vesting(t *testing.T) {
+	cpcInfo := VestingCpcInfo
+
+	coins := []BankCoin{
+		{Denom: constants.BaseDenom, Amount: bigIntMaxUint64},
+	}
+
+	t.Run("createVestingAccount(address,(string,uint256)[],int64,bool)", func(t *testing.T) {
+		bz, err := cpcInfo.ABI.Methods["createVestingAccount"].Inputs.Pack(common.BytesToAddress([]byte("account")), coins, int64(math.MaxInt64), true)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"createVestingAccount",
+			append([]byte{0xa3, 0x19, 0xa6, 0xc4}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 4)
+		require.Equal(t, common.BytesToAddress([]byte("account")), ret[0].(common.Address))
+		require.Equal(t, int64(math.MaxInt64), ret[2].(int64))
+		require.True(t, ret[3].(bool))
+
+		var gotCoins []BankCoin
+		bz, err = json.Marshal(ret[1])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &gotCoins))
+		require.Equal(t, coins, gotCoins)
+	})
+
+	t.Run("createPeriodicVestingAccount(address,int64,(int64,(string,uint256)[])[])", func(t *testing.T) {
+		periods := []VestingPeriod{
+			{Length: 1, Amount: coins},
+			{Length: math.MaxInt64, Amount: coins},
+		}
+		bz, err := cpcInfo.ABI.Methods["createPeriodicVestingAccount"].Inputs.Pack(common.BytesToAddress([]byte("account")), int64(1), periods)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"createPeriodicVestingAccount",
+			append([]byte{0xdc, 0x32, 0x77, 0x52}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 3)
+		require.Equal(t, common.BytesToAddress([]byte("account")), ret[0].(common.Address))
+		require.Equal(t, int64(1), ret[1].(int64))
+
+		var gotPeriods []VestingPeriod
+		bz, err = json.Marshal(ret[2])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &gotPeriods))
+		require.Equal(t, periods, gotPeriods)
+	})
+
+	t.Run("lockedCoins(address)", func(t *testing.T) {
+		bz, err := cpcInfo.PackMethodOutput("lockedCoins", coins)
+		require.NoError(t, err)
+		rets, err := cpcInfo.ABI.Methods["lockedCoins"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, rets, 1)
+
+		var gotCoins []BankCoin
+		bz, err = json.Marshal(rets[0])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bz, &gotCoins))
+		require.Equal(t, coins, gotCoins)
+	})
+}
+
+func Test_VAuth(t *testing.T) {
+	cpcInfo := VAuthCpcInfo
+
+	t.Run("submitProofExternalOwnedAccount(address,bytes)", func(t *testing.T) {
+		signature := []byte("signature")
+		bz, err := cpcInfo.ABI.Methods["submitProofExternalOwnedAccount"].Inputs.Pack(common.BytesToAddress([]byte("account")), signature)
+		require.NoError(t, err)
+
+		ret, err := cpcInfo.UnpackMethodInput(
+			"submitProofExternalOwnedAccount",
+			append([]byte{0xca, 0x33, 0x78, 0x0d}, bz...),
+		)
+		require.NoError(t, err)
+		require.Len(t, ret, 2)
+		require.Equal(t, common.BytesToAddress([]byte("account")), ret[0].(common.Address))
+		require.Equal(t, signature, ret[1].([]byte))
+	})
+
+	t.Run("proofOf(address)", func(t *testing.T) {
+		hash := common.BytesToHash([]byte("hash"))
+		signature := []byte("signature")
+		bz, err := cpcInfo.PackMethodOutput("proofOf", hash, signature)
+		require.NoError(t, err)
+		rets, err := cpcInfo.ABI.Methods["proofOf"].Outputs.Unpack(bz)
+		require.NoError(t, err)
+		require.Len(t, rets, 2)
+		require.Equal(t, [32]byte(hash), rets[0].([32]byte))
+		require.Equal(t, signature, rets[1].([]byte))
+	})
+}
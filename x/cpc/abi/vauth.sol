@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+/**
+ * Interacts with the `x/vauth` module, which holds the proofs that accounts are external owned account (EOA).
+ * Such proof is required to create vesting accounts.
+ */
+interface IVAuthCPC {
+    /**
+     * @dev Emitted when `submitter` submitted the proof that `account` is external owned account (EOA).
+     */
+    event SubmitProofExternalOwnedAccount(address indexed submitter, address indexed account);
+
+    /**
+     * @dev Emitted when `account` revoked its own proof of external owned account (EOA).
+     */
+    event RevokeProofExternalOwnedAccount(address indexed account);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Returns true if `account` has proof of external owned account (EOA).
+     */
+    function hasProofExternalOwnedAccount(address account) external view returns (bool);
+
+    /**
+     * @dev Returns the proof of external owned account (EOA) of `account`,
+     * including keccak256 hash of the signed message and the signature.
+     * Reverts if the account does not have proof.
+     */
+    function proofOf(address account) external view returns (bytes32 hash, bytes memory signature);
+
+    /**
+     * @dev Submits the proof that `account` is external owned account (EOA).
+     * The `signature` is the Ethereum signed message of the `x/vauth` message to sign, signed by `account`.
+     * The submission fee is charged from the caller, same as the Cosmos message, following the `x/vauth` module params.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {SubmitProofExternalOwnedAccount} event.
+     */
+    function submitProofExternalOwnedAccount(address account, bytes memory signature) external returns (bool);
+
+    /**
+     * @dev Revokes the proof of external owned account (EOA) of the caller.
+     * Reverts if the caller does not have proof.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {RevokeProofExternalOwnedAccount} event.
+     */
+    function revokeProofExternalOwnedAccount() external returns (bool);
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.7.0 <0.9.0;
+
+struct Coin {
+    string denom;
+    uint256 amount;
+}
+
+struct Period {
+    int64 length; // in seconds
+    Coin[] amount;
+}
+
+/**
+ * Creates vesting accounts of `x/auth/vesting` module.
+ * Same as the Cosmos messages, the target account must have proof of external owned account (EOA) via `x/vauth` module.
+ *
+ * Vesting types:
+ * - 1: continuous
+ * - 2: delayed
+ * - 3: periodic
+ * - 4: permanent locked
+ */
+interface IVestingCPC {
+    /**
+     * @dev Emitted when a vesting account `to` is created and funded by `from`.
+     */
+    event CreateVestingAccount(address indexed from, address indexed to, uint8 vestingType);
+
+    /**
+     * @dev Returns the name of the contract.
+     */
+    function name() external view returns (string memory);
+
+    /**
+     * @dev Creates a continuous vesting account, or a delayed vesting account if `delayed` is true,
+     * funded by the caller. Vesting ends at `endTime` (unix seconds).
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {CreateVestingAccount} event.
+     */
+    function createVestingAccount(address to, Coin[] memory amount, int64 endTime, bool delayed) external returns (bool);
+
+    /**
+     * @dev Creates a periodic vesting account funded by the caller, vesting starts at `startTime` (unix seconds).
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {CreateVestingAccount} event.
+     */
+    function createPeriodicVestingAccount(address to, int64 startTime, Period[] memory periods) external returns (bool);
+
+    /**
+     * @dev Creates a permanent locked account funded by the caller.
+     *
+     * Returns a boolean value indicating whether the operation succeeded.
+     *
+     * Emits a {CreateVestingAccount} event.
+     */
+    function createPermanentLockedAccount(address to, Coin[] memory amount) external returns (bool);
+
+    /**
+     * @dev Returns the vested coins of the vesting account at the current block time.
+     * Returns empty if the account is not a vesting account.
+     */
+    function vestedCoins(address account) external view returns (Coin[] memory);
+
+    /**
+     * @dev Returns the coins which are still locked by vesting at the current block time.
+     */
+    function lockedCoins(address account) external view returns (Coin[] memory);
+
+    /**
+     * @dev Returns the coins which can be spent by the account at the current block time.
+     */
+    function spendableCoins(address account) external view returns (Coin[] memory);
+}
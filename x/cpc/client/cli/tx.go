@@ -22,6 +22,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetDeployTxCmd(),
+		NewDisableContractTxCmd(),
+		NewEnableContractTxCmd(),
 	)
 
 	return cmd
@@ -36,6 +38,7 @@ func GetDeployTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewDeployErc20ContractTxCmd(),
 		NewDeployStakingContractTxCmd(),
+		NewDeployIbcTransferContractTxCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+func NewDeployIbcTransferContractTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-transfer",
+		Short: "Deploy a new IBC transfer contract, can only be done by the whitelisted deployer",
+		Example: fmt.Sprintf(
+			"$ %s %s tx deploy ibc-transfer --%s authority",
+			version.AppName, cpctypes.ModuleName,
+			flags.FlagFrom,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress().String()
+
+			if authority == "" {
+				return fmt.Errorf("flag --%s is required", flags.FlagFrom)
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &cpctypes.MsgDeployIbcTransferContractRequest{
+				Authority: authority,
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
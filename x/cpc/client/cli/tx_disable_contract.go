@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+func NewDisableContractTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [contract-address]",
+		Short: "Disable a custom precompiled contract, can only be done by the governance authority",
+		Long:  "Disable a custom precompiled contract, can only be done by the governance authority. Use with --generate-only to produce the message for a governance proposal.",
+		Example: fmt.Sprintf(
+			"$ %s %s tx disable 0xcc01000000000000000000000000000000000001 --%s authority --%s",
+			version.AppName, cpctypes.ModuleName,
+			flags.FlagFrom, flags.FlagGenerateOnly,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress().String()
+
+			if authority == "" {
+				return fmt.Errorf("flag --%s is required", flags.FlagFrom)
+			}
+
+			msg := &cpctypes.MsgDisableCustomPrecompiledContract{
+				Authority:       authority,
+				ContractAddress: args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
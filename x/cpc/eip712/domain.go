@@ -34,3 +34,25 @@ func GetDomainTypes() []apitypes.Type {
 		{"salt", "string"},
 	}
 }
+
+// GetEip2612Domain returns typed data domain for the EIP-2612 permit of the given ERC-20 custom-precompiled-contract.
+// Unlike GetDomain, it follows the domain used by the common ERC-20 implementations,
+// so the signatures can be produced by the existing tools.
+func GetEip2612Domain(tokenName string, cpcAddr common.Address, chainId *big.Int) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              tokenName,
+		Version:           "1",
+		ChainId:           (*cmath.HexOrDecimal256)(chainId),
+		VerifyingContract: cpcAddr.Hex(),
+	}
+}
+
+// GetEip2612DomainTypes returns domain types for EIP712Domain of the EIP-2612 permit.
+func GetEip2612DomainTypes() []apitypes.Type {
+	return []apitypes.Type{
+		{"name", "string"},
+		{"version", "string"},
+		{"chainId", "uint256"},
+		{"verifyingContract", "address"},
+	}
+}
@@ -73,7 +73,7 @@ func EIP712HashingTypedMessage(tm TypedMessage, chainId *big.Int) ([]byte, error
 	}
 
 	var domainSeparator hexutil.Bytes
-	domainSeparator, err = typedData.HashStruct(PrimaryTypeNameEIP712Domain, typedData.Domain.Map())
+	domainSeparator, err = DomainSeparator(typedData)
 	if err != nil {
 		return nil, err
 	}
@@ -81,3 +81,8 @@ func EIP712HashingTypedMessage(tm TypedMessage, chainId *big.Int) ([]byte, error
 	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
 	return crypto.Keccak256(rawData), nil
 }
+
+// DomainSeparator returns the hash of the domain of the given typed data.
+func DomainSeparator(typedData apitypes.TypedData) ([]byte, error) {
+	return typedData.HashStruct(PrimaryTypeNameEIP712Domain, typedData.Domain.Map())
+}
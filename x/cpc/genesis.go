@@ -10,6 +10,7 @@ import (
 	cpckeeper "github.com/EscanBE/evermint/x/cpc/keeper"
 	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // InitGenesis initializes genesis state based on exported genesis
@@ -23,6 +24,37 @@ func InitGenesis(
 		panic(err)
 	}
 
+	for _, contractMeta := range data.DeployedContracts {
+		if err := k.ImportCustomPrecompiledContract(ctx, contractMeta); err != nil {
+			panic(fmt.Errorf("error importing Custom Precompiled Contract %s: %s", common.BytesToAddress(contractMeta.Address), err))
+		}
+	}
+
+	for _, allowance := range data.Erc20Allowances {
+		k.SetErc20CpcAllowance(
+			ctx,
+			common.HexToAddress(allowance.Contract),
+			common.HexToAddress(allowance.Owner),
+			common.HexToAddress(allowance.Spender),
+			allowance.Amount.BigInt(),
+		)
+	}
+
+	for _, permitNonce := range data.Erc20PermitNonces {
+		k.SetErc20CpcPermitNonce(
+			ctx,
+			common.HexToAddress(permitNonce.Contract),
+			common.HexToAddress(permitNonce.Owner),
+			permitNonce.Nonce,
+		)
+	}
+
+	if data.ModuleAccountNonce > k.GetModuleAccountNonce(ctx) {
+		if err := k.SetModuleAccountNonce(ctx, data.ModuleAccountNonce); err != nil {
+			panic(err)
+		}
+	}
+
 	if data.DeployErc20Native {
 		stakingParams, err := stakingKeeper.GetParams(ctx)
 		if err != nil {
@@ -51,19 +83,73 @@ func InitGenesis(
 		}
 	}
 
-	{ // always deploy Bech32 Custom Precompiled Contract
+	if !k.HasCustomPrecompiledContract(ctx, cpctypes.CpcBech32FixedAddress) { // always deploy Bech32 Custom Precompiled Contract
 		_, err := k.DeployBech32CustomPrecompiledContract(ctx)
 		if err != nil {
 			panic(fmt.Errorf("error deploying Bech32 Custom Precompiled Contract: %s", err))
 		}
 	}
+
+	if !k.HasCustomPrecompiledContract(ctx, cpctypes.CpcGovFixedAddress) { // always deploy Gov Custom Precompiled Contract
+		_, err := k.DeployGovCustomPrecompiledContract(ctx)
+		if err != nil {
+			panic(fmt.Errorf("error deploying Gov Custom Precompiled Contract: %s", err))
+		}
+	}
+
+	if !k.HasCustomPrecompiledContract(ctx, cpctypes.CpcBankFixedAddress) { // always deploy Bank Custom Precompiled Contract
+		_, err := k.DeployBankCustomPrecompiledContract(ctx)
+		if err != nil {
+			panic(fmt.Errorf("error deploying Bank Custom Precompiled Contract: %s", err))
+		}
+	}
+
+	if !k.HasCustomPrecompiledContract(ctx, cpctypes.CpcDistributionFixedAddress) { // always deploy Distribution Custom Precompiled Contract
+		_, err := k.DeployDistributionCustomPrecompiledContract(ctx)
+		if err != nil {
+			panic(fmt.Errorf("error deploying Distribution Custom Precompiled Contract: %s", err))
+		}
+	}
+
+	if !k.HasCustomPrecompiledContract(ctx, cpctypes.CpcVestingFixedAddress) { // always deploy Vesting Custom Precompiled Contract
+		_, err := k.DeployVestingCustomPrecompiledContract(ctx)
+		if err != nil {
+			panic(fmt.Errorf("error deploying Vesting Custom Precompiled Contract: %s", err))
+		}
+	}
+
+	if !k.HasCustomPrecompiledContract(ctx, cpctypes.CpcVAuthFixedAddress) { // always deploy VAuth Custom Precompiled Contract
+		_, err := k.DeployVAuthCustomPrecompiledContract(ctx)
+		if err != nil {
+			panic(fmt.Errorf("error deploying VAuth Custom Precompiled Contract: %s", err))
+		}
+	}
 }
 
 // ExportGenesis export genesis state for cpc
 func ExportGenesis(ctx sdk.Context, k cpckeeper.Keeper) cpctypes.GenesisState {
+	deployedContracts := k.GetAllCustomPrecompiledContractsMeta(ctx)
+	if deployedContracts == nil {
+		deployedContracts = []cpctypes.CustomPrecompiledContractMeta{}
+	}
+
+	erc20Allowances := k.GetAllErc20CpcAllowances(ctx)
+	if erc20Allowances == nil {
+		erc20Allowances = []cpctypes.Erc20CpcAllowance{}
+	}
+
+	erc20PermitNonces := k.GetAllErc20CpcPermitNonces(ctx)
+	if erc20PermitNonces == nil {
+		erc20PermitNonces = []cpctypes.Erc20CpcPermitNonce{}
+	}
+
 	return cpctypes.GenesisState{
 		Params:                k.GetParams(ctx),
 		DeployErc20Native:     false,
-		DeployStakingContract: k.HasCustomPrecompiledContract(ctx, cpctypes.CpcStakingFixedAddress),
+		DeployStakingContract: false, // already included in the deployed contracts
+		DeployedContracts:     deployedContracts,
+		Erc20Allowances:       erc20Allowances,
+		ModuleAccountNonce:    k.GetModuleAccountNonce(ctx),
+		Erc20PermitNonces:     erc20PermitNonces,
 	}
 }
@@ -0,0 +1,127 @@
+package cpc
+
+import (
+	cpckeeper "github.com/EscanBE/evermint/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = IBCTransferMiddleware{}
+
+// IBCTransferMiddleware wraps the ICS-20 transfer IBC module.
+// When the tokens of a failed or timed out transfer, which was started through the IBC transfer custom precompiled contract,
+// are refunded to the sender, it emits the `IbcTransferRefund` EVM log of the contract,
+// so the EVM dApps which started the transfer can keep track of the refund.
+// When the tokens are received, it deploys the ERC20 custom precompiled contract for the received denom,
+// if enabled by the module params.
+type IBCTransferMiddleware struct {
+	ibctransfer.IBCModule
+	cpcKeeper cpckeeper.Keeper
+	evmKeeper cpctypes.EvmKeeper
+}
+
+// NewIBCTransferMiddleware creates a new IBCTransferMiddleware wrapping the given ICS-20 transfer IBC module.
+func NewIBCTransferMiddleware(app ibctransfer.IBCModule, cpcKeeper cpckeeper.Keeper, evmKeeper cpctypes.EvmKeeper) IBCTransferMiddleware {
+	return IBCTransferMiddleware{
+		IBCModule: app,
+		cpcKeeper: cpcKeeper,
+		evmKeeper: evmKeeper,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCTransferMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// validated by the underlying module
+		panic(err)
+	}
+
+	im.cpcKeeper.AutoDeployErc20CustomPrecompiledContract(ctx, getReceivedDenom(packet, data), cpckeeper.Erc20AutoDeployOnIbcReceive)
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// The tokens are refunded when the acknowledgement is an error.
+func (im IBCTransferMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		// validated by the underlying module
+		panic(err)
+	}
+
+	sentByCpc := im.cpcKeeper.ConsumeIbcTransferPacketSentByCpc(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if sentByCpc && !ack.Success() {
+		im.addRefundLog(ctx, packet)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// The tokens are always refunded when the packet timed out.
+func (im IBCTransferMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	if im.cpcKeeper.ConsumeIbcTransferPacketSentByCpc(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence) {
+		im.addRefundLog(ctx, packet)
+	}
+
+	return nil
+}
+
+func (im IBCTransferMiddleware) addRefundLog(ctx sdk.Context, packet channeltypes.Packet) {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// validated by the underlying module
+		panic(err)
+	}
+
+	if log := im.cpcKeeper.GetIbcTransferRefundLog(ctx, packet, data); log != nil {
+		im.evmKeeper.AddCosmosTxLogs(ctx, log)
+	}
+}
+
+// getReceivedDenom returns the denom of the tokens received on this chain, following the logic of the ICS-20 transfer module.
+func getReceivedDenom(packet channeltypes.Packet, data ibctransfertypes.FungibleTokenPacketData) string {
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the tokens were originally sent from this chain, unescrowed
+		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		return ibctransfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	// vouchers were minted
+	sourcePrefix := ibctransfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return ibctransfertypes.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+}
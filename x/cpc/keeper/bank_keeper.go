@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ bankkeeper.Keeper = &BankKeeperWithErc20AutoDeploy{}
+
+// BankKeeperWithErc20AutoDeploy wraps the bank keeper, when the denom metadata is set,
+// the ERC20 custom precompiled contract of the denom will be deployed if enabled by the module params.
+// When the metadata is set before minting, like the metadata of IBC vouchers set by `x/ibc-transfer`
+// which uses this keeper, the contract will be deployed when the denom is minted.
+type BankKeeperWithErc20AutoDeploy struct {
+	bankkeeper.Keeper
+	cpcKeeper *Keeper
+}
+
+// NewBankKeeperWithErc20AutoDeploy returns a new bank keeper which deploys the ERC20 custom precompiled contracts.
+// The CPC keeper must be provided later via WithCpcKeeper.
+func NewBankKeeperWithErc20AutoDeploy(bk bankkeeper.Keeper) *BankKeeperWithErc20AutoDeploy {
+	return &BankKeeperWithErc20AutoDeploy{
+		Keeper: bk,
+	}
+}
+
+// WithCpcKeeper sets the CPC keeper which is used to deploy the contracts.
+func (k *BankKeeperWithErc20AutoDeploy) WithCpcKeeper(ck Keeper) *BankKeeperWithErc20AutoDeploy {
+	k.cpcKeeper = &ck
+	return k
+}
+
+// SetDenomMetaData implements bankkeeper.Keeper.
+func (k BankKeeperWithErc20AutoDeploy) SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata) {
+	k.Keeper.SetDenomMetaData(ctx, denomMetaData)
+
+	if k.cpcKeeper == nil {
+		return
+	}
+
+	k.cpcKeeper.AutoDeployErc20CustomPrecompiledContract(sdk.UnwrapSDKContext(ctx), denomMetaData.Base, Erc20AutoDeployOnDenomMetadataSet)
+}
+
+// MintCoins implements bankkeeper.Keeper.
+func (k BankKeeperWithErc20AutoDeploy) MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	if err := k.Keeper.MintCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
+	if k.cpcKeeper == nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, coin := range amounts {
+		if !k.Keeper.HasDenomMetaData(sdkCtx, coin.Denom) {
+			continue
+		}
+
+		k.cpcKeeper.AutoDeployErc20CustomPrecompiledContract(sdkCtx, coin.Denom, Erc20AutoDeployOnDenomMetadataSet)
+	}
+
+	return nil
+}
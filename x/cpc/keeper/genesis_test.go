@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+	chainapp "github.com/EscanBE/evermint/app"
+	"github.com/EscanBE/evermint/constants"
+	"github.com/EscanBE/evermint/x/cpc"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *CpcTestSuite) TestExportImportGenesis() {
+	suite.SetupStakingCPC()
+
+	ctx, _ := suite.Ctx().CacheContext()
+
+	const denom = "uexport"
+	err := suite.App().BankKeeper().MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	suite.Require().NoError(err)
+
+	erc20Address, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(ctx, "Export", cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   "EXPORT",
+		Decimals: constants.BaseDenomExponent,
+		MinDenom: denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App().CpcKeeper().SetCustomPrecompiledContractDisabled(ctx, erc20Address, true))
+
+	owner := common.BytesToAddress([]byte("owner"))
+	spender := common.BytesToAddress([]byte("spender"))
+	suite.App().CpcKeeper().SetErc20CpcAllowance(ctx, erc20Address, owner, spender, big.NewInt(1))
+	suite.App().CpcKeeper().SetErc20CpcAllowance(ctx, erc20Address, spender, owner, cpctypes.BigMaxUint256)
+	suite.App().CpcKeeper().SetErc20CpcPermitNonce(ctx, erc20Address, owner, 3)
+
+	exported := cpc.ExportGenesis(ctx, *suite.App().CpcKeeper())
+	suite.Require().NoError(exported.Validate())
+	suite.Len(exported.Erc20Allowances, 2)
+	suite.Len(exported.Erc20PermitNonces, 1)
+	suite.Len(exported.DeployedContracts, len(suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(ctx)))
+	suite.Equal(suite.App().CpcKeeper().GetModuleAccountNonce(ctx), exported.ModuleAccountNonce)
+
+	// wipe the store to simulate a new chain
+	store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+	var keys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	suite.Require().NoError(iterator.Close())
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	suite.Require().Empty(suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(ctx))
+
+	cpc.InitGenesis(ctx, *suite.App().CpcKeeper(), *suite.App().StakingKeeper(), exported)
+
+	suite.Equal(exported, cpc.ExportGenesis(ctx, *suite.App().CpcKeeper()))
+
+	suite.Equal(erc20Address, *suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, denom))
+	suite.True(suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, erc20Address).Disabled)
+	suite.Equal("1", suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, erc20Address, owner, spender).String())
+	suite.Equal(cpctypes.BigMaxUint256, suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, erc20Address, spender, owner))
+	suite.Equal(uint64(3), suite.App().CpcKeeper().GetErc20CpcPermitNonce(ctx, erc20Address, owner))
+	suite.True(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcStakingFixedAddress))
+	suite.True(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcBech32FixedAddress))
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 
@@ -19,34 +21,51 @@ import (
 
 // Keeper of the CPC store
 type Keeper struct {
-	cdc           codec.BinaryCodec
-	storeKey      storetypes.StoreKey
-	authority     sdk.AccAddress
-	accountKeeper authkeeper.AccountKeeper
-	bankKeeper    bankkeeper.Keeper
-	stakingKeeper stakingkeeper.Keeper
-	distKeeper    distkeeper.Keeper
+	cdc            codec.Codec
+	storeKey       storetypes.StoreKey
+	authority      sdk.AccAddress
+	accountKeeper  authkeeper.AccountKeeper
+	bankKeeper     bankkeeper.Keeper
+	stakingKeeper  stakingkeeper.Keeper
+	distKeeper     distkeeper.Keeper
+	govKeeper      *govkeeper.Keeper
+	transferKeeper ibctransferkeeper.Keeper
+	vauthKeeper    cpctypes.VAuthKeeper
+
+	protocolRegistry *protocolCpcRegistry
 }
 
 // NewKeeper returns a new instance of the CPC keeper
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	key storetypes.StoreKey,
 	authority sdk.AccAddress,
 	ak authkeeper.AccountKeeper,
 	bk bankkeeper.Keeper,
 	sk stakingkeeper.Keeper,
 	dk distkeeper.Keeper,
+	gk *govkeeper.Keeper,
+	tk ibctransferkeeper.Keeper,
+	vak cpctypes.VAuthKeeper,
 ) Keeper {
-	return Keeper{
-		cdc:           cdc,
-		storeKey:      key,
-		authority:     authority,
-		accountKeeper: ak,
-		bankKeeper:    bk,
-		stakingKeeper: sk,
-		distKeeper:    dk,
+	k := Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		authority:      authority,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		stakingKeeper:  sk,
+		distKeeper:     dk,
+		govKeeper:      gk,
+		transferKeeper: tk,
+		vauthKeeper:    vak,
+
+		protocolRegistry: newProtocolCpcRegistry(),
 	}
+
+	k.registerProtocolCpcUpgrades()
+
+	return k
 }
 
 // Logger returns a module-specific logger.
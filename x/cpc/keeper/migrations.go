@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the ERC20 custom precompiled contract allowances
+// from the legacy key (owner, spender) to the contract-scoped key (contract, owner, spender).
+//
+// Legacy allowances were shared by all ERC20 contracts, there is no way to know which contract
+// the owner intended to approve, so they are only carried over to the ERC20 contract of the bond denom
+// (the wrapped native token), which is the only ERC20 contract deployed by default.
+// If that contract does not exist, the legacy allowances are dropped.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	stakingParams, err := m.keeper.stakingKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	nativeContract := m.keeper.GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, stakingParams.BondDenom)
+
+	const legacyKeyLen = 1 /*prefix*/ + common.AddressLength /*owner*/ + common.AddressLength /*spender*/
+
+	type legacyAllowance struct {
+		key       []byte
+		owner     common.Address
+		spender   common.Address
+		allowance *big.Int
+	}
+	var legacyAllowances []legacyAllowance
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, cpctypes.KeyPrefixErc20CpcAllowance)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) != legacyKeyLen {
+			continue
+		}
+
+		legacyAllowances = append(legacyAllowances, legacyAllowance{
+			key:       append([]byte{}, key...),
+			owner:     common.BytesToAddress(key[1 : 1+common.AddressLength]),
+			spender:   common.BytesToAddress(key[1+common.AddressLength:]),
+			allowance: new(big.Int).SetBytes(iterator.Value()),
+		})
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	var migrated, dropped int
+	for _, legacy := range legacyAllowances {
+		store.Delete(legacy.key)
+
+		if nativeContract == nil {
+			dropped++
+			continue
+		}
+
+		m.keeper.SetErc20CpcAllowance(ctx, *nativeContract, legacy.owner, legacy.spender, legacy.allowance)
+		migrated++
+	}
+
+	m.keeper.Logger(ctx).Info(
+		fmt.Sprintf("migrated %d ERC20 allowances, dropped %d", migrated, dropped),
+	)
+
+	return nil
+}
+
+// Migrate2to3 deploys the gov custom precompiled contract, which is deployed at genesis for new chains.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if m.keeper.HasCustomPrecompiledContract(ctx, cpctypes.CpcGovFixedAddress) {
+		return nil
+	}
+
+	_, err := m.keeper.DeployGovCustomPrecompiledContract(ctx)
+	return err
+}
+
+// Migrate3to4 deploys the bank custom precompiled contract, which is deployed at genesis for new chains.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if m.keeper.HasCustomPrecompiledContract(ctx, cpctypes.CpcBankFixedAddress) {
+		return nil
+	}
+
+	_, err := m.keeper.DeployBankCustomPrecompiledContract(ctx)
+	return err
+}
+
+// Migrate4to5 deploys the distribution custom precompiled contract, which is deployed at genesis for new chains.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if m.keeper.HasCustomPrecompiledContract(ctx, cpctypes.CpcDistributionFixedAddress) {
+		return nil
+	}
+
+	_, err := m.keeper.DeployDistributionCustomPrecompiledContract(ctx)
+	return err
+}
+
+// Migrate5to6 deploys the vesting custom precompiled contract, which is deployed at genesis for new chains.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	if m.keeper.HasCustomPrecompiledContract(ctx, cpctypes.CpcVestingFixedAddress) {
+		return nil
+	}
+
+	_, err := m.keeper.DeployVestingCustomPrecompiledContract(ctx)
+	return err
+}
+
+// Migrate6to7 deploys the vauth custom precompiled contract, which is deployed at genesis for new chains.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	if m.keeper.HasCustomPrecompiledContract(ctx, cpctypes.CpcVAuthFixedAddress) {
+		return nil
+	}
+
+	_, err := m.keeper.DeployVAuthCustomPrecompiledContract(ctx)
+	return err
+}
+
+// Migrate7to8 enables the dynamic gas of the custom precompiled contract methods, as the default of new chains.
+// The method gas table is left empty so the methods keep requiring the built-in gas.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.DynamicGasEnabled = true
+	if params.MethodGasTable == nil {
+		params.MethodGasTable = []cpctypes.CpcMethodGas{}
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	chainapp "github.com/EscanBE/evermint/app"
+	"github.com/EscanBE/evermint/constants"
+	cpckeeper "github.com/EscanBE/evermint/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *CpcTestSuite) TestMigrator_Migrate1to2() {
+	owner := common.BytesToAddress([]byte("owner"))
+	spender1 := common.BytesToAddress([]byte("spender1"))
+	spender2 := common.BytesToAddress([]byte("spender2"))
+
+	setLegacyAllowance := func(ctx sdk.Context, owner, spender common.Address, allowance *big.Int) {
+		store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+		store.Set(cpctypes.LegacyErc20CustomPrecompiledContractAllowanceKey(owner, spender), allowance.Bytes())
+	}
+
+	hasLegacyAllowance := func(ctx sdk.Context, owner, spender common.Address) bool {
+		store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+		return store.Has(cpctypes.LegacyErc20CustomPrecompiledContractAllowanceKey(owner, spender))
+	}
+
+	suite.Run("pass - legacy allowances are moved to the ERC20 contract of bond denom", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		nativeContract := suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, suite.bondDenom(ctx))
+		if nativeContract == nil {
+			addr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(ctx, constants.DisplayDenom, cpctypes.Erc20CustomPrecompiledContractMeta{
+				Symbol:   constants.DisplayDenom,
+				Decimals: constants.BaseDenomExponent,
+				MinDenom: suite.bondDenom(ctx),
+			})
+			suite.Require().NoError(err)
+			nativeContract = &addr
+		}
+
+		otherContract := common.BytesToAddress([]byte("other-contract"))
+
+		setLegacyAllowance(ctx, owner, spender1, big.NewInt(1))
+		setLegacyAllowance(ctx, owner, spender2, cpctypes.BigMaxUint256)
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate1to2(ctx)
+		suite.Require().NoError(err)
+
+		suite.False(hasLegacyAllowance(ctx, owner, spender1))
+		suite.False(hasLegacyAllowance(ctx, owner, spender2))
+
+		suite.Equal("1", suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, *nativeContract, owner, spender1).String())
+		suite.Equal(cpctypes.BigMaxUint256, suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, *nativeContract, owner, spender2))
+
+		suite.Zero(suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, otherContract, owner, spender1).Sign())
+		suite.Zero(suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, otherContract, owner, spender2).Sign())
+	})
+
+	suite.Run("pass - contract-scoped allowances are not touched", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		contract := common.BytesToAddress([]byte("contract"))
+		suite.App().CpcKeeper().SetErc20CpcAllowance(ctx, contract, owner, spender1, big.NewInt(9))
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate1to2(ctx)
+		suite.Require().NoError(err)
+
+		suite.Equal("9", suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contract, owner, spender1).String())
+	})
+}
+
+func (suite *CpcTestSuite) TestMigrator_Migrate2to3() {
+	suite.Run("pass - gov contract is deployed when missing", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+		store.Delete(cpctypes.CustomPrecompiledContractMetaKey(cpctypes.CpcGovFixedAddress))
+		suite.Require().False(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcGovFixedAddress))
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate2to3(ctx)
+		suite.Require().NoError(err)
+
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, cpctypes.CpcGovFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeGov, meta.CustomPrecompiledType)
+	})
+
+	suite.Run("pass - existing gov contract is kept", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate2to3(ctx)
+		suite.Require().NoError(err)
+
+		suite.True(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcGovFixedAddress))
+	})
+}
+
+func (suite *CpcTestSuite) TestMigrator_Migrate3to4() {
+	suite.Run("pass - bank contract is deployed when missing", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+		store.Delete(cpctypes.CustomPrecompiledContractMetaKey(cpctypes.CpcBankFixedAddress))
+		suite.Require().False(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcBankFixedAddress))
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate3to4(ctx)
+		suite.Require().NoError(err)
+
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, cpctypes.CpcBankFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeBank, meta.CustomPrecompiledType)
+	})
+
+	suite.Run("pass - existing bank contract is kept", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate3to4(ctx)
+		suite.Require().NoError(err)
+
+		suite.True(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcBankFixedAddress))
+	})
+}
+
+func (suite *CpcTestSuite) TestMigrator_Migrate4to5() {
+	suite.Run("pass - distribution contract is deployed when missing", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+		store.Delete(cpctypes.CustomPrecompiledContractMetaKey(cpctypes.CpcDistributionFixedAddress))
+		suite.Require().False(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcDistributionFixedAddress))
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate4to5(ctx)
+		suite.Require().NoError(err)
+
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, cpctypes.CpcDistributionFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeDistribution, meta.CustomPrecompiledType)
+	})
+
+	suite.Run("pass - existing distribution contract is kept", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate4to5(ctx)
+		suite.Require().NoError(err)
+
+		suite.True(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcDistributionFixedAddress))
+	})
+}
+
+func (suite *CpcTestSuite) TestMigrator_Migrate5to6() {
+	suite.Run("pass - vesting contract is deployed when missing", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+		store.Delete(cpctypes.CustomPrecompiledContractMetaKey(cpctypes.CpcVestingFixedAddress))
+		suite.Require().False(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcVestingFixedAddress))
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate5to6(ctx)
+		suite.Require().NoError(err)
+
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, cpctypes.CpcVestingFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeVesting, meta.CustomPrecompiledType)
+	})
+
+	suite.Run("pass - existing vesting contract is kept", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate5to6(ctx)
+		suite.Require().NoError(err)
+
+		suite.True(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcVestingFixedAddress))
+	})
+}
+
+func (suite *CpcTestSuite) TestMigrator_Migrate6to7() {
+	suite.Run("pass - vauth contract is deployed when missing", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+		store.Delete(cpctypes.CustomPrecompiledContractMetaKey(cpctypes.CpcVAuthFixedAddress))
+		suite.Require().False(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcVAuthFixedAddress))
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate6to7(ctx)
+		suite.Require().NoError(err)
+
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, cpctypes.CpcVAuthFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeVAuth, meta.CustomPrecompiledType)
+	})
+
+	suite.Run("pass - existing vauth contract is kept", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate6to7(ctx)
+		suite.Require().NoError(err)
+
+		suite.True(suite.App().CpcKeeper().HasCustomPrecompiledContract(ctx, cpctypes.CpcVAuthFixedAddress))
+	})
+}
+
+func (suite *CpcTestSuite) TestMigrator_Migrate7to8() {
+	suite.Run("pass - dynamic gas is enabled, other params are kept", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		params := suite.App().CpcKeeper().GetParams(ctx)
+		params.DynamicGasEnabled = false
+		params.MethodGasTable = nil
+		params.WhitelistedDeployers = []string{suite.CITS.WalletAccounts.Number(1).GetCosmosAddress().String()}
+		suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+
+		err := cpckeeper.NewMigrator(*suite.App().CpcKeeper()).Migrate7to8(ctx)
+		suite.Require().NoError(err)
+
+		migratedParams := suite.App().CpcKeeper().GetParams(ctx)
+		suite.True(migratedParams.DynamicGasEnabled)
+		suite.Empty(migratedParams.MethodGasTable)
+		suite.Equal(params.ProtocolVersion, migratedParams.ProtocolVersion)
+		suite.Equal(params.WhitelistedDeployers, migratedParams.WhitelistedDeployers)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var _ cpctypes.MsgServer = &msgServer{}
@@ -84,6 +85,54 @@ func (k *msgServer) DeployStakingContract(goCtx context.Context, req *cpctypes.M
 	}, nil
 }
 
+func (k *msgServer) DeployIbcTransferContract(goCtx context.Context, req *cpctypes.MsgDeployIbcTransferContractRequest) (*cpctypes.MsgDeployIbcTransferContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	moduleParams := k.GetParams(ctx)
+
+	if err := validateDeployer(req.Authority, moduleParams); err != nil {
+		return nil, err
+	}
+
+	contractAddr, err := k.DeployIbcTransferCustomPrecompiledContract(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpctypes.MsgDeployIbcTransferContractResponse{
+		ContractAddress: contractAddr.Hex(),
+	}, nil
+}
+
+// DisableCustomPrecompiledContract implements the gRPC MsgServer interface. After a successful governance vote
+// it disables the custom precompiled contract, any call to the contract will be reverted.
+func (k *msgServer) DisableCustomPrecompiledContract(goCtx context.Context, req *cpctypes.MsgDisableCustomPrecompiledContract) (*cpctypes.MsgDisableCustomPrecompiledContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetCustomPrecompiledContractDisabled(ctx, common.HexToAddress(req.ContractAddress), true); err != nil {
+		return nil, err
+	}
+
+	return &cpctypes.MsgDisableCustomPrecompiledContractResponse{}, nil
+}
+
+// EnableCustomPrecompiledContract implements the gRPC MsgServer interface. After a successful governance vote
+// it re-enables the disabled custom precompiled contract.
+func (k *msgServer) EnableCustomPrecompiledContract(goCtx context.Context, req *cpctypes.MsgEnableCustomPrecompiledContract) (*cpctypes.MsgEnableCustomPrecompiledContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetCustomPrecompiledContractDisabled(ctx, common.HexToAddress(req.ContractAddress), false); err != nil {
+		return nil, err
+	}
+
+	return &cpctypes.MsgEnableCustomPrecompiledContractResponse{}, nil
+}
+
 func validateDeployer(authority string, moduleParams cpctypes.Params) error {
 	for _, whitelistedAddr := range moduleParams.WhitelistedDeployers {
 		if whitelistedAddr == authority {
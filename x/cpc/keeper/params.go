@@ -19,6 +19,7 @@ func (k Keeper) GetParams(ctx sdk.Context) (params cpctypes.Params) {
 
 // SetParams sets module parameters.
 // Note: The protocol version cannot be downgraded.
+// When the protocol version is bumped, the registered metadata migrations of the new versions are applied to the existing contracts.
 func (k Keeper) SetParams(ctx sdk.Context, params cpctypes.Params) error {
 	if err := params.Validate(); err != nil {
 		panic(err)
@@ -36,5 +37,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params cpctypes.Params) error {
 	}
 
 	store.Set(cpctypes.KeyPrefixParams, bz)
+
+	if existingParams.ProtocolVersion > 0 && params.ProtocolVersion > existingParams.ProtocolVersion {
+		return k.migrateCustomPrecompiledContractsMeta(
+			ctx, cpctypes.ProtocolCpc(existingParams.ProtocolVersion), cpctypes.ProtocolCpc(params.ProtocolVersion),
+		)
+	}
+
 	return nil
 }
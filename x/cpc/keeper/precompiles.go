@@ -3,9 +3,13 @@ package keeper
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"strings"
 
+	"github.com/EscanBE/evermint/x/cpc/abi"
 	"github.com/EscanBE/evermint/x/evm/vm"
 
 	corevm "github.com/ethereum/go-ethereum/core/vm"
@@ -65,8 +69,6 @@ func (k Keeper) SetCustomPrecompiledContractMeta(ctx sdk.Context, contractMetada
 		))
 	}
 
-	fmt.Println("#### Deployed Custom Precompiled Contract", contractAddress)
-
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		cpctypes.EventTypeCustomPrecompiledContractUpdated,
 		sdk.NewAttribute(cpctypes.AttributeKeyCpcAddress, strings.ToLower(contractAddress.Hex())),
@@ -150,6 +152,86 @@ func (k Keeper) GetNextDynamicCustomPrecompiledContractAddress(ctx sdk.Context)
 	return crypto.CreateAddress(cpctypes.CpcModuleAddress, nonce)
 }
 
+// GetModuleAccountNonce returns the nonce of the module account,
+// which will be used to generate the address for the next dynamic custom precompiled contract.
+func (k Keeper) GetModuleAccountNonce(ctx sdk.Context) uint64 {
+	return k.accountKeeper.GetModuleAccount(ctx, cpctypes.ModuleName).GetSequence()
+}
+
+// SetModuleAccountNonce sets the nonce of the module account.
+// The nonce is not allowed to go backward, to prevent address collision of the dynamic custom precompiled contracts.
+func (k Keeper) SetModuleAccountNonce(ctx sdk.Context, nonce uint64) error {
+	ma := k.accountKeeper.GetModuleAccount(ctx, cpctypes.ModuleName)
+	if nonce < ma.GetSequence() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "nonce cannot go backward: %d < %d", nonce, ma.GetSequence())
+	}
+
+	if err := ma.SetSequence(nonce); err != nil {
+		return err
+	}
+
+	k.accountKeeper.SetModuleAccount(ctx, ma)
+
+	return nil
+}
+
+// ImportCustomPrecompiledContract stores the custom precompiled contract from genesis,
+// including the type-specific indexes like the reverse mapping from min denom to address of the ERC20 contracts.
+func (k Keeper) ImportCustomPrecompiledContract(ctx sdk.Context, contractMetadata cpctypes.CustomPrecompiledContractMeta) error {
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMetadata, true); err != nil {
+		return err
+	}
+
+	if contractMetadata.CustomPrecompiledType == cpctypes.CpcTypeErc20 {
+		var erc20Meta cpctypes.Erc20CustomPrecompiledContractMeta
+		if err := json.Unmarshal([]byte(contractMetadata.TypedMeta), &erc20Meta); err != nil {
+			return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidRequest, err), "failed to unmarshal ERC20 metadata")
+		}
+
+		store := ctx.KVStore(k.storeKey)
+		key := cpctypes.Erc20CustomPrecompiledContractMinDenomToAddressKey(erc20Meta.MinDenom)
+		if existingAddrBz := store.Get(key); len(existingAddrBz) != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrConflict, "existing contract for %s: %s", erc20Meta.MinDenom, common.BytesToAddress(existingAddrBz))
+		}
+
+		store.Set(key, contractMetadata.Address)
+	}
+
+	return nil
+}
+
+// SetCustomPrecompiledContractDisabled updates the `disabled` flag of the custom precompiled contract.
+// Disabled contracts are still registered into the EVM but any call to them will be reverted.
+func (k Keeper) SetCustomPrecompiledContractDisabled(ctx sdk.Context, contractAddress common.Address, disabled bool) error {
+	contractMeta := k.GetCustomPrecompiledContractMeta(ctx, contractAddress)
+	if contractMeta == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract does not exist by address: %s", contractAddress)
+	}
+
+	if contractMeta.Disabled == disabled {
+		if disabled {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contract is already disabled: %s", contractAddress)
+		}
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contract is not disabled: %s", contractAddress)
+	}
+
+	contractMeta.Disabled = disabled
+	if err := k.SetCustomPrecompiledContractMeta(ctx, *contractMeta, false); err != nil {
+		return err
+	}
+
+	eventType := cpctypes.EventTypeCustomPrecompiledContractEnabled
+	if disabled {
+		eventType = cpctypes.EventTypeCustomPrecompiledContractDisabled
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(cpctypes.AttributeKeyCpcAddress, strings.ToLower(contractAddress.Hex())),
+	))
+
+	return nil
+}
+
 // GetErc20CustomPrecompiledContractAddressByMinDenom returns the ERC20 custom precompiled contract address by min denom.
 func (k Keeper) GetErc20CustomPrecompiledContractAddressByMinDenom(ctx sdk.Context, minDenom string) *common.Address {
 	store := ctx.KVStore(k.storeKey)
@@ -166,24 +248,40 @@ func (k Keeper) GetErc20CustomPrecompiledContractAddressByMinDenom(ctx sdk.Conte
 
 var _ corevm.CustomPrecompiledContractMethodExecutorI = &customPrecompiledContractMethodExecutorImpl{}
 
+// NewCustomPrecompiledContractMethod wraps the executor into a method of the custom precompiled contract.
+// The static gas of the method can be overridden by the gas table in the module params.
 func NewCustomPrecompiledContractMethod(
 	executor ExtendedCustomPrecompiledContractMethodExecutorI,
-	protocolVersion cpctypes.ProtocolCpc,
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	params cpctypes.Params,
 ) corevm.CustomPrecompiledContractMethod {
+	requireGas := executor.RequireGas()
+	if gas, found := params.GetMethodGas(metadata.CustomPrecompiledType, executor.Method4BytesSignatures()); found {
+		requireGas = gas
+	}
+
 	return corevm.CustomPrecompiledContractMethod{
 		Method4BytesSignatures: executor.Method4BytesSignatures(),
-		RequireGas:             executor.RequireGas(),
+		RequireGas:             requireGas,
 		ReadOnly:               executor.ReadOnly(),
 		Executor: &customPrecompiledContractMethodExecutorImpl{
-			executor:        executor,
-			protocolVersion: protocolVersion,
+			executor:          executor,
+			cpcType:           metadata.CustomPrecompiledType,
+			protocolVersion:   cpctypes.ProtocolCpc(params.ProtocolVersion),
+			disabled:          metadata.Disabled,
+			requireGas:        requireGas,
+			dynamicGasEnabled: params.DynamicGasEnabled,
 		},
 	}
 }
 
 type customPrecompiledContractMethodExecutorImpl struct {
-	executor        ExtendedCustomPrecompiledContractMethodExecutorI
-	protocolVersion cpctypes.ProtocolCpc
+	executor          ExtendedCustomPrecompiledContractMethodExecutorI
+	cpcType           uint32
+	protocolVersion   cpctypes.ProtocolCpc
+	disabled          bool
+	requireGas        uint64 // static gas, charged by the EVM before execution
+	dynamicGasEnabled bool
 }
 
 func (m customPrecompiledContractMethodExecutorImpl) Execute(caller corevm.ContractRef, contractAddress common.Address, input []byte, evm *corevm.EVM) ([]byte, error) {
@@ -198,12 +296,137 @@ func (m customPrecompiledContractMethodExecutorImpl) Execute(caller corevm.Contr
 		))
 	}
 
-	ctx := evm.StateDB.(vm.CStateDB).GetCurrentContext()
-	return m.executor.Execute(caller, contractAddress, input, cpcExecutorEnv{
+	if m.disabled {
+		// the EVM should reject the call before reaching here, this is the second layer of protection
+		return nil, cpctypes.ErrDisabledCpc
+	}
+
+	cStateDB := evm.StateDB.(vm.CStateDB)
+	ctx := cStateDB.GetCurrentContext()
+	env := cpcExecutorEnv{
 		ctx:             ctx,
 		evm:             evm,
 		protocolVersion: m.protocolVersion,
-	})
+	}
+
+	cpcCallTracer, isCpcCallTracer := evm.Config.Tracer.(cpctypes.CpcCallTracer)
+	isTracing := evm.Config.Debug && isCpcCallTracer
+	if !isTracing && !m.dynamicGasEnabled {
+		return m.executor.Execute(caller, contractAddress, input, env)
+	}
+
+	originalEventsCount := len(ctx.EventManager().Events())
+
+	var output []byte
+	var err error
+	gasUsed := m.requireGas
+	if m.dynamicGasEnabled {
+		var sdkGasConsumed uint64
+		output, sdkGasConsumed, err = m.executeWithGasMeter(caller, contractAddress, input, env, cStateDB.GetCpcDynamicGasRemaining())
+		if sdkGasConsumed > m.requireGas {
+			// the work was done regardless of the execution result, so the extra gas is charged even if the call failed
+			cStateDB.AddCpcDynamicGas(sdkGasConsumed - m.requireGas)
+			gasUsed = sdkGasConsumed
+		}
+	} else {
+		output, err = m.executor.Execute(caller, contractAddress, input, env)
+	}
+
+	if !isTracing {
+		return output, err
+	}
+
+	frame := cpctypes.CpcCallFrame{
+		From:    caller.Address(),
+		To:      contractAddress,
+		Input:   input,
+		Output:  output,
+		Err:     err,
+		GasUsed: gasUsed,
+	}
+	if cpcInfo := getCustomPrecompiledContractInfo(m.cpcType); cpcInfo != nil {
+		if method, errMethod := cpcInfo.ABI.MethodById(input[:4]); errMethod == nil {
+			frame.Method = method.Sig
+			args := make(map[string]interface{})
+			if errUnpack := method.Inputs.UnpackIntoMap(args, input[4:]); errUnpack == nil {
+				frame.Args = args
+			}
+		}
+	}
+	if events := ctx.EventManager().ABCIEvents(); len(events) > originalEventsCount {
+		frame.CosmosEvents = events[originalEventsCount:]
+	}
+	cpcCallTracer.CaptureCpcCall(frame)
+
+	return output, err
+}
+
+// executeWithGasMeter executes the method with a gas meter and the default KV gas config of Cosmos SDK,
+// then returns the amount of SDK gas consumed during execution.
+// Ethereum txs are executed with zero KV gas config and infinite gas meter,
+// so the SDK gas consumed is measured by a fresh gas meter, bounded by the static gas of the method
+// plus the extra gas the custom precompiled contracts can still consume during the tx.
+// The execution is aborted with out of gas as soon as the gas meter reaches the limit.
+func (m customPrecompiledContractMethodExecutorImpl) executeWithGasMeter(
+	caller corevm.ContractRef, contractAddress common.Address, input []byte, env cpcExecutorEnv, dynamicGasRemaining uint64,
+) (output []byte, gasConsumed uint64, err error) {
+	gasLimit := m.requireGas + dynamicGasRemaining
+	if gasLimit < m.requireGas {
+		// overflow
+		gasLimit = math.MaxUint64
+	}
+	if blockGasLimit := env.evm.Context.GasLimit; blockGasLimit > 0 && blockGasLimit < gasLimit {
+		gasLimit = blockGasLimit
+	}
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+
+	env.ctx = env.ctx.
+		WithGasMeter(gasMeter).
+		WithKVGasConfig(storetypes.KVGasConfig()).
+		WithTransientKVGasConfig(storetypes.TransientGasConfig())
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, isOutOfGas := r.(storetypes.ErrorOutOfGas)
+			if !isOutOfGas {
+				panic(r)
+			}
+
+			output = nil
+			gasConsumed = gasMeter.Limit()
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", oog.Descriptor)
+		}
+	}()
+
+	output, err = m.executor.Execute(caller, contractAddress, input, env)
+	gasConsumed = gasMeter.GasConsumed()
+	return
+}
+
+// getCustomPrecompiledContractInfo returns the ABI info of the custom precompiled contract type, nil if not found.
+func getCustomPrecompiledContractInfo(cpcType uint32) *abi.CustomPrecompiledContractInfo {
+	switch cpcType {
+	case cpctypes.CpcTypeErc20:
+		return &abi.Erc20CpcInfo
+	case cpctypes.CpcTypeStaking:
+		return &abi.StakingCpcInfo
+	case cpctypes.CpcTypeBech32:
+		return &abi.Bech32CpcInfo
+	case cpctypes.CpcTypeGov:
+		return &abi.GovCpcInfo
+	case cpctypes.CpcTypeIbcTransfer:
+		return &abi.IbcTransferCpcInfo
+	case cpctypes.CpcTypeBank:
+		return &abi.BankCpcInfo
+	case cpctypes.CpcTypeDistribution:
+		return &abi.DistributionCpcInfo
+	case cpctypes.CpcTypeVesting:
+		return &abi.VestingCpcInfo
+	case cpctypes.CpcTypeVAuth:
+		return &abi.VAuthCpcInfo
+	default:
+		return nil
+	}
 }
 
 type CustomPrecompiledContractI interface {
@@ -221,6 +444,18 @@ func NewCustomPrecompiledContract(
 		return NewStakingCustomPrecompiledContract(metadata, keeper)
 	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeBech32 {
 		return NewBech32CustomPrecompiledContract(metadata)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeGov {
+		return NewGovCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeIbcTransfer {
+		return NewIbcTransferCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeBank {
+		return NewBankCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeDistribution {
+		return NewDistributionCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeVesting {
+		return NewVestingCustomPrecompiledContract(metadata, keeper)
+	} else if metadata.CustomPrecompiledType == cpctypes.CpcTypeVAuth {
+		return NewVAuthCustomPrecompiledContract(metadata, keeper)
 	}
 
 	panic(fmt.Sprintf("unsupported custom precompiled type %d", metadata.CustomPrecompiledType))
@@ -0,0 +1,394 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
+
+	sdkmath "cosmossdk.io/math"
+
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	cpcutils "github.com/EscanBE/evermint/x/cpc/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployBankCustomPrecompiledContract deploys a new bank custom precompiled contract.
+func (k Keeper) DeployBankCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcBankFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeBank,
+		Name:                  "Bank - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &bankCustomPrecompiledContract{}
+
+// bankCustomPrecompiledContract is a contract that can be used to interact with `x/bank` module,
+// to query and send any denom, without deploying an ERC-20 contract per denom.
+type bankCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewBankCustomPrecompiledContract creates a new bank custom precompiled contract.
+func NewBankCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &bankCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&bankCustomPrecompiledContractRoName{contract: contract},
+		&bankCustomPrecompiledContractRoBalances{contract: contract},
+		&bankCustomPrecompiledContractRoBalanceOf{contract: contract},
+		&bankCustomPrecompiledContractRoSupplyOf{contract: contract},
+		&bankCustomPrecompiledContractRoDenomMetadata{contract: contract},
+		&bankCustomPrecompiledContractRwSend{contract: contract},
+	}
+
+	return contract
+}
+
+func (m bankCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m bankCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+func (m bankCustomPrecompiledContract) emitsEventSend(from, to common.Address, coin sdk.Coin, env cpcExecutorEnv) error {
+	if err := addEvmLog(env.evm.StateDB, cpctypes.CpcBankFixedAddress, abi.BankCpcInfo, "Send", from, to, coin.Denom, coin.Amount.BigInt()); err != nil {
+		return err
+	}
+
+	// keep the ERC-20 contract of the denom in sync
+	if erc20Addr := m.keeper.GetErc20CustomPrecompiledContractAddressByMinDenom(env.ctx, coin.Denom); erc20Addr != nil {
+		if err := addEvmLog(env.evm.StateDB, *erc20Addr, abi.Erc20CpcInfo, "Transfer", from, to, coin.Amount.BigInt()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toAbiCoins converts the coins into the `Coin[]` output of the custom precompiled contracts.
+func toAbiCoins(coins sdk.Coins) []abi.BankCoin {
+	abiCoins := make([]abi.BankCoin, len(coins))
+	for i, coin := range coins {
+		abiCoins[i] = abi.BankCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		}
+	}
+	return abiCoins
+}
+
+// fromAbiCoins parses the `Coin[]` input of the custom precompiled contracts,
+// the result is sorted and validated, empty is not allowed.
+func fromAbiCoins(input any) (sdk.Coins, error) {
+	var abiCoins []abi.BankCoin
+	if bz, err := json.Marshal(input); err != nil {
+		return nil, err
+	} else if err := json.Unmarshal(bz, &abiCoins); err != nil {
+		return nil, fmt.Errorf("failed to parse coins: %s", err.Error())
+	}
+
+	coins := make(sdk.Coins, len(abiCoins))
+	for i, abiCoin := range abiCoins {
+		coins[i] = sdk.Coin{
+			Denom:  abiCoin.Denom,
+			Amount: sdkmath.NewIntFromBigInt(abiCoin.Amount),
+		}
+	}
+	coins = coins.Sort()
+	if len(coins) == 0 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "coins cannot be empty")
+	} else if err := coins.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "invalid coins: %s", err.Error())
+	}
+
+	return coins, nil
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoName{}
+
+type bankCustomPrecompiledContractRoName struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.BankCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.BankCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e bankCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e bankCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e bankCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// balances(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoBalances{}
+
+type bankCustomPrecompiledContractRoBalances struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoBalances) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("balances", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := ips[0].(common.Address)
+
+	balances := e.contract.keeper.bankKeeper.GetAllBalances(env.ctx, account.Bytes())
+
+	return abi.BankCpcInfo.PackMethodOutput("balances", toAbiCoins(balances))
+}
+
+func (e bankCustomPrecompiledContractRoBalances) Method4BytesSignatures() []byte {
+	return []byte{0x27, 0xe2, 0x35, 0xe3}
+}
+
+func (e bankCustomPrecompiledContractRoBalances) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e bankCustomPrecompiledContractRoBalances) ReadOnly() bool {
+	return true
+}
+
+// balanceOf(address,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoBalanceOf{}
+
+type bankCustomPrecompiledContractRoBalanceOf struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoBalanceOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("balanceOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := ips[0].(common.Address)
+	denom := ips[1].(string)
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "invalid denom: %s", err.Error())
+	}
+
+	balance := e.contract.keeper.bankKeeper.GetBalance(env.ctx, account.Bytes(), denom)
+
+	return abi.BankCpcInfo.PackMethodOutput("balanceOf", balance.Amount.BigInt())
+}
+
+func (e bankCustomPrecompiledContractRoBalanceOf) Method4BytesSignatures() []byte {
+	return []byte{0xb9, 0xb0, 0x92, 0xc8}
+}
+
+func (e bankCustomPrecompiledContractRoBalanceOf) RequireGas() uint64 {
+	return 1000
+}
+
+func (e bankCustomPrecompiledContractRoBalanceOf) ReadOnly() bool {
+	return true
+}
+
+// supplyOf(string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoSupplyOf{}
+
+type bankCustomPrecompiledContractRoSupplyOf struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoSupplyOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("supplyOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := ips[0].(string)
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "invalid denom: %s", err.Error())
+	}
+
+	supply := e.contract.keeper.bankKeeper.GetSupply(env.ctx, denom)
+
+	return abi.BankCpcInfo.PackMethodOutput("supplyOf", supply.Amount.BigInt())
+}
+
+func (e bankCustomPrecompiledContractRoSupplyOf) Method4BytesSignatures() []byte {
+	return []byte{0x3c, 0xda, 0x01, 0x03}
+}
+
+func (e bankCustomPrecompiledContractRoSupplyOf) RequireGas() uint64 {
+	return 1000
+}
+
+func (e bankCustomPrecompiledContractRoSupplyOf) ReadOnly() bool {
+	return true
+}
+
+// denomMetadata(string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRoDenomMetadata{}
+
+type bankCustomPrecompiledContractRoDenomMetadata struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRoDenomMetadata) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("denomMetadata", input)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := ips[0].(string)
+
+	metadata, found := e.contract.keeper.bankKeeper.GetDenomMetaData(env.ctx, denom)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "denom metadata not found: %s", denom)
+	}
+
+	denomUnits := make([]abi.BankDenomUnit, len(metadata.DenomUnits))
+	for i, denomUnit := range metadata.DenomUnits {
+		aliases := denomUnit.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+		denomUnits[i] = abi.BankDenomUnit{
+			Denom:    denomUnit.Denom,
+			Exponent: denomUnit.Exponent,
+			Aliases:  aliases,
+		}
+	}
+
+	return abi.BankCpcInfo.PackMethodOutput("denomMetadata", abi.BankDenomMetadata{
+		Description: metadata.Description,
+		DenomUnits:  denomUnits,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		Uri:         metadata.URI,
+		UriHash:     metadata.URIHash,
+	})
+}
+
+func (e bankCustomPrecompiledContractRoDenomMetadata) Method4BytesSignatures() []byte {
+	return []byte{0xbf, 0x16, 0x75, 0x69}
+}
+
+func (e bankCustomPrecompiledContractRoDenomMetadata) RequireGas() uint64 {
+	return 5000
+}
+
+func (e bankCustomPrecompiledContractRoDenomMetadata) ReadOnly() bool {
+	return true
+}
+
+// send(address,(string,uint256)[])
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &bankCustomPrecompiledContractRwSend{}
+
+type bankCustomPrecompiledContractRwSend struct {
+	contract *bankCustomPrecompiledContract
+}
+
+func (e bankCustomPrecompiledContractRwSend) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.BankCpcInfo.UnpackMethodInput("send", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	bankKeeper := e.contract.keeper.bankKeeper
+
+	from := caller.Address()
+	to := ips[0].(common.Address)
+
+	coins, err := fromAbiCoins(ips[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if to == (common.Address{}) {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "receiver cannot be the zero address")
+	}
+
+	if err := bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return nil, err
+	}
+
+	if bankKeeper.BlockedAddr(to.Bytes()) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	if err := bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), coins); err != nil {
+		return nil, errorsmod.Wrapf(errors.Join(cpctypes.ErrExecFailure, err), "failed to send coins")
+	}
+
+	for _, coin := range coins {
+		if err := e.contract.emitsEventSend(from, to, coin, env); err != nil {
+			return nil, err
+		}
+	}
+
+	return cpcutils.AbiEncodeBool(true)
+}
+
+func (e bankCustomPrecompiledContractRwSend) Method4BytesSignatures() []byte {
+	return []byte{0x8f, 0x7f, 0x2b, 0x20}
+}
+
+func (e bankCustomPrecompiledContractRwSend) RequireGas() uint64 {
+	return 30_000
+}
+
+func (e bankCustomPrecompiledContractRwSend) ReadOnly() bool {
+	return false
+}
@@ -0,0 +1,318 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/evermint/x/cpc/abi"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	cpcutils "github.com/EscanBE/evermint/x/cpc/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const topic0BankSend = "0x96c444353f223060ab52a3ff615c3871282cc0a8380227861bb5bd1b0c95bc49"
+
+func (suite *CpcTestSuite) TestKeeper_DeployBankCustomPrecompiledContract() {
+	suite.Run("pass - deployed at genesis", func() {
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcBankFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeBank, meta.CustomPrecompiledType)
+		suite.Equal(cpctypes.EmptyTypedMeta, meta.TypedMeta)
+		suite.False(meta.Disabled)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcBankFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.True(found)
+	})
+
+	suite.Run("fail - can not deploy twice", func() {
+		_, err := suite.App().CpcKeeper().DeployBankCustomPrecompiledContract(suite.Ctx())
+		suite.Require().ErrorContains(err, "contract address is being in use")
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Topic0_Bank() {
+	suite.Equal(common.HexToHash(topic0BankSend), abi.BankCpcInfo.ABI.Events["Send"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_BankCustomPrecompiledContract() {
+	const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+
+	err := suite.App().BankKeeper().MintCoins(suite.Ctx(), minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1_000_000)))
+	suite.Require().NoError(err)
+	err = suite.App().BankKeeper().SendCoinsFromModuleToAccount(suite.Ctx(), minttypes.ModuleName, account1.GetCosmosAddress(), sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1_000_000)))
+	suite.Require().NoError(err)
+
+	buildInput := func(methodName string, args ...any) []byte {
+		method := abi.BankCpcInfo.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		suite.Require().NoError(err)
+		return append(append([]byte{}, method.ID...), input...)
+	}
+
+	unpackOutput := func(methodName string, ret []byte) []any {
+		outputs, err := abi.BankCpcInfo.ABI.Methods[methodName].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		return outputs
+	}
+
+	suite.Run("name()", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcBankFixedAddress, buildInput("name"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotName, err := cpcutils.AbiDecodeString(res.Ret)
+		suite.Require().NoError(err)
+		suite.Equal("Bank - Precompiled Contract", gotName)
+	})
+
+	suite.Run("balances(address)", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcBankFixedAddress, buildInput("balances", account1.GetEthAddress()))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		wantBalances := suite.App().BankKeeper().GetAllBalances(suite.Ctx(), account1.GetCosmosAddress())
+
+		gotBalances := unpackOutput("balances", res.Ret)[0].([]struct {
+			Denom  string   `json:"denom"`
+			Amount *big.Int `json:"amount"`
+		})
+		suite.Require().Len(gotBalances, len(wantBalances))
+		for i, wantBalance := range wantBalances {
+			suite.Equal(wantBalance.Denom, gotBalances[i].Denom)
+			suite.Equal(wantBalance.Amount.String(), gotBalances[i].Amount.String())
+		}
+	})
+
+	suite.Run("balanceOf(address,string)", func() {
+		suite.Run("pass", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcBankFixedAddress, buildInput("balanceOf", account1.GetEthAddress(), ibcDenom))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			suite.Equal(big.NewInt(1_000_000), new(big.Int).SetBytes(res.Ret))
+		})
+
+		suite.Run("pass - zero balance", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcBankFixedAddress, buildInput("balanceOf", account2.GetEthAddress(), ibcDenom))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			suite.Zero(new(big.Int).SetBytes(res.Ret).Sign())
+		})
+
+		suite.Run("fail - invalid denom", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcBankFixedAddress, buildInput("balanceOf", account1.GetEthAddress(), "1"))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "invalid denom")
+		})
+	})
+
+	suite.Run("supplyOf(string)", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcBankFixedAddress, buildInput("supplyOf", ibcDenom))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		suite.Equal(big.NewInt(1_000_000), new(big.Int).SetBytes(res.Ret))
+	})
+
+	suite.Run("denomMetadata(string)", func() {
+		suite.Run("pass", func() {
+			metadata := banktypes.Metadata{
+				Description: "IBC voucher",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: ibcDenom, Exponent: 0, Aliases: []string{"uatom"}},
+					{Denom: "ATOM", Exponent: 6},
+				},
+				Base:    ibcDenom,
+				Display: "ATOM",
+				Name:    "Cosmos Hub Atom",
+				Symbol:  "ATOM",
+			}
+			suite.App().BankKeeper().SetDenomMetaData(suite.Ctx(), metadata)
+
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcBankFixedAddress, buildInput("denomMetadata", ibcDenom))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			wantBz, err := abi.BankCpcInfo.PackMethodOutput("denomMetadata", abi.BankDenomMetadata{
+				Description: metadata.Description,
+				DenomUnits: []abi.BankDenomUnit{
+					{Denom: ibcDenom, Exponent: 0, Aliases: []string{"uatom"}},
+					{Denom: "ATOM", Exponent: 6, Aliases: []string{}},
+				},
+				Base:    metadata.Base,
+				Display: metadata.Display,
+				Name:    metadata.Name,
+				Symbol:  metadata.Symbol,
+			})
+			suite.Require().NoError(err)
+			suite.Equal(wantBz, res.Ret)
+		})
+
+		suite.Run("fail - not found", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcBankFixedAddress, buildInput("denomMetadata", "unknown"))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "denom metadata not found")
+		})
+	})
+
+	suite.Run("send(address,(string,uint256)[])", func() {
+		sender := account1.GetEthAddress()
+		receiver := account2.GetEthAddress()
+
+		suite.Run("pass - multiple denoms", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			coins := []abi.BankCoin{
+				{Denom: ibcDenom, Amount: big.NewInt(100)},
+				{Denom: suite.bondDenom(ctx), Amount: big.NewInt(200)},
+			}
+
+			senderBalancesBefore := suite.App().BankKeeper().GetAllBalances(ctx, account1.GetCosmosAddress())
+			receiverBalancesBefore := suite.App().BankKeeper().GetAllBalances(ctx, account2.GetCosmosAddress())
+
+			res, err := suite.EthCallApply(ctx, &sender, cpctypes.CpcBankFixedAddress, buildInput("send", receiver, coins))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+			suite.Require().NoError(err)
+			suite.True(gotSuccess)
+
+			sent := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100), sdk.NewInt64Coin(suite.bondDenom(ctx), 200))
+
+			senderBalancesAfter := suite.App().BankKeeper().GetAllBalances(ctx, account1.GetCosmosAddress())
+			suite.Equal(sent.String(), senderBalancesBefore.Sub(senderBalancesAfter...).String())
+
+			receiverBalancesAfter := suite.App().BankKeeper().GetAllBalances(ctx, account2.GetCosmosAddress())
+			suite.Equal(sent.String(), receiverBalancesAfter.Sub(receiverBalancesBefore...).String())
+
+			var receipt ethtypes.Receipt
+			err = receipt.UnmarshalBinary(res.MarshalledReceipt)
+			suite.Require().NoError(err)
+
+			var sendLogs, transferLogs []*ethtypes.Log
+			for _, log := range receipt.Logs {
+				switch log.Topics[0].String() {
+				case topic0BankSend:
+					sendLogs = append(sendLogs, log)
+				case "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef":
+					transferLogs = append(transferLogs, log)
+				}
+			}
+
+			if suite.Len(sendLogs, 2, "expect event Send per denom") {
+				for i, coin := range sent {
+					log := sendLogs[i]
+					suite.Equal(cpctypes.CpcBankFixedAddress, log.Address)
+					suite.Equal(sender.String(), common.BytesToAddress(log.Topics[1].Bytes()).String())
+					suite.Equal(receiver.String(), common.BytesToAddress(log.Topics[2].Bytes()).String())
+
+					data, err := abi.BankCpcInfo.ABI.Events["Send"].Inputs.NonIndexed().Unpack(log.Data)
+					suite.Require().NoError(err)
+					suite.Equal(coin.Denom, data[0].(string))
+					suite.Equal(coin.Amount.BigInt(), data[1].(*big.Int))
+				}
+			}
+
+			erc20Addr := suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, suite.bondDenom(ctx))
+			if erc20Addr == nil {
+				suite.Empty(transferLogs)
+			} else if suite.Len(transferLogs, 1, "expect event Transfer of the ERC-20 contract") {
+				suite.Equal(*erc20Addr, transferLogs[0].Address)
+				suite.Equal(big.NewInt(200), new(big.Int).SetBytes(transferLogs[0].Data))
+			}
+		})
+
+		suite.Run("fail - insufficient funds", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			coins := []abi.BankCoin{
+				{Denom: ibcDenom, Amount: big.NewInt(1_000_001)},
+			}
+
+			res, err := suite.EthCallApply(ctx, &sender, cpctypes.CpcBankFixedAddress, buildInput("send", receiver, coins))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "insufficient funds")
+
+			suite.Equal(sdkmath.NewInt(1_000_000).String(), suite.App().BankKeeper().GetBalance(ctx, account1.GetCosmosAddress(), ibcDenom).Amount.String())
+		})
+
+		suite.Run("fail - zero amount", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			coins := []abi.BankCoin{
+				{Denom: ibcDenom, Amount: big.NewInt(0)},
+			}
+
+			res, err := suite.EthCallApply(ctx, &sender, cpctypes.CpcBankFixedAddress, buildInput("send", receiver, coins))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "invalid coins")
+		})
+
+		suite.Run("fail - duplicated denom", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			coins := []abi.BankCoin{
+				{Denom: ibcDenom, Amount: big.NewInt(1)},
+				{Denom: ibcDenom, Amount: big.NewInt(1)},
+			}
+
+			res, err := suite.EthCallApply(ctx, &sender, cpctypes.CpcBankFixedAddress, buildInput("send", receiver, coins))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "invalid coins")
+		})
+
+		suite.Run("fail - empty coins", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			res, err := suite.EthCallApply(ctx, &sender, cpctypes.CpcBankFixedAddress, buildInput("send", receiver, []abi.BankCoin{}))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "coins cannot be empty")
+		})
+
+		suite.Run("fail - zero address receiver", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			coins := []abi.BankCoin{
+				{Denom: ibcDenom, Amount: big.NewInt(1)},
+			}
+
+			res, err := suite.EthCallApply(ctx, &sender, cpctypes.CpcBankFixedAddress, buildInput("send", common.Address{}, coins))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "receiver cannot be the zero address")
+		})
+
+		suite.Run("fail - blocked receiver", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			coins := []abi.BankCoin{
+				{Denom: ibcDenom, Amount: big.NewInt(1)},
+			}
+
+			blocked := common.BytesToAddress(suite.App().AccountKeeper().GetModuleAddress(minttypes.ModuleName))
+			res, err := suite.EthCallApply(ctx, &sender, cpctypes.CpcBankFixedAddress, buildInput("send", blocked, coins))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "is not allowed to receive funds")
+		})
+	})
+}
@@ -0,0 +1,380 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
+
+	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployDistributionCustomPrecompiledContract deploys a new distribution custom precompiled contract.
+func (k Keeper) DeployDistributionCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcDistributionFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeDistribution,
+		Name:                  "Distribution - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &distributionCustomPrecompiledContract{}
+
+// distributionCustomPrecompiledContract is a contract that can be used to interact with `x/distribution` module,
+// complements the staking contract with withdraw address, validator commission and community pool operations.
+type distributionCustomPrecompiledContract struct {
+	metadata        cpctypes.CustomPrecompiledContractMeta
+	keeper          Keeper
+	executors       []ExtendedCustomPrecompiledContractMethodExecutorI
+	sdkEventsBridge sdkEventsBridge
+}
+
+// NewDistributionCustomPrecompiledContract creates a new distribution custom precompiled contract.
+func NewDistributionCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &distributionCustomPrecompiledContract{
+		metadata:        metadata,
+		keeper:          keeper,
+		sdkEventsBridge: newSdkEventsBridge(keeper, cpctypes.CpcDistributionFixedAddress, abi.DistributionCpcInfo, distributionSdkEventsMappings...),
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&distributionCustomPrecompiledContractRoName{contract: contract},
+		&distributionCustomPrecompiledContractRwSetWithdrawAddress{contract: contract},
+		&distributionCustomPrecompiledContractRwWithdrawValidatorCommission{contract: contract},
+		&distributionCustomPrecompiledContractRwFundCommunityPool{contract: contract},
+		&distributionCustomPrecompiledContractRoValidatorCommission{contract: contract},
+		&distributionCustomPrecompiledContractRoValidatorOutstandingRewards{contract: contract},
+	}
+
+	return contract
+}
+
+func (m distributionCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m distributionCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+func (m distributionCustomPrecompiledContract) emitsEventFundCommunityPool(depositor common.Address, amount *big.Int, env cpcExecutorEnv) error {
+	return addEvmLog(env.evm.StateDB, cpctypes.CpcDistributionFixedAddress, abi.DistributionCpcInfo, "FundCommunityPool", depositor, amount)
+}
+
+// distributionSdkEventsMappings declares the SDK events to be bridged into SetWithdrawAddress/WithdrawValidatorCommission logs.
+// Those SDK events do not carry the delegator/validator, so the actor is provided by the executor.
+var distributionSdkEventsMappings = []sdkEventToEvmLogMapping{
+	{
+		sdkEventType:       disttypes.EventTypeSetWithdrawAddress,
+		sdkEventAttributes: []string{disttypes.AttributeKeyWithdrawAddress},
+		evmEvent:           "SetWithdrawAddress",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromActor(),
+			sdkEventArgFromAccAddress(disttypes.AttributeKeyWithdrawAddress),
+		},
+	},
+	{
+		sdkEventType:       disttypes.EventTypeWithdrawCommission,
+		sdkEventAttributes: []string{sdk.AttributeKeyAmount},
+		evmEvent:           "WithdrawValidatorCommission",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromActor(),
+			sdkEventArgFromBondDenomAmount(sdk.AttributeKeyAmount),
+		},
+	},
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRoName{}
+
+type distributionCustomPrecompiledContractRoName struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.DistributionCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e distributionCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e distributionCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e distributionCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// setWithdrawAddress(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRwSetWithdrawAddress{}
+
+type distributionCustomPrecompiledContractRwSetWithdrawAddress struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRwSetWithdrawAddress) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("setWithdrawAddress", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	delegator := caller.Address()
+	withdrawAddress := ips[0].(common.Address)
+
+	if withdrawAddress == (common.Address{}) {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "withdraw address cannot be the zero address")
+	}
+
+	originalDistributionEventsCount := e.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
+
+	msgSetWithdrawAddress := disttypes.NewMsgSetWithdrawAddress(delegator.Bytes(), withdrawAddress.Bytes())
+	if _, err := distkeeper.NewMsgServerImpl(e.contract.keeper.distKeeper).SetWithdrawAddress(ctx, msgSetWithdrawAddress); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.sdkEventsBridge.emitEvmLogs(originalDistributionEventsCount, delegator, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit events")
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("setWithdrawAddress", true)
+}
+
+func (e distributionCustomPrecompiledContractRwSetWithdrawAddress) Method4BytesSignatures() []byte {
+	return []byte{0x3a, 0xb1, 0xa4, 0x94}
+}
+
+func (e distributionCustomPrecompiledContractRwSetWithdrawAddress) RequireGas() uint64 {
+	return 50_000
+}
+
+func (e distributionCustomPrecompiledContractRwSetWithdrawAddress) ReadOnly() bool {
+	return false
+}
+
+// withdrawValidatorCommission()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRwWithdrawValidatorCommission{}
+
+type distributionCustomPrecompiledContractRwWithdrawValidatorCommission struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRwWithdrawValidatorCommission) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.DistributionCpcInfo.UnpackMethodInput("withdrawValidatorCommission", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	// the validator operated by the caller
+	validator := caller.Address()
+	valAddrStr, err := e.contract.keeper.stakingKeeper.ValidatorAddressCodec().BytesToString(validator.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	originalDistributionEventsCount := e.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
+
+	msgWithdrawValidatorCommission := disttypes.NewMsgWithdrawValidatorCommission(valAddrStr)
+	if _, err := distkeeper.NewMsgServerImpl(e.contract.keeper.distKeeper).WithdrawValidatorCommission(ctx, msgWithdrawValidatorCommission); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.sdkEventsBridge.emitEvmLogs(originalDistributionEventsCount, validator, env); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to emit events")
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("withdrawValidatorCommission", true)
+}
+
+func (e distributionCustomPrecompiledContractRwWithdrawValidatorCommission) Method4BytesSignatures() []byte {
+	return []byte{0x0b, 0xde, 0x07, 0x6d}
+}
+
+func (e distributionCustomPrecompiledContractRwWithdrawValidatorCommission) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e distributionCustomPrecompiledContractRwWithdrawValidatorCommission) ReadOnly() bool {
+	return false
+}
+
+// fundCommunityPool(uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRwFundCommunityPool{}
+
+type distributionCustomPrecompiledContractRwFundCommunityPool struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRwFundCommunityPool) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("fundCommunityPool", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	depositor := caller.Address()
+	amount := ips[0].(*big.Int)
+
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "amount must be positive")
+	}
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgFundCommunityPool := disttypes.NewMsgFundCommunityPool(
+		sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount))),
+		sdk.AccAddress(depositor.Bytes()).String(),
+	)
+	if _, err := distkeeper.NewMsgServerImpl(e.contract.keeper.distKeeper).FundCommunityPool(ctx, msgFundCommunityPool); err != nil {
+		return nil, err
+	}
+
+	// `x/distribution` does not emit any event for this action
+	if err := e.contract.emitsEventFundCommunityPool(depositor, amount, env); err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("fundCommunityPool", true)
+}
+
+func (e distributionCustomPrecompiledContractRwFundCommunityPool) Method4BytesSignatures() []byte {
+	return []byte{0xde, 0xe6, 0x86, 0x23}
+}
+
+func (e distributionCustomPrecompiledContractRwFundCommunityPool) RequireGas() uint64 {
+	return 50_000
+}
+
+func (e distributionCustomPrecompiledContractRwFundCommunityPool) ReadOnly() bool {
+	return false
+}
+
+// validatorCommission(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRoValidatorCommission{}
+
+type distributionCustomPrecompiledContractRoValidatorCommission struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorCommission) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("validatorCommission", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := ips[0].(common.Address)
+
+	commission, err := e.contract.keeper.distKeeper.GetValidatorAccumulatedCommission(ctx, validator.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("validatorCommission", commission.Commission.AmountOf(bondDenom).TruncateInt().BigInt())
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorCommission) Method4BytesSignatures() []byte {
+	return []byte{0x83, 0xa2, 0x50, 0x78}
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorCommission) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorCommission) ReadOnly() bool {
+	return true
+}
+
+// validatorOutstandingRewards(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &distributionCustomPrecompiledContractRoValidatorOutstandingRewards{}
+
+type distributionCustomPrecompiledContractRoValidatorOutstandingRewards struct {
+	contract *distributionCustomPrecompiledContract
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorOutstandingRewards) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.DistributionCpcInfo.UnpackMethodInput("validatorOutstandingRewards", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := ips[0].(common.Address)
+
+	rewards, err := e.contract.keeper.distKeeper.GetValidatorOutstandingRewards(ctx, validator.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DistributionCpcInfo.PackMethodOutput("validatorOutstandingRewards", rewards.Rewards.AmountOf(bondDenom).TruncateInt().BigInt())
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorOutstandingRewards) Method4BytesSignatures() []byte {
+	return []byte{0xf2, 0x8e, 0x9b, 0x39}
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorOutstandingRewards) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e distributionCustomPrecompiledContractRoValidatorOutstandingRewards) ReadOnly() bool {
+	return true
+}
@@ -0,0 +1,297 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/evermint/x/cpc/abi"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	cpcutils "github.com/EscanBE/evermint/x/cpc/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	topic0SetWithdrawAddress          = "0xae416f064415339eb2fc98ef48a0fc06ee07e3b33d469e001c477eac6e68947c"
+	topic0WithdrawValidatorCommission = "0x441c6af7e6de8d3bd62744b58b17e4338adc259ef67e1ce16c3b12c9cdd6f128"
+	topic0FundCommunityPool           = "0xcaf76e243f2c5363d9c1509c402f2eadf947c6ce9920350c4e3572cb3dd3487e"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployDistributionCustomPrecompiledContract() {
+	suite.Run("pass - deployed at genesis", func() {
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcDistributionFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeDistribution, meta.CustomPrecompiledType)
+		suite.Equal(cpctypes.EmptyTypedMeta, meta.TypedMeta)
+		suite.False(meta.Disabled)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcDistributionFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.True(found)
+	})
+
+	suite.Run("fail - can not deploy twice", func() {
+		_, err := suite.App().CpcKeeper().DeployDistributionCustomPrecompiledContract(suite.Ctx())
+		suite.Require().ErrorContains(err, "contract address is being in use")
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Topic0_Distribution() {
+	suite.Equal(common.HexToHash(topic0SetWithdrawAddress), abi.DistributionCpcInfo.ABI.Events["SetWithdrawAddress"].ID)
+	suite.Equal(common.HexToHash(topic0WithdrawValidatorCommission), abi.DistributionCpcInfo.ABI.Events["WithdrawValidatorCommission"].ID)
+	suite.Equal(common.HexToHash(topic0FundCommunityPool), abi.DistributionCpcInfo.ABI.Events["FundCommunityPool"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_DistributionCustomPrecompiledContract() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+	validator1 := suite.CITS.ValidatorAccounts.Number(1)
+
+	buildInput := func(methodName string, args ...any) []byte {
+		method := abi.DistributionCpcInfo.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		suite.Require().NoError(err)
+		return append(append([]byte{}, method.ID...), input...)
+	}
+
+	getReceiptLogs := func(bzReceipt []byte) []*ethtypes.Log {
+		var receipt ethtypes.Receipt
+		err := receipt.UnmarshalBinary(bzReceipt)
+		suite.Require().NoError(err)
+		return receipt.Logs
+	}
+
+	suite.Run("name()", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcDistributionFixedAddress, buildInput("name"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotName, err := cpcutils.AbiDecodeString(res.Ret)
+		suite.Require().NoError(err)
+		suite.Equal("Distribution - Precompiled Contract", gotName)
+	})
+
+	suite.Run("setWithdrawAddress(address)", func() {
+		suite.Run("pass", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			res, err := suite.EthCallApply(ctx, account1.GetEthAddressP(), cpctypes.CpcDistributionFixedAddress, buildInput("setWithdrawAddress", account2.GetEthAddress()))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+			suite.Require().NoError(err)
+			suite.True(gotSuccess)
+
+			withdrawAddr, err := suite.App().DistributionKeeper().GetDelegatorWithdrawAddr(ctx, account1.GetCosmosAddress())
+			suite.Require().NoError(err)
+			suite.Equal(account2.GetCosmosAddress(), withdrawAddr)
+
+			logs := getReceiptLogs(res.MarshalledReceipt)
+			if suite.Len(logs, 1, "expect event SetWithdrawAddress") {
+				log := logs[0]
+				suite.Equal(cpctypes.CpcDistributionFixedAddress, log.Address)
+				if suite.Len(log.Topics, 3) {
+					suite.Equal(topic0SetWithdrawAddress, log.Topics[0].String())
+					suite.Equal(account1.GetEthAddress(), common.BytesToAddress(log.Topics[1].Bytes()))
+					suite.Equal(account2.GetEthAddress(), common.BytesToAddress(log.Topics[2].Bytes()))
+				}
+			}
+		})
+
+		suite.Run("fail - zero address", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			res, err := suite.EthCallApply(ctx, account1.GetEthAddressP(), cpctypes.CpcDistributionFixedAddress, buildInput("setWithdrawAddress", common.Address{}))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "withdraw address cannot be the zero address")
+		})
+
+		suite.Run("fail - blocked address", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			blocked := common.BytesToAddress(suite.App().AccountKeeper().GetModuleAddress(minttypes.ModuleName))
+			res, err := suite.EthCallApply(ctx, account1.GetEthAddressP(), cpctypes.CpcDistributionFixedAddress, buildInput("setWithdrawAddress", blocked))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "not allowed to receive external funds")
+		})
+	})
+
+	suite.Run("fundCommunityPool(uint256)", func() {
+		suite.Run("pass", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			bondDenom := suite.bondDenom(ctx)
+			amount := big.NewInt(1e9)
+
+			poolBefore, err := suite.App().DistributionKeeper().FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			balanceBefore := suite.App().BankKeeper().GetBalance(ctx, account1.GetCosmosAddress(), bondDenom)
+
+			res, err := suite.EthCallApply(ctx, account1.GetEthAddressP(), cpctypes.CpcDistributionFixedAddress, buildInput("fundCommunityPool", amount))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+			suite.Require().NoError(err)
+			suite.True(gotSuccess)
+
+			poolAfter, err := suite.App().DistributionKeeper().FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Equal(
+				amount.String(),
+				poolAfter.CommunityPool.AmountOf(bondDenom).Sub(poolBefore.CommunityPool.AmountOf(bondDenom)).TruncateInt().String(),
+			)
+
+			balanceAfter := suite.App().BankKeeper().GetBalance(ctx, account1.GetCosmosAddress(), bondDenom)
+			suite.Equal(amount.String(), balanceBefore.Amount.Sub(balanceAfter.Amount).String())
+
+			var found bool
+			for _, log := range getReceiptLogs(res.MarshalledReceipt) {
+				if log.Topics[0].String() != topic0FundCommunityPool {
+					continue
+				}
+				found = true
+				suite.Equal(cpctypes.CpcDistributionFixedAddress, log.Address)
+				suite.Equal(account1.GetEthAddress(), common.BytesToAddress(log.Topics[1].Bytes()))
+				suite.Equal(amount, new(big.Int).SetBytes(log.Data))
+			}
+			suite.True(found, "expect event FundCommunityPool")
+		})
+
+		suite.Run("fail - zero amount", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			res, err := suite.EthCallApply(ctx, account1.GetEthAddressP(), cpctypes.CpcDistributionFixedAddress, buildInput("fundCommunityPool", big.NewInt(0)))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "amount must be positive")
+		})
+
+		suite.Run("fail - insufficient funds", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			balance := suite.App().BankKeeper().GetBalance(ctx, account1.GetCosmosAddress(), suite.bondDenom(ctx))
+			amount := balance.Amount.Add(sdkmath.OneInt()).BigInt()
+
+			res, err := suite.EthCallApply(ctx, account1.GetEthAddressP(), cpctypes.CpcDistributionFixedAddress, buildInput("fundCommunityPool", amount))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "insufficient funds")
+		})
+	})
+
+	suite.Run("when commission not available", func() {
+		suite.Run("fail - withdrawValidatorCommission() of non-validator", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			res, err := suite.EthCallApply(ctx, account1.GetEthAddressP(), cpctypes.CpcDistributionFixedAddress, buildInput("withdrawValidatorCommission"))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "no validator commission to withdraw")
+		})
+
+		suite.Run("validatorCommission(address) of non-validator returns zero", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcDistributionFixedAddress, buildInput("validatorCommission", account1.GetEthAddress()))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			gotCommission, err := cpcutils.AbiDecodeUint256(res.Ret)
+			suite.Require().NoError(err)
+			suite.Zero(gotCommission.Sign())
+		})
+	})
+
+	{
+		// setup commission
+		dk := suite.App().DistributionKeeper()
+		valAddr := validator1.GetValidatorAddress()
+		commission := sdk.NewDecCoins(sdk.NewInt64DecCoin(suite.bondDenom(suite.Ctx()), 1e9))
+
+		suite.CITS.MintCoinToModuleAccount(dk.GetDistributionAccount(suite.Ctx()), sdk.NewInt64Coin(suite.bondDenom(suite.Ctx()), 1e9))
+
+		err := dk.SetValidatorAccumulatedCommission(suite.Ctx(), valAddr, disttypes.ValidatorAccumulatedCommission{Commission: commission})
+		suite.Require().NoError(err)
+
+		outstanding, err := dk.GetValidatorOutstandingRewards(suite.Ctx(), valAddr)
+		suite.Require().NoError(err)
+		err = dk.SetValidatorOutstandingRewards(suite.Ctx(), valAddr, disttypes.ValidatorOutstandingRewards{Rewards: outstanding.Rewards.Add(commission...)})
+		suite.Require().NoError(err)
+	}
+
+	suite.Run("when commission available", func() {
+		bondDenom := suite.bondDenom(suite.Ctx())
+
+		suite.Run("validatorCommission(address)", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcDistributionFixedAddress, buildInput("validatorCommission", validator1.GetEthAddress()))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			commission, err := suite.App().DistributionKeeper().GetValidatorAccumulatedCommission(suite.Ctx(), validator1.GetValidatorAddress())
+			suite.Require().NoError(err)
+
+			gotCommission, err := cpcutils.AbiDecodeUint256(res.Ret)
+			suite.Require().NoError(err)
+			suite.Equal(big.NewInt(1e9), gotCommission)
+			suite.Equal(commission.Commission.AmountOf(bondDenom).TruncateInt().String(), gotCommission.String())
+		})
+
+		suite.Run("validatorOutstandingRewards(address)", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcDistributionFixedAddress, buildInput("validatorOutstandingRewards", validator1.GetEthAddress()))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			rewards, err := suite.App().DistributionKeeper().GetValidatorOutstandingRewards(suite.Ctx(), validator1.GetValidatorAddress())
+			suite.Require().NoError(err)
+
+			gotRewards, err := cpcutils.AbiDecodeUint256(res.Ret)
+			suite.Require().NoError(err)
+			suite.Equal(1, gotRewards.Sign())
+			suite.Equal(rewards.Rewards.AmountOf(bondDenom).TruncateInt().String(), gotRewards.String())
+		})
+
+		suite.Run("pass - withdrawValidatorCommission()", func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			balanceBefore := suite.App().BankKeeper().GetBalance(ctx, validator1.GetCosmosAddress(), bondDenom)
+
+			res, err := suite.EthCallApply(ctx, validator1.GetEthAddressP(), cpctypes.CpcDistributionFixedAddress, buildInput("withdrawValidatorCommission"))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+			suite.Require().NoError(err)
+			suite.True(gotSuccess)
+
+			balanceAfter := suite.App().BankKeeper().GetBalance(ctx, validator1.GetCosmosAddress(), bondDenom)
+			withdrawn := balanceAfter.Amount.Sub(balanceBefore.Amount)
+			suite.Require().True(withdrawn.IsPositive(), "balance should be increased because withdrawn commission")
+
+			logs := getReceiptLogs(res.MarshalledReceipt)
+			if suite.Len(logs, 1, "expect event WithdrawValidatorCommission") {
+				log := logs[0]
+				suite.Equal(cpctypes.CpcDistributionFixedAddress, log.Address)
+				if suite.Len(log.Topics, 2) {
+					suite.Equal(topic0WithdrawValidatorCommission, log.Topics[0].String())
+					suite.Equal(validator1.GetEthAddress(), common.BytesToAddress(log.Topics[1].Bytes()))
+				}
+				suite.Equal(withdrawn.BigInt(), new(big.Int).SetBytes(log.Data))
+			}
+
+			commission, err := suite.App().DistributionKeeper().GetValidatorAccumulatedCommission(ctx, validator1.GetValidatorAddress())
+			suite.Require().NoError(err)
+			suite.True(commission.Commission.AmountOf(bondDenom).TruncateInt().IsZero())
+		})
+	})
+}
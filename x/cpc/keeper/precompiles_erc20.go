@@ -7,13 +7,13 @@ import (
 	"math/big"
 
 	"github.com/EscanBE/evermint/x/cpc/abi"
+	"github.com/EscanBE/evermint/x/cpc/eip712"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
-
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -63,9 +63,9 @@ func (k Keeper) DeployErc20CustomPrecompiledContract(ctx sdk.Context, name strin
 }
 
 // SetErc20CpcAllowance sets allowance for ERC20 custom precompiled contract.
-func (k Keeper) SetErc20CpcAllowance(ctx sdk.Context, owner, spender common.Address, allowance *big.Int) {
+func (k Keeper) SetErc20CpcAllowance(ctx sdk.Context, contract, owner, spender common.Address, allowance *big.Int) {
 	store := ctx.KVStore(k.storeKey)
-	key := cpctypes.Erc20CustomPrecompiledContractAllowanceKey(owner, spender)
+	key := cpctypes.Erc20CustomPrecompiledContractAllowanceKey(contract, owner, spender)
 
 	switch allowance.Sign() {
 	case 0:
@@ -83,9 +83,9 @@ func (k Keeper) SetErc20CpcAllowance(ctx sdk.Context, owner, spender common.Addr
 }
 
 // GetErc20CpcAllowance returns allowance for ERC20 custom precompiled contract.
-func (k Keeper) GetErc20CpcAllowance(ctx sdk.Context, owner, spender common.Address) *big.Int {
+func (k Keeper) GetErc20CpcAllowance(ctx sdk.Context, contract, owner, spender common.Address) *big.Int {
 	store := ctx.KVStore(k.storeKey)
-	key := cpctypes.Erc20CustomPrecompiledContractAllowanceKey(owner, spender)
+	key := cpctypes.Erc20CustomPrecompiledContractAllowanceKey(contract, owner, spender)
 
 	bz := store.Get(key)
 	if len(bz) == 0 {
@@ -95,6 +95,85 @@ func (k Keeper) GetErc20CpcAllowance(ctx sdk.Context, owner, spender common.Addr
 	return new(big.Int).SetBytes(bz)
 }
 
+// GetAllErc20CpcAllowances returns all allowance records of the ERC20 custom precompiled contracts.
+func (k Keeper) GetAllErc20CpcAllowances(ctx sdk.Context) []cpctypes.Erc20CpcAllowance {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, cpctypes.KeyPrefixErc20CpcAllowance)
+
+	var allowances []cpctypes.Erc20CpcAllowance
+
+	defer func() {
+		_ = iterator.Close()
+	}()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(cpctypes.KeyPrefixErc20CpcAllowance):]
+		if len(key) != 3*common.AddressLength {
+			panic(fmt.Sprintf("invalid allowance key length: %d", len(key)))
+		}
+
+		allowances = append(allowances, cpctypes.Erc20CpcAllowance{
+			Contract: common.BytesToAddress(key[:common.AddressLength]).Hex(),
+			Owner:    common.BytesToAddress(key[common.AddressLength : 2*common.AddressLength]).Hex(),
+			Spender:  common.BytesToAddress(key[2*common.AddressLength:]).Hex(),
+			Amount:   sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(iterator.Value())),
+		})
+	}
+
+	return allowances
+}
+
+// SetErc20CpcPermitNonce sets the EIP-2612 permit nonce of the owner for ERC20 custom precompiled contract.
+func (k Keeper) SetErc20CpcPermitNonce(ctx sdk.Context, contract, owner common.Address, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := cpctypes.Erc20CustomPrecompiledContractPermitNonceKey(contract, owner)
+
+	if nonce == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetErc20CpcPermitNonce returns the EIP-2612 permit nonce of the owner for ERC20 custom precompiled contract.
+func (k Keeper) GetErc20CpcPermitNonce(ctx sdk.Context, contract, owner common.Address) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	key := cpctypes.Erc20CustomPrecompiledContractPermitNonceKey(contract, owner)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetAllErc20CpcPermitNonces returns all EIP-2612 permit nonce records of the ERC20 custom precompiled contracts.
+func (k Keeper) GetAllErc20CpcPermitNonces(ctx sdk.Context) []cpctypes.Erc20CpcPermitNonce {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, cpctypes.KeyPrefixErc20CpcPermitNonce)
+
+	var nonces []cpctypes.Erc20CpcPermitNonce
+
+	defer func() {
+		_ = iterator.Close()
+	}()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(cpctypes.KeyPrefixErc20CpcPermitNonce):]
+		if len(key) != 2*common.AddressLength {
+			panic(fmt.Sprintf("invalid permit nonce key length: %d", len(key)))
+		}
+
+		nonces = append(nonces, cpctypes.Erc20CpcPermitNonce{
+			Contract: common.BytesToAddress(key[:common.AddressLength]).Hex(),
+			Owner:    common.BytesToAddress(key[common.AddressLength:]).Hex(),
+			Nonce:    sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return nonces
+}
+
 // contract
 
 var _ CustomPrecompiledContractI = &erc20CustomPrecompiledContract{}
@@ -122,6 +201,13 @@ type erc20CustomPrecompiledContract struct {
 // Also supports burnable:
 //   - burnFrom(address,uint256)
 //   - burn(uint256)
+//
+// And EIP-2612 permit:
+//   - permit(address,address,uint256,uint256,uint8,bytes32,bytes32)
+//   - nonces(address)
+//   - DOMAIN_SEPARATOR()
+//
+// Methods added from protocol version 2 are provided by newErc20CustomPrecompiledContractV2MethodExecutors.
 func NewErc20CustomPrecompiledContract(
 	metadata cpctypes.CustomPrecompiledContractMeta,
 	keeper Keeper,
@@ -135,6 +221,10 @@ func NewErc20CustomPrecompiledContract(
 		contract: contract,
 	}
 
+	approveME := erc20CustomPrecompiledContractRwApprove{
+		contract: contract,
+	}
+
 	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
 		&erc20CustomPrecompiledContractRoName{
 			contract: contract,
@@ -155,9 +245,7 @@ func NewErc20CustomPrecompiledContract(
 		&erc20CustomPrecompiledContractRwTransfer{
 			transferFrom: transferFromME,
 		},
-		&erc20CustomPrecompiledContractRwApprove{
-			contract: contract,
-		},
+		&approveME,
 		&erc20CustomPrecompiledContractRoAllowance{
 			contract: contract,
 		},
@@ -167,6 +255,15 @@ func NewErc20CustomPrecompiledContract(
 		&erc20CustomPrecompiledContractRwBurn{
 			transferFrom: transferFromME,
 		},
+		&erc20CustomPrecompiledContractRwPermit{
+			approve: approveME,
+		},
+		&erc20CustomPrecompiledContractRoNonces{
+			contract: contract,
+		},
+		&erc20CustomPrecompiledContractRoDomainSeparator{
+			contract: contract,
+		},
 	}
 
 	return contract
@@ -373,7 +470,7 @@ func (e erc20CustomPrecompiledContractRwTransferFrom) Execute(caller corevm.Cont
 	}
 
 	if from != caller.Address() {
-		if err := e.spendAllowance(ctx, from, caller.Address(), amount); err != nil {
+		if err := e.spendAllowance(ctx, contractAddr, from, caller.Address(), amount); err != nil {
 			return nil, err
 		}
 	}
@@ -381,9 +478,9 @@ func (e erc20CustomPrecompiledContractRwTransferFrom) Execute(caller corevm.Cont
 	return e.transfer(ctx, from, to, amount, contractAddr, stateDB)
 }
 
-func (e erc20CustomPrecompiledContractRwTransferFrom) spendAllowance(ctx sdk.Context, owner, spender common.Address, amount *big.Int) error {
+func (e erc20CustomPrecompiledContractRwTransferFrom) spendAllowance(ctx sdk.Context, contractAddr, owner, spender common.Address, amount *big.Int) error {
 	// check allowance
-	currentAllowance := e.contract.keeper.GetErc20CpcAllowance(ctx, owner, spender)
+	currentAllowance := e.contract.keeper.GetErc20CpcAllowance(ctx, contractAddr, owner, spender)
 	if currentAllowance.Cmp(cpctypes.BigMaxUint256) == 0 {
 		// Does not update the allowance value in case of infinite allowance.
 	} else {
@@ -392,7 +489,7 @@ func (e erc20CustomPrecompiledContractRwTransferFrom) spendAllowance(ctx sdk.Con
 		}
 
 		currentAllowance = new(big.Int).Sub(currentAllowance, amount)
-		e.contract.keeper.SetErc20CpcAllowance(ctx, owner, spender, currentAllowance)
+		e.contract.keeper.SetErc20CpcAllowance(ctx, contractAddr, owner, spender, currentAllowance)
 	}
 
 	return nil
@@ -429,15 +526,11 @@ func (e erc20CustomPrecompiledContractRwTransferFrom) transfer(ctx sdk.Context,
 		}
 	}
 
-	stateDB.AddLog(&ethtypes.Log{
-		Address: contractAddr,
-		Topics: []common.Hash{
-			common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
-			common.BytesToHash(from.Bytes()),
-			common.BytesToHash(to.Bytes()),
-		},
-		Data: common.BytesToHash(amount.Bytes()).Bytes(),
-	})
+	// Transfer log is emitted explicitly instead of bridging SDK events,
+	// because it is required even for zero-amount and self transfers, those do not emit SDK event.
+	if err := addEvmLog(stateDB, contractAddr, abi.Erc20CpcInfo, "Transfer", from, to, amount); err != nil {
+		return nil, err
+	}
 
 	return cpcutils.AbiEncodeBool(true)
 }
@@ -523,21 +616,20 @@ func (e erc20CustomPrecompiledContractRwApprove) Execute(caller corevm.ContractR
 		return nil, fmt.Errorf(`ERC20InvalidSpender("%s")`, spender.String())
 	}
 
-	e.contract.keeper.SetErc20CpcAllowance(ctx, owner, spender, value)
-
-	stateDB.AddLog(&ethtypes.Log{
-		Address: contractAddr,
-		Topics: []common.Hash{
-			common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"),
-			common.BytesToHash(owner.Bytes()),
-			common.BytesToHash(spender.Bytes()),
-		},
-		Data: common.BytesToHash(value.Bytes()).Bytes(),
-	})
+	if err := e.approve(ctx, contractAddr, owner, spender, value, stateDB); err != nil {
+		return nil, err
+	}
 
 	return abi.Erc20CpcInfo.PackMethodOutput("approve", true)
 }
 
+// approve sets the allowance and emits the Approval event.
+func (e erc20CustomPrecompiledContractRwApprove) approve(ctx sdk.Context, contractAddr, owner, spender common.Address, value *big.Int, stateDB corevm.StateDB) error {
+	e.contract.keeper.SetErc20CpcAllowance(ctx, contractAddr, owner, spender, value)
+
+	return addEvmLog(stateDB, contractAddr, abi.Erc20CpcInfo, "Approval", owner, spender, value)
+}
+
 func (e erc20CustomPrecompiledContractRwApprove) Method4BytesSignatures() []byte {
 	return []byte{0x09, 0x5e, 0xa7, 0xb3}
 }
@@ -558,7 +650,7 @@ type erc20CustomPrecompiledContractRoAllowance struct {
 	contract *erc20CustomPrecompiledContract
 }
 
-func (e erc20CustomPrecompiledContractRoAllowance) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+func (e erc20CustomPrecompiledContractRoAllowance) Execute(_ corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
 	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("allowance", input)
 	if err != nil {
 		return nil, err
@@ -568,7 +660,7 @@ func (e erc20CustomPrecompiledContractRoAllowance) Execute(_ corevm.ContractRef,
 	owner := ips[0].(common.Address)
 	spender := ips[1].(common.Address)
 
-	allowance := e.contract.keeper.GetErc20CpcAllowance(ctx, owner, spender)
+	allowance := e.contract.keeper.GetErc20CpcAllowance(ctx, contractAddr, owner, spender)
 
 	return abi.Erc20CpcInfo.PackMethodOutput("allowance", allowance)
 }
@@ -610,7 +702,7 @@ func (e erc20CustomPrecompiledContractRwBurnFrom) Execute(caller corevm.Contract
 	}
 
 	if address != caller.Address() {
-		if err := e.transferFrom.spendAllowance(ctx, address, caller.Address(), amount); err != nil {
+		if err := e.transferFrom.spendAllowance(ctx, contractAddr, address, caller.Address(), amount); err != nil {
 			return nil, err
 		}
 	}
@@ -668,3 +760,277 @@ func (e erc20CustomPrecompiledContractRwBurn) RequireGas() uint64 {
 func (e erc20CustomPrecompiledContractRwBurn) ReadOnly() bool {
 	return false
 }
+
+// EIP-2612: permit(address,address,uint256,uint256,uint8,bytes32,bytes32)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRwPermit{}
+
+type erc20CustomPrecompiledContractRwPermit struct {
+	approve erc20CustomPrecompiledContractRwApprove
+}
+
+func (e erc20CustomPrecompiledContractRwPermit) Execute(_ corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("permit", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	stateDB := env.evm.StateDB
+	keeper := e.approve.contract.keeper
+
+	owner := ips[0].(common.Address)
+	spender := ips[1].(common.Address)
+	value := ips[2].(*big.Int)
+	deadline := ips[3].(*big.Int)
+	v := ips[4].(uint8)
+	r := ips[5].([32]byte)
+	s := ips[6].([32]byte)
+
+	if deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, fmt.Errorf(`ERC2612ExpiredSignature(%s)`, deadline.String())
+	}
+
+	if owner == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidApprover("%s")`, owner.String())
+	} else if spender == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidSpender("%s")`, spender.String())
+	}
+
+	nonce := keeper.GetErc20CpcPermitNonce(ctx, contractAddr, owner)
+
+	permitMessage := abi.PermitMessage{
+		TokenName: e.approve.contract.metadata.Name,
+		Contract:  contractAddr,
+		Owner:     owner,
+		Spender:   spender,
+		Value:     value,
+		Nonce:     new(big.Int).SetUint64(nonce),
+		Deadline:  deadline,
+	}
+
+	match, recoveredAddr, err := eip712.VerifySignature(owner, permitMessage, r, s, v, env.evm.ChainConfig().ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify signature: %s", err.Error())
+	}
+	if !match {
+		return nil, fmt.Errorf(`ERC2612InvalidSigner("%s", "%s")`, recoveredAddr.String(), owner.String())
+	}
+
+	keeper.SetErc20CpcPermitNonce(ctx, contractAddr, owner, nonce+1)
+
+	if err := e.approve.approve(ctx, contractAddr, owner, spender, value, stateDB); err != nil {
+		return nil, err
+	}
+
+	return abi.Erc20CpcInfo.PackMethodOutput("permit")
+}
+
+func (e erc20CustomPrecompiledContractRwPermit) Method4BytesSignatures() []byte {
+	return []byte{0xd5, 0x05, 0xac, 0xcf}
+}
+
+func (e erc20CustomPrecompiledContractRwPermit) RequireGas() uint64 {
+	return e.approve.RequireGas() + cpctypes.GasVerifyEIP712
+}
+
+func (e erc20CustomPrecompiledContractRwPermit) ReadOnly() bool {
+	return false
+}
+
+// EIP-2612: nonces(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRoNonces{}
+
+type erc20CustomPrecompiledContractRoNonces struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRoNonces) Execute(_ corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("nonces", input)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := ips[0].(common.Address)
+
+	nonce := e.contract.keeper.GetErc20CpcPermitNonce(env.ctx, contractAddr, owner)
+
+	return abi.Erc20CpcInfo.PackMethodOutput("nonces", new(big.Int).SetUint64(nonce))
+}
+
+func (e erc20CustomPrecompiledContractRoNonces) Method4BytesSignatures() []byte {
+	return []byte{0x7e, 0xce, 0xbe, 0x00}
+}
+
+func (e erc20CustomPrecompiledContractRoNonces) RequireGas() uint64 {
+	return 1000
+}
+
+func (e erc20CustomPrecompiledContractRoNonces) ReadOnly() bool {
+	return true
+}
+
+// EIP-2612: DOMAIN_SEPARATOR()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRoDomainSeparator{}
+
+type erc20CustomPrecompiledContractRoDomainSeparator struct {
+	contract *erc20CustomPrecompiledContract
+}
+
+func (e erc20CustomPrecompiledContractRoDomainSeparator) Execute(_ corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.Erc20CpcInfo.UnpackMethodInput("DOMAIN_SEPARATOR", input)
+	if err != nil {
+		return nil, err
+	}
+
+	permitMessage := abi.PermitMessage{
+		TokenName: e.contract.metadata.Name,
+		Contract:  contractAddr,
+	}
+
+	domainSeparator, err := eip712.DomainSeparator(permitMessage.ToTypedData(env.evm.ChainConfig().ChainID))
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Erc20CpcInfo.PackMethodOutput("DOMAIN_SEPARATOR", [32]byte(domainSeparator))
+}
+
+func (e erc20CustomPrecompiledContractRoDomainSeparator) Method4BytesSignatures() []byte {
+	return []byte{0x36, 0x44, 0xe5, 0x15}
+}
+
+func (e erc20CustomPrecompiledContractRoDomainSeparator) RequireGas() uint64 {
+	return 1000
+}
+
+func (e erc20CustomPrecompiledContractRoDomainSeparator) ReadOnly() bool {
+	return true
+}
+
+// newErc20CustomPrecompiledContractV2MethodExecutors returns the method executors,
+// which are added into the ERC-20 custom precompiled contracts from protocol version 2:
+//   - increaseAllowance(address,uint256)
+//   - decreaseAllowance(address,uint256)
+func newErc20CustomPrecompiledContractV2MethodExecutors(contract CustomPrecompiledContractI) []ExtendedCustomPrecompiledContractMethodExecutorI {
+	erc20Contract := contract.(*erc20CustomPrecompiledContract)
+
+	approveME := erc20CustomPrecompiledContractRwApprove{
+		contract: erc20Contract,
+	}
+
+	return []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&erc20CustomPrecompiledContractRwIncreaseAllowance{
+			approve: approveME,
+		},
+		&erc20CustomPrecompiledContractRwDecreaseAllowance{
+			approve: approveME,
+		},
+	}
+}
+
+// ERC-20: increaseAllowance(address,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRwIncreaseAllowance{}
+
+type erc20CustomPrecompiledContractRwIncreaseAllowance struct {
+	approve erc20CustomPrecompiledContractRwApprove
+}
+
+func (e erc20CustomPrecompiledContractRwIncreaseAllowance) Execute(caller corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("increaseAllowance", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	stateDB := env.evm.StateDB
+
+	owner := caller.Address()
+	spender := ips[0].(common.Address)
+	addedValue := ips[1].(*big.Int)
+
+	if owner == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidApprover("%s")`, owner.String())
+	} else if spender == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidSpender("%s")`, spender.String())
+	}
+
+	currentAllowance := e.approve.contract.keeper.GetErc20CpcAllowance(ctx, contractAddr, owner, spender)
+	newAllowance := new(big.Int).Add(currentAllowance, addedValue)
+	if newAllowance.Cmp(cpctypes.BigMaxUint256) > 0 {
+		return nil, fmt.Errorf("allowance overflow")
+	}
+
+	if err := e.approve.approve(ctx, contractAddr, owner, spender, newAllowance, stateDB); err != nil {
+		return nil, err
+	}
+
+	return abi.Erc20CpcInfo.PackMethodOutput("increaseAllowance", true)
+}
+
+func (e erc20CustomPrecompiledContractRwIncreaseAllowance) Method4BytesSignatures() []byte {
+	return []byte{0x39, 0x50, 0x93, 0x51}
+}
+
+func (e erc20CustomPrecompiledContractRwIncreaseAllowance) RequireGas() uint64 {
+	return e.approve.RequireGas()
+}
+
+func (e erc20CustomPrecompiledContractRwIncreaseAllowance) ReadOnly() bool {
+	return false
+}
+
+// ERC-20: decreaseAllowance(address,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &erc20CustomPrecompiledContractRwDecreaseAllowance{}
+
+type erc20CustomPrecompiledContractRwDecreaseAllowance struct {
+	approve erc20CustomPrecompiledContractRwApprove
+}
+
+func (e erc20CustomPrecompiledContractRwDecreaseAllowance) Execute(caller corevm.ContractRef, contractAddr common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.Erc20CpcInfo.UnpackMethodInput("decreaseAllowance", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	stateDB := env.evm.StateDB
+
+	owner := caller.Address()
+	spender := ips[0].(common.Address)
+	requestedDecrease := ips[1].(*big.Int)
+
+	if owner == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidApprover("%s")`, owner.String())
+	} else if spender == (common.Address{}) {
+		return nil, fmt.Errorf(`ERC20InvalidSpender("%s")`, spender.String())
+	}
+
+	currentAllowance := e.approve.contract.keeper.GetErc20CpcAllowance(ctx, contractAddr, owner, spender)
+	if currentAllowance.Cmp(requestedDecrease) < 0 {
+		return nil, fmt.Errorf(`ERC20FailedDecreaseAllowance("%s", %s, %s)`, spender.String(), currentAllowance.String(), requestedDecrease.String())
+	}
+
+	newAllowance := new(big.Int).Sub(currentAllowance, requestedDecrease)
+	if err := e.approve.approve(ctx, contractAddr, owner, spender, newAllowance, stateDB); err != nil {
+		return nil, err
+	}
+
+	return abi.Erc20CpcInfo.PackMethodOutput("decreaseAllowance", true)
+}
+
+func (e erc20CustomPrecompiledContractRwDecreaseAllowance) Method4BytesSignatures() []byte {
+	return []byte{0xa4, 0x57, 0xc2, 0xd7}
+}
+
+func (e erc20CustomPrecompiledContractRwDecreaseAllowance) RequireGas() uint64 {
+	return e.approve.RequireGas()
+}
+
+func (e erc20CustomPrecompiledContractRwDecreaseAllowance) ReadOnly() bool {
+	return false
+}
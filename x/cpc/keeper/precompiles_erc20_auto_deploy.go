@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+)
+
+// Erc20AutoDeployTrigger is the event which triggers the automatic deployment of the ERC20 custom precompiled contract.
+type Erc20AutoDeployTrigger uint8
+
+const (
+	// Erc20AutoDeployOnIbcReceive is triggered when IBC vouchers are received.
+	Erc20AutoDeployOnIbcReceive Erc20AutoDeployTrigger = iota + 1
+	// Erc20AutoDeployOnDenomMetadataSet is triggered when the denom metadata is set into `x/bank`.
+	Erc20AutoDeployOnDenomMetadataSet
+)
+
+// AutoDeployErc20CustomPrecompiledContract deploys the ERC20 custom precompiled contract for the denom,
+// if the automatic deployment is enabled for the trigger and the denom is allowed, following the module params.
+// Name, symbol and decimals are taken from the denom metadata.
+// Failure does not abort the caller, the contract is just not deployed.
+// Returns the address of the deployed contract, or nil if not deployed.
+func (k Keeper) AutoDeployErc20CustomPrecompiledContract(ctx sdk.Context, denom string, trigger Erc20AutoDeployTrigger) *common.Address {
+	autoDeploy := k.GetParams(ctx).Erc20AutoDeploy
+
+	switch trigger {
+	case Erc20AutoDeployOnIbcReceive:
+		if !autoDeploy.OnIbcReceive {
+			return nil
+		}
+	case Erc20AutoDeployOnDenomMetadataSet:
+		if !autoDeploy.OnDenomMetadataSet {
+			return nil
+		}
+	default:
+		panic(fmt.Sprintf("unknown ERC20 auto deploy trigger %d", trigger))
+	}
+
+	if !autoDeploy.IsDenomAllowed(denom) {
+		return nil
+	}
+
+	if k.GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, denom) != nil {
+		return nil
+	}
+
+	if !k.bankKeeper.GetSupply(ctx, denom).IsPositive() {
+		// like the metadata of IBC vouchers, which is set before minting, the contract will be deployed when minted
+		return nil
+	}
+
+	// deploy within a cached context so nothing is persisted if failed
+	cacheCtx, writeCache := ctx.CacheContext()
+	contractAddress, err := k.deployErc20CustomPrecompiledContractFromDenomMetadata(cacheCtx, denom)
+	if err != nil {
+		k.Logger(ctx).Error("failed to auto deploy ERC20 custom precompiled contract", "denom", denom, "error", err.Error())
+		return nil
+	}
+	writeCache()
+
+	return &contractAddress
+}
+
+func (k Keeper) deployErc20CustomPrecompiledContractFromDenomMetadata(ctx sdk.Context, denom string) (common.Address, error) {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return common.Address{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "denom metadata not found for %s", denom)
+	}
+
+	decimals, err := getDecimalsFromDenomMetadata(metadata)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	name := metadata.Name
+	if name == "" {
+		name = metadata.Symbol
+	}
+
+	return k.DeployErc20CustomPrecompiledContract(ctx, name, cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   metadata.Symbol,
+		Decimals: decimals,
+		MinDenom: denom,
+	})
+}
+
+// getDecimalsFromDenomMetadata returns the exponent of the display denom unit.
+// When the display denom unit is not declared, like the metadata of IBC vouchers set by `x/ibc-transfer`
+// which only has the unit of exponent 0 and uses the full denom path as display, the base denom unit is used.
+func getDecimalsFromDenomMetadata(metadata banktypes.Metadata) (uint8, error) {
+	var baseDenomUnit *banktypes.DenomUnit
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit == nil {
+			continue
+		}
+
+		if baseDenomUnit == nil && (denomUnit.Denom == metadata.Base || denomUnit.Exponent == 0) {
+			baseDenomUnit = denomUnit
+		}
+
+		if denomUnit.Denom != metadata.Display {
+			continue
+		}
+
+		if denomUnit.Exponent > 18 {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "exponent of display denom unit is too big: %d", denomUnit.Exponent)
+		}
+
+		return uint8(denomUnit.Exponent), nil
+	}
+
+	if baseDenomUnit != nil {
+		if baseDenomUnit.Exponent != 0 {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "exponent of base denom unit must be zero: %d", baseDenomUnit.Exponent)
+		}
+
+		return 0, nil
+	}
+
+	return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "neither display nor base denom unit found for %s", metadata.Base)
+}
@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/EscanBE/evermint/x/cpc"
+	cpckeeper "github.com/EscanBE/evermint/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+)
+
+func (suite *CpcTestSuite) TestKeeper_AutoDeployErc20CustomPrecompiledContract() {
+	const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	metadata := banktypes.Metadata{
+		Description: "IBC voucher",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: ibcDenom, Exponent: 0},
+			{Denom: "ATOM", Exponent: 6},
+		},
+		Base:    ibcDenom,
+		Display: "ATOM",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+	}
+
+	setAutoDeploy := func(ctx sdk.Context, autoDeploy cpctypes.Erc20AutoDeploy) {
+		params := suite.App().CpcKeeper().GetParams(ctx)
+		params.Erc20AutoDeploy = autoDeploy
+		suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+	}
+
+	mint := func(ctx sdk.Context, denom string) {
+		err := suite.App().BankKeeper().MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000_000)))
+		suite.Require().NoError(err)
+	}
+
+	getDeployedErc20Meta := func(ctx sdk.Context, denom string) (*cpctypes.CustomPrecompiledContractMeta, cpctypes.Erc20CustomPrecompiledContractMeta) {
+		var erc20Meta cpctypes.Erc20CustomPrecompiledContractMeta
+
+		contractAddress := suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, denom)
+		if contractAddress == nil {
+			return nil, erc20Meta
+		}
+
+		contractMeta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, *contractAddress)
+		suite.Require().NotNil(contractMeta)
+		suite.Require().NoError(json.Unmarshal([]byte(contractMeta.TypedMeta), &erc20Meta))
+		return contractMeta, erc20Meta
+	}
+
+	suite.Run("pass - deployed on IBC receive, following the denom metadata", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnIbcReceive: true})
+		suite.App().BankKeeper().SetDenomMetaData(ctx, metadata)
+		mint(ctx, ibcDenom)
+
+		contractAddress := suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnIbcReceive)
+		suite.Require().NotNil(contractAddress)
+
+		contractMeta, erc20Meta := getDeployedErc20Meta(ctx, ibcDenom)
+		suite.Require().NotNil(contractMeta)
+		suite.Equal(*contractAddress, common.BytesToAddress(contractMeta.Address))
+		suite.Equal("Cosmos Hub Atom", contractMeta.Name)
+		suite.Equal(cpctypes.Erc20CustomPrecompiledContractMeta{
+			Symbol:   "ATOM",
+			Decimals: 6,
+			MinDenom: ibcDenom,
+		}, erc20Meta)
+
+		suite.Nil(
+			suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnIbcReceive),
+			"should not deploy again",
+		)
+	})
+
+	suite.Run("pass - deployed when denom metadata is set", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnDenomMetadataSet: true})
+		mint(ctx, ibcDenom)
+
+		suite.App().BankKeeper().SetDenomMetaData(ctx, metadata)
+
+		contractMeta, _ := getDeployedErc20Meta(ctx, ibcDenom)
+		suite.NotNil(contractMeta)
+	})
+
+	suite.Run("pass - deployed when minted, if denom metadata is set before minting", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnDenomMetadataSet: true})
+
+		suite.App().BankKeeper().SetDenomMetaData(ctx, metadata)
+
+		contractMeta, _ := getDeployedErc20Meta(ctx, ibcDenom)
+		suite.Nil(contractMeta, "should not deploy without supply")
+
+		mint(ctx, ibcDenom)
+
+		contractMeta, _ = getDeployedErc20Meta(ctx, ibcDenom)
+		suite.NotNil(contractMeta)
+	})
+
+	suite.Run("pass - not deployed on IBC receive when there is no supply", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnIbcReceive: true})
+		suite.App().BankKeeper().SetDenomMetaData(ctx, metadata)
+
+		suite.Nil(suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnIbcReceive))
+	})
+
+	suite.Run("pass - decimals of the base denom unit are used when display denom unit is not declared", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnIbcReceive: true})
+
+		baseOnlyMetadata := metadata
+		baseOnlyMetadata.DenomUnits = []*banktypes.DenomUnit{{Denom: "uatom", Exponent: 0}}
+		baseOnlyMetadata.Display = "transfer/channel-0/uatom"
+		suite.App().BankKeeper().SetDenomMetaData(ctx, baseOnlyMetadata)
+		mint(ctx, ibcDenom)
+
+		suite.Require().NotNil(suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnIbcReceive))
+
+		_, erc20Meta := getDeployedErc20Meta(ctx, ibcDenom)
+		suite.Equal(uint8(0), erc20Meta.Decimals)
+	})
+
+	suite.Run("pass - not deployed when the trigger is not enabled", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{})
+		suite.App().BankKeeper().SetDenomMetaData(ctx, metadata)
+		mint(ctx, ibcDenom)
+
+		suite.Nil(suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnIbcReceive))
+		suite.Nil(suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnDenomMetadataSet))
+	})
+
+	suite.Run("pass - not deployed when the denom is not allowed", func() {
+		for _, autoDeploy := range []cpctypes.Erc20AutoDeploy{
+			{OnIbcReceive: true, AllowedDenoms: []string{"factory/*"}},
+			{OnIbcReceive: true, DeniedDenoms: []string{"ibc/*"}},
+			{OnIbcReceive: true, AllowedDenoms: []string{"ibc/*"}, DeniedDenoms: []string{ibcDenom}},
+		} {
+			ctx, _ := suite.Ctx().CacheContext()
+			setAutoDeploy(ctx, autoDeploy)
+			suite.App().BankKeeper().SetDenomMetaData(ctx, metadata)
+			mint(ctx, ibcDenom)
+
+			suite.Nil(suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnIbcReceive))
+		}
+	})
+
+	suite.Run("pass - not deployed when denom metadata is missing", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnIbcReceive: true})
+		mint(ctx, ibcDenom)
+
+		suite.Nil(suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnIbcReceive))
+	})
+
+	suite.Run("pass - not deployed when denom metadata is invalid", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnIbcReceive: true})
+
+		invalidMetadata := metadata
+		invalidMetadata.Symbol = ""
+		suite.App().BankKeeper().SetDenomMetaData(ctx, invalidMetadata)
+		mint(ctx, ibcDenom)
+
+		moduleAccountNonce := suite.App().CpcKeeper().GetModuleAccountNonce(ctx)
+
+		suite.Nil(suite.App().CpcKeeper().AutoDeployErc20CustomPrecompiledContract(ctx, ibcDenom, cpckeeper.Erc20AutoDeployOnIbcReceive))
+		suite.Equal(moduleAccountNonce, suite.App().CpcKeeper().GetModuleAccountNonce(ctx), "nothing should be persisted")
+	})
+}
+
+func (suite *CpcTestSuite) TestIBCTransferMiddleware_OnRecvPacketAutoDeployErc20() {
+	const (
+		sourcePort    = "transfer"
+		sourceChannel = "channel-1"
+		destPort      = "transfer"
+		destChannel   = "channel-0"
+		baseDenom     = "uatom"
+	)
+
+	receiver := suite.CITS.WalletAccounts.Number(1)
+	voucherDenom := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(destPort, destChannel, baseDenom)).IBCDenom()
+
+	middleware := cpc.NewIBCTransferMiddleware(
+		ibctransfer.NewIBCModule(*suite.App().IbcTransferKeeper()),
+		*suite.App().CpcKeeper(),
+		suite.App().EvmKeeper(),
+	)
+
+	recvPacket := func(ctx sdk.Context) {
+		data := ibctransfertypes.NewFungibleTokenPacketData(baseDenom, "1000", "cosmos1sender", receiver.GetCosmosAddress().String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, sourcePort, sourceChannel, destPort, destChannel, clienttypes.NewHeight(1, 1000), 0)
+
+		ack := middleware.OnRecvPacket(ctx, packet, nil)
+		suite.Require().NotNil(ack)
+		suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+		// the metadata is written by `x/ibc-transfer`
+		metadata, found := suite.App().BankKeeper().GetDenomMetaData(ctx, voucherDenom)
+		suite.Require().True(found)
+		suite.Require().Len(metadata.DenomUnits, 1)
+		suite.Require().NotEqual(metadata.Display, metadata.DenomUnits[0].Denom)
+		suite.Require().NotEqual(metadata.Base, metadata.DenomUnits[0].Denom)
+	}
+
+	requireDeployed := func(ctx sdk.Context) {
+		contractAddress := suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, voucherDenom)
+		suite.Require().NotNil(contractAddress, "contract should be deployed")
+
+		contractMeta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, *contractAddress)
+		suite.Require().NotNil(contractMeta)
+
+		var erc20Meta cpctypes.Erc20CustomPrecompiledContractMeta
+		suite.Require().NoError(json.Unmarshal([]byte(contractMeta.TypedMeta), &erc20Meta))
+		suite.Equal(cpctypes.Erc20CustomPrecompiledContractMeta{
+			Symbol:   "UATOM",
+			Decimals: 0,
+			MinDenom: voucherDenom,
+		}, erc20Meta)
+		suite.Equal("transfer/channel-0/uatom IBC token", contractMeta.Name)
+	}
+
+	setAutoDeploy := func(ctx sdk.Context, autoDeploy cpctypes.Erc20AutoDeploy) {
+		params := suite.App().CpcKeeper().GetParams(ctx)
+		params.Erc20AutoDeploy = autoDeploy
+		suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+	}
+
+	suite.Run("pass - deployed on IBC receive", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnIbcReceive: true})
+
+		recvPacket(ctx)
+
+		requireDeployed(ctx)
+	})
+
+	suite.Run("pass - deployed when the denom metadata is set by x/ibc-transfer", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{OnDenomMetadataSet: true})
+
+		recvPacket(ctx)
+
+		requireDeployed(ctx)
+	})
+
+	suite.Run("pass - not deployed when disabled", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		setAutoDeploy(ctx, cpctypes.Erc20AutoDeploy{})
+
+		recvPacket(ctx)
+
+		suite.Nil(suite.App().CpcKeeper().GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, voucherDenom))
+	})
+}
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"fmt"
 	"math/big"
+	"time"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
 
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
@@ -101,29 +104,30 @@ func (suite *CpcTestSuite) TestKeeper_DeployErc20CustomPrecompiledContract() {
 }
 
 func (suite *CpcTestSuite) TestKeeper_SetErc20CpcAllowance() {
+	contract := common.BytesToAddress([]byte("contract"))
 	owner := common.BytesToAddress([]byte("owner"))
 	spender := common.BytesToAddress([]byte("spender"))
 
 	suite.Run("pass - (get) when not set, returns empty", func() {
-		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), owner, spender)
+		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), contract, owner, spender)
 		suite.Zero(allowance.Sign())
 	})
 
 	suite.Run("pass - (set) can set", func() {
-		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), owner, spender, big.NewInt(2))
+		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), contract, owner, spender, big.NewInt(2))
 	})
 
 	suite.Run("pass - (get) returns correctly", func() {
-		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), owner, spender)
+		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), contract, owner, spender)
 		suite.Equal("2", allowance.String())
 	})
 
 	suite.Run("pass - (get/set) can working with max uint256", func() {
 		maxUint256 := cpctypes.BigMaxUint256
 		suite.Require().Equal(1, maxUint256.Sign())
-		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), owner, spender, maxUint256)
+		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), contract, owner, spender, maxUint256)
 
-		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), owner, spender)
+		allowance := suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), contract, owner, spender)
 		suite.Require().Equal(maxUint256, allowance)
 	})
 
@@ -132,9 +136,20 @@ func (suite *CpcTestSuite) TestKeeper_SetErc20CpcAllowance() {
 		suite.Require().Equal(1, uint264.Sign())
 
 		suite.Require().Panics(func() {
-			suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), owner, spender, uint264)
+			suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), contract, owner, spender, uint264)
 		})
 	})
+
+	suite.Run("pass - allowance is scoped by contract", func() {
+		otherContract := common.BytesToAddress([]byte("other-contract"))
+
+		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), contract, owner, spender, big.NewInt(3))
+		suite.Zero(suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), otherContract, owner, spender).Sign())
+
+		suite.App().CpcKeeper().SetErc20CpcAllowance(suite.Ctx(), otherContract, owner, spender, big.NewInt(4))
+		suite.Equal("3", suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), contract, owner, spender).String())
+		suite.Equal("4", suite.App().CpcKeeper().GetErc20CpcAllowance(suite.Ctx(), otherContract, owner, spender).String())
+	})
 }
 
 func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
@@ -376,7 +391,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		suite.Require().NoError(err)
 		suite.Empty(res.VmError)
 
-		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 
 		// spender transfer on-behalf of owner
 		balanceOfSenderBefore := balance(ctx, owner)
@@ -399,7 +414,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		balanceOfReceiverAfter := balance(ctx, receiver)
 		suite.Equal(balanceOfReceiverAfter.Sub(balanceOfReceiverBefore).String(), transferAmount.String())
 
-		suite.Equal(new(big.Int).Sub(grantAmount, transferAmount).String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Equal(new(big.Int).Sub(grantAmount, transferAmount).String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 	})
 
 	suite.Run("pass - transferFrom(address,address,uint256) with infinity allowance", func() {
@@ -439,7 +454,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 
 		suite.Equal(
 			cpctypes.BigMaxUint256.String(),
-			suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String(),
+			suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String(),
 			"should not update the infinite allowance",
 		)
 	})
@@ -459,7 +474,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		suite.Require().NoError(err)
 		suite.Empty(res.VmError)
 
-		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 
 		// spender transfer on-behalf of owner
 		balanceOfSenderBefore := balance(ctx, owner)
@@ -478,7 +493,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		balanceOfReceiverAfter := balance(ctx, receiver)
 		suite.Equal(balanceOfReceiverBefore.String(), balanceOfReceiverAfter.String())
 
-		suite.Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String()) // unchanged
+		suite.Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String()) // unchanged
 	})
 
 	suite.Run("fail - transferFrom(address,address,uint256) without allowance", func() {
@@ -595,7 +610,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		suite.Require().NoError(err)
 		suite.Empty(res.VmError)
 
-		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Require().Equal(grantAmount.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 
 		// spender burns on-behalf of owner
 		balanceOfOwnerBefore := balance(ctx, owner)
@@ -614,7 +629,7 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		balanceOfOwnerAfter := balance(ctx, owner)
 		suite.Equal(balanceOfOwnerBefore.Sub(balanceOfOwnerAfter).String(), burnAmount.String())
 
-		suite.Equal(new(big.Int).Sub(grantAmount, burnAmount).String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, owner, spender).String())
+		suite.Equal(new(big.Int).Sub(grantAmount, burnAmount).String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
 	})
 
 	suite.Run("pass - burn(uint256)", func() {
@@ -653,3 +668,250 @@ func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract() {
 		}
 	})
 }
+
+func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract_Permit() {
+	const name = constants.DisplayDenom
+	erc20Meta := cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   constants.DisplayDenom,
+		Decimals: constants.BaseDenomExponent,
+		MinDenom: constants.BaseDenom,
+	}
+
+	account1 := suite.CITS.WalletAccounts.Number(1)
+
+	contractAddr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(suite.Ctx(), name, erc20Meta)
+	suite.Require().NoError(err)
+
+	chainId := suite.App().EvmKeeper().GetEip155ChainId(suite.Ctx()).BigInt()
+
+	nonces := func(ctx sdk.Context, owner common.Address) *big.Int {
+		input := simpleBuildContractInput(get4BytesSignature("nonces(address)"), owner)
+		res, err := suite.EthCallApply(ctx, nil, contractAddr, input)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		nonce, err := cpcutils.AbiDecodeUint256(res.Ret)
+		suite.Require().NoError(err)
+		return nonce
+	}
+
+	buildPermitInput := func(msg abi.PermitMessage, r, s [32]byte, v uint8) []byte {
+		input, err := abi.Erc20CpcInfo.ABI.Methods["permit"].Inputs.Pack(msg.Owner, msg.Spender, msg.Value, msg.Deadline, v, r, s)
+		suite.Require().NoError(err)
+		return append(get4BytesSignature("permit(address,address,uint256,uint256,uint8,bytes32,bytes32)"), input...)
+	}
+
+	newPermitMessage := func(ctx sdk.Context, owner, spender common.Address, value *big.Int) abi.PermitMessage {
+		return abi.PermitMessage{
+			TokenName: name,
+			Contract:  contractAddr,
+			Owner:     owner,
+			Spender:   spender,
+			Value:     value,
+			Nonce:     nonces(ctx, owner),
+			Deadline:  big.NewInt(ctx.BlockTime().Add(time.Hour).Unix()),
+		}
+	}
+
+	suite.Run("pass - DOMAIN_SEPARATOR()", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, contractAddr, get4BytesSignature("DOMAIN_SEPARATOR()"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		// keccak256(abi.encode(TYPE_HASH, keccak256(name), keccak256(version), chainId, address(this)))
+		var encoded []byte
+		encoded = append(encoded, crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))...)
+		encoded = append(encoded, crypto.Keccak256([]byte(name))...)
+		encoded = append(encoded, crypto.Keccak256([]byte("1"))...)
+		encoded = append(encoded, common.BigToHash(chainId).Bytes()...)
+		encoded = append(encoded, common.BytesToHash(contractAddr.Bytes()).Bytes()...)
+
+		suite.Equal(crypto.Keccak256(encoded), res.Ret)
+	})
+
+	suite.Run("pass - nonces(address) returns zero by default", func() {
+		suite.Zero(nonces(suite.Ctx(), account1.GetEthAddress()).Sign())
+	})
+
+	suite.Run("pass - permit(address,address,uint256,uint256,uint8,bytes32,bytes32)", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		owner := account1.GetEthAddress()
+		spender := common.BytesToAddress([]byte("spender"))
+		value := big.NewInt(500)
+
+		msg := newPermitMessage(ctx, owner, spender, value)
+		r, s, v := suite.hashEip712Message(msg, account1)
+
+		// anyone can submit the permit
+		relayer := common.BytesToAddress([]byte("relayer"))
+		res, err := suite.EthCallApply(ctx, &relayer, contractAddr, buildPermitInput(msg, r, s, v))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		suite.Equal(value.String(), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).String())
+		suite.Equal("1", nonces(ctx, owner).String())
+
+		var receipt ethtypes.Receipt
+		err = receipt.UnmarshalBinary(res.MarshalledReceipt)
+		suite.Require().NoError(err)
+		if suite.Len(receipt.Logs, 1, "expect event Approval") {
+			log := receipt.Logs[0]
+			if suite.Len(log.Topics, 3, "expect 3 topics") {
+				suite.Equal("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925", log.Topics[0].String())
+				suite.Equal(owner.String(), common.BytesToAddress(log.Topics[1].Bytes()).String())
+				suite.Equal(spender.String(), common.BytesToAddress(log.Topics[2].Bytes()).String())
+			}
+		}
+
+		suite.Run("fail - replay the same signature", func() {
+			res, err := suite.EthCallApply(ctx, &relayer, contractAddr, buildPermitInput(msg, r, s, v))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "ERC2612InvalidSigner")
+			suite.Equal("1", nonces(ctx, owner).String())
+		})
+	})
+
+	suite.Run("fail - permit with expired deadline", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		owner := account1.GetEthAddress()
+		spender := common.BytesToAddress([]byte("spender"))
+
+		msg := newPermitMessage(ctx, owner, spender, big.NewInt(500))
+		msg.Deadline = big.NewInt(ctx.BlockTime().Add(-time.Second).Unix())
+		r, s, v := suite.hashEip712Message(msg, account1)
+
+		res, err := suite.EthCallApply(ctx, &owner, contractAddr, buildPermitInput(msg, r, s, v))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC2612ExpiredSignature")
+		suite.Zero(suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).Sign())
+		suite.Zero(nonces(ctx, owner).Sign())
+	})
+
+	suite.Run("fail - permit signed by other account", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		owner := account1.GetEthAddress()
+		spender := common.BytesToAddress([]byte("spender"))
+
+		msg := newPermitMessage(ctx, owner, spender, big.NewInt(500))
+		msg.Owner = suite.CITS.WalletAccounts.Number(2).GetEthAddress()
+		r, s, v := suite.hashEip712Message(msg, suite.CITS.WalletAccounts.Number(2))
+		msg.Owner = owner
+
+		res, err := suite.EthCallApply(ctx, &owner, contractAddr, buildPermitInput(msg, r, s, v))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC2612InvalidSigner")
+		suite.Zero(suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).Sign())
+	})
+
+	suite.Run("fail - permit signature of other contract can not be used", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		owner := account1.GetEthAddress()
+		spender := common.BytesToAddress([]byte("spender"))
+
+		msg := newPermitMessage(ctx, owner, spender, big.NewInt(500))
+		msg.Contract = common.BytesToAddress([]byte("other-contract"))
+		r, s, v := suite.hashEip712Message(msg, account1)
+
+		res, err := suite.EthCallApply(ctx, &owner, contractAddr, buildPermitInput(msg, r, s, v))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC2612InvalidSigner")
+	})
+
+	suite.Run("fail - permit to zero spender", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		owner := account1.GetEthAddress()
+
+		msg := newPermitMessage(ctx, owner, common.Address{}, big.NewInt(500))
+		r, s, v := suite.hashEip712Message(msg, account1)
+
+		res, err := suite.EthCallApply(ctx, &owner, contractAddr, buildPermitInput(msg, r, s, v))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC20InvalidSpender")
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Erc20CustomPrecompiledContract_IncreaseDecreaseAllowance() {
+	const name = constants.DisplayDenom
+	erc20Meta := cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   constants.DisplayDenom,
+		Decimals: constants.BaseDenomExponent,
+		MinDenom: constants.BaseDenom,
+	}
+
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	owner := account1.GetEthAddress()
+	spender := common.BytesToAddress([]byte("spender"))
+
+	contractAddr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(suite.Ctx(), name, erc20Meta)
+	suite.Require().NoError(err)
+
+	increaseInput := simpleBuildContractInput(get4BytesSignature("increaseAllowance(address,uint256)"), spender, big.NewInt(500))
+	decreaseInput := simpleBuildContractInput(get4BytesSignature("decreaseAllowance(address,uint256)"), spender, big.NewInt(200))
+
+	suite.Run("fail - not available before protocol version 2", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		suite.setProtocolVersion(ctx, cpctypes.ProtocolCpcV1)
+
+		res, err := suite.EthCallApply(ctx, &owner, contractAddr, increaseInput)
+		suite.Require().NoError(err)
+		suite.NotEmpty(res.VmError)
+
+		res, err = suite.EthCallApply(ctx, &owner, contractAddr, decreaseInput)
+		suite.Require().NoError(err)
+		suite.NotEmpty(res.VmError)
+
+		suite.Zero(suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender).Sign())
+	})
+
+	suite.Run("pass - increase then decrease", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		suite.setProtocolVersion(ctx, cpctypes.ProtocolCpcV2)
+
+		res, err := suite.EthCallApply(ctx, &owner, contractAddr, increaseInput)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+		suite.Require().NoError(err)
+		suite.True(gotSuccess)
+		suite.Equal(big.NewInt(500), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender))
+
+		var receipt ethtypes.Receipt
+		suite.Require().NoError(receipt.UnmarshalBinary(res.MarshalledReceipt))
+		if suite.Len(receipt.Logs, 1, "expect event Approval") {
+			log := receipt.Logs[0]
+			suite.Equal("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925", log.Topics[0].String())
+			suite.Equal(big.NewInt(500), new(big.Int).SetBytes(log.Data), "Approval event carries the updated allowance")
+		}
+
+		res, err = suite.EthCallApply(ctx, &owner, contractAddr, decreaseInput)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(big.NewInt(300), suite.App().CpcKeeper().GetErc20CpcAllowance(ctx, contractAddr, owner, spender))
+	})
+
+	suite.Run("fail - decrease below zero", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		suite.setProtocolVersion(ctx, cpctypes.ProtocolCpcV2)
+
+		res, err := suite.EthCallApply(ctx, &owner, contractAddr, decreaseInput)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "ERC20FailedDecreaseAllowance")
+	})
+
+	suite.Run("fail - increase overflow", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		suite.setProtocolVersion(ctx, cpctypes.ProtocolCpcV2)
+
+		suite.App().CpcKeeper().SetErc20CpcAllowance(ctx, contractAddr, owner, spender, cpctypes.BigMaxUint256)
+
+		res, err := suite.EthCallApply(ctx, &owner, contractAddr, increaseInput)
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "allowance overflow")
+	})
+}
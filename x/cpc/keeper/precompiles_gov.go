@@ -0,0 +1,663 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/EscanBE/evermint/x/cpc/eip712"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
+	"github.com/EscanBE/evermint/x/evm/vm"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployGovCustomPrecompiledContract deploys a new gov custom precompiled contract.
+func (k Keeper) DeployGovCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcGovFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeGov,
+		Name:                  "Gov - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &govCustomPrecompiledContract{}
+
+// govCustomPrecompiledContract is a contract that can be used to interact with `x/gov` module,
+// to submit proposals, vote and deposit.
+type govCustomPrecompiledContract struct {
+	metadata        cpctypes.CustomPrecompiledContractMeta
+	keeper          Keeper
+	executors       []ExtendedCustomPrecompiledContractMethodExecutorI
+	sdkEventsBridge sdkEventsBridge
+}
+
+// NewGovCustomPrecompiledContract creates a new gov custom precompiled contract.
+func NewGovCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &govCustomPrecompiledContract{
+		metadata:        metadata,
+		keeper:          keeper,
+		sdkEventsBridge: newSdkEventsBridge(keeper, cpctypes.CpcGovFixedAddress, abi.GovCpcInfo, govSdkEventsMappings...),
+	}
+
+	voteWeightedME := govCustomPrecompiledContractRwVoteWeighted{contract: contract}
+	depositME := govCustomPrecompiledContractRwDeposit{contract: contract}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&govCustomPrecompiledContractRoName{contract: contract},
+		&govCustomPrecompiledContractRoProposal{contract: contract},
+		&govCustomPrecompiledContractRoTally{contract: contract},
+		&govCustomPrecompiledContractRwSubmitProposal{contract: contract},
+		&govCustomPrecompiledContractRwVote{voteWeighted: voteWeightedME},
+		&voteWeightedME,
+		&depositME,
+		&govCustomPrecompiledContractRwVoteOrDepositByActionMessage{
+			voteWeighted: voteWeightedME,
+			deposit:      depositME,
+		},
+	}
+
+	return contract
+}
+
+func (m govCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m govCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+func (m govCustomPrecompiledContract) emitsEventVote(voter common.Address, proposalId uint64, option uint8, weight *big.Int, env cpcExecutorEnv) error {
+	return addEvmLog(env.evm.StateDB, cpctypes.CpcGovFixedAddress, abi.GovCpcInfo, "Vote", voter, proposalId, option, weight)
+}
+
+// govSdkEventsMappings declares the SDK events to be bridged into SubmitProposal/Deposit logs.
+// The Vote logs are not bridged because the SDK event does not carry the weight of each option in a parseable form.
+var govSdkEventsMappings = []sdkEventToEvmLogMapping{
+	{
+		sdkEventType: govtypes.EventTypeSubmitProposal,
+		sdkEventAttributes: []string{
+			govtypes.AttributeKeyProposalID,
+			govtypes.AttributeKeyProposalProposer,
+			govtypes.AttributeKeyProposalMessages,
+		},
+		evmEvent: "SubmitProposal",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromAccAddress(govtypes.AttributeKeyProposalProposer),
+			sdkEventArgFromUint64(govtypes.AttributeKeyProposalID),
+		},
+	},
+	{
+		sdkEventType: govtypes.EventTypeProposalDeposit,
+		sdkEventAttributes: []string{
+			govtypes.AttributeKeyDepositor,
+			sdk.AttributeKeyAmount,
+			govtypes.AttributeKeyProposalID,
+		},
+		evmEvent: "Deposit",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromAccAddress(govtypes.AttributeKeyDepositor),
+			sdkEventArgFromUint64(govtypes.AttributeKeyProposalID),
+			sdkEventArgFromBondDenomAmount(sdk.AttributeKeyAmount),
+		},
+	},
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRoName{}
+
+type govCustomPrecompiledContractRoName struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.GovCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e govCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e govCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e govCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// proposal(uint64)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRoProposal{}
+
+type govCustomPrecompiledContractRoProposal struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRoProposal) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("proposal", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	proposalId := ips[0].(uint64)
+
+	resProposal, err := govkeeper.NewQueryServer(e.contract.keeper.govKeeper).Proposal(ctx, &govv1.QueryProposalRequest{
+		ProposalId: proposalId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	proposal := resProposal.Proposal
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to parse proposer address: %s", proposal.Proposer)
+	}
+
+	unixOrZero := func(t *time.Time) uint64 {
+		if t == nil || t.Unix() < 0 {
+			return 0
+		}
+		return uint64(t.Unix())
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("proposal", abi.GovProposal{
+		Id:              proposal.Id,
+		Status:          uint8(proposal.Status),
+		Proposer:        common.BytesToAddress(proposer),
+		Title:           proposal.Title,
+		Summary:         proposal.Summary,
+		Metadata:        proposal.Metadata,
+		Expedited:       proposal.Expedited,
+		SubmitTime:      unixOrZero(proposal.SubmitTime),
+		DepositEndTime:  unixOrZero(proposal.DepositEndTime),
+		VotingStartTime: unixOrZero(proposal.VotingStartTime),
+		VotingEndTime:   unixOrZero(proposal.VotingEndTime),
+		TotalDeposit:    sdk.Coins(proposal.TotalDeposit).AmountOf(bondDenom).BigInt(),
+	})
+}
+
+func (e govCustomPrecompiledContractRoProposal) Method4BytesSignatures() []byte {
+	return []byte{0x7a, 0xfa, 0x0a, 0xa3}
+}
+
+func (e govCustomPrecompiledContractRoProposal) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e govCustomPrecompiledContractRoProposal) ReadOnly() bool {
+	return true
+}
+
+// tally(uint64)
+
+// govTallyGasPerVote is the gas charged for each vote of the proposal being tallied.
+const govTallyGasPerVote uint64 = 5_000
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRoTally{}
+
+type govCustomPrecompiledContractRoTally struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRoTally) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("tally", input)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalId := ips[0].(uint64)
+
+	// tally iterates over all the votes of the proposal, so gas is charged per vote,
+	// on top of the static gas of the method. Votes are counted before tally
+	// so the iteration stops as soon as the remaining gas of the tx is not enough.
+	cStateDB := env.evm.StateDB.(vm.CStateDB)
+	votesGasLimit := cStateDB.GetCpcDynamicGasRemaining()
+	var votesGas uint64
+	err = e.contract.keeper.govKeeper.Votes.Walk(
+		env.ctx,
+		collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalId),
+		func(_ collections.Pair[uint64, sdk.AccAddress], _ govv1.Vote) (stop bool, err error) {
+			votesGas += govTallyGasPerVote
+			return votesGas > votesGasLimit, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if votesGas > votesGasLimit {
+		return nil, corevm.ErrOutOfGas
+	}
+	cStateDB.AddCpcDynamicGas(votesGas)
+
+	// tally of the proposals in voting period removes the votes from store,
+	// so it must be computed on a branched context which will be discarded.
+	cacheCtx, _ := env.ctx.CacheContext()
+
+	resTally, err := govkeeper.NewQueryServer(e.contract.keeper.govKeeper).TallyResult(cacheCtx, &govv1.QueryTallyResultRequest{
+		ProposalId: proposalId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []any
+	for _, count := range []string{
+		resTally.Tally.YesCount,
+		resTally.Tally.AbstainCount,
+		resTally.Tally.NoCount,
+		resTally.Tally.NoWithVetoCount,
+	} {
+		amount, ok := sdkmath.NewIntFromString(count)
+		if !ok {
+			return nil, fmt.Errorf("invalid tally count: %s", count)
+		}
+		counts = append(counts, amount.BigInt())
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("tally", counts...)
+}
+
+func (e govCustomPrecompiledContractRoTally) Method4BytesSignatures() []byte {
+	return []byte{0x0c, 0x8e, 0xc7, 0x17}
+}
+
+func (e govCustomPrecompiledContractRoTally) RequireGas() uint64 {
+	return 50_000
+}
+
+func (e govCustomPrecompiledContractRoTally) ReadOnly() bool {
+	return true
+}
+
+// submitProposal(string,string,string,string,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwSubmitProposal{}
+
+type govCustomPrecompiledContractRwSubmitProposal struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRwSubmitProposal) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("submitProposal", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	keeper := e.contract.keeper
+
+	messagesJson := ips[0].(string)
+	metadata := ips[1].(string)
+	title := ips[2].(string)
+	summary := ips[3].(string)
+	initialDeposit := ips[4].(*big.Int)
+
+	var rawMessages []json.RawMessage
+	if err := json.Unmarshal([]byte(messagesJson), &rawMessages); err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "messages must be a JSON array: %s", err.Error())
+	}
+
+	msgs := make([]sdk.Msg, len(rawMessages))
+	for i, rawMessage := range rawMessages {
+		var msg sdk.Msg
+		if err := keeper.cdc.UnmarshalInterfaceJSON(rawMessage, &msg); err != nil {
+			return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "failed to parse message at index %d: %s", i, err.Error())
+		}
+		msgs[i] = msg
+	}
+
+	var deposit sdk.Coins
+	if initialDeposit.Sign() > 0 {
+		bondDenom, err := keeper.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return nil, err
+		}
+		deposit = sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(initialDeposit)))
+	}
+
+	proposer := caller.Address()
+
+	msgSubmitProposal, err := govv1.NewMsgSubmitProposal(
+		msgs,
+		deposit,
+		sdk.AccAddress(proposer.Bytes()).String(),
+		metadata, title, summary,
+		false, // expedited
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	originalGovEventsCount := e.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
+
+	res, err := govkeeper.NewMsgServerImpl(keeper.govKeeper).SubmitProposal(ctx, msgSubmitProposal)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.sdkEventsBridge.emitEvmLogs(originalGovEventsCount, proposer, env); err != nil {
+		return nil, err
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("submitProposal", res.ProposalId)
+}
+
+func (e govCustomPrecompiledContractRwSubmitProposal) Method4BytesSignatures() []byte {
+	return []byte{0x62, 0xaa, 0x5a, 0x68}
+}
+
+func (e govCustomPrecompiledContractRwSubmitProposal) RequireGas() uint64 {
+	return 300_000
+}
+
+func (e govCustomPrecompiledContractRwSubmitProposal) ReadOnly() bool {
+	return false
+}
+
+// vote(uint64,uint8)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwVote{}
+
+type govCustomPrecompiledContractRwVote struct {
+	voteWeighted govCustomPrecompiledContractRwVoteWeighted
+}
+
+func (e govCustomPrecompiledContractRwVote) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("vote", input)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalId := ips[0].(uint64)
+	option := ips[1].(uint8)
+
+	if err := e.voteWeighted.vote(
+		caller.Address(), proposalId,
+		govv1.NewNonSplitVoteOption(govv1.VoteOption(option)),
+		env,
+	); err != nil {
+		return nil, err
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("vote", true)
+}
+
+func (e govCustomPrecompiledContractRwVote) Method4BytesSignatures() []byte {
+	return []byte{0xb0, 0x40, 0xd1, 0x66}
+}
+
+func (e govCustomPrecompiledContractRwVote) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e govCustomPrecompiledContractRwVote) ReadOnly() bool {
+	return false
+}
+
+// voteWeighted(uint64,(uint8,uint256)[])
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwVoteWeighted{}
+
+type govCustomPrecompiledContractRwVoteWeighted struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("voteWeighted", input)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalId := ips[0].(uint64)
+
+	var weightedOptions []abi.GovWeightedVoteOption
+	if bz, err := json.Marshal(ips[1]); err != nil {
+		return nil, err
+	} else if err := json.Unmarshal(bz, &weightedOptions); err != nil {
+		return nil, fmt.Errorf("failed to parse vote options: %s", err.Error())
+	}
+
+	options := make(govv1.WeightedVoteOptions, len(weightedOptions))
+	for i, weightedOption := range weightedOptions {
+		options[i] = govv1.NewWeightedVoteOption(
+			govv1.VoteOption(weightedOption.Option),
+			sdkmath.LegacyNewDecFromBigIntWithPrec(weightedOption.Weight, sdkmath.LegacyPrecision),
+		)
+	}
+
+	if err := e.vote(caller.Address(), proposalId, options, env); err != nil {
+		return nil, err
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("voteWeighted", true)
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) vote(
+	voter common.Address, proposalId uint64,
+	options govv1.WeightedVoteOptions,
+	env cpcExecutorEnv,
+) error {
+	msgVoteWeighted := govv1.NewMsgVoteWeighted(
+		voter.Bytes(), // voter
+		proposalId,    // proposal
+		options,       // options
+		"",            // metadata
+	)
+	if _, err := govkeeper.NewMsgServerImpl(e.contract.keeper.govKeeper).VoteWeighted(env.ctx, msgVoteWeighted); err != nil {
+		return err
+	}
+
+	for _, option := range options {
+		weight, err := sdkmath.LegacyNewDecFromStr(option.Weight)
+		if err != nil {
+			panic(err) // should be validated
+		}
+		if err := e.contract.emitsEventVote(voter, proposalId, uint8(option.Option), weight.BigInt(), env); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) Method4BytesSignatures() []byte {
+	return []byte{0xb3, 0x55, 0xa9, 0x1e}
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) RequireGas() uint64 {
+	return 150_000
+}
+
+func (e govCustomPrecompiledContractRwVoteWeighted) ReadOnly() bool {
+	return false
+}
+
+// deposit(uint64,uint256)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwDeposit{}
+
+type govCustomPrecompiledContractRwDeposit struct {
+	contract *govCustomPrecompiledContract
+}
+
+func (e govCustomPrecompiledContractRwDeposit) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("deposit", input)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := e.contract.keeper.stakingKeeper.BondDenom(env.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalId := ips[0].(uint64)
+	amount := ips[1].(*big.Int)
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "deposit amount must be positive")
+	}
+
+	if err := e.deposit(
+		caller.Address(), proposalId,
+		sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(amount)),
+		env,
+	); err != nil {
+		return nil, err
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("deposit", true)
+}
+
+func (e govCustomPrecompiledContractRwDeposit) deposit(
+	depositor common.Address, proposalId uint64,
+	amount sdk.Coin,
+	env cpcExecutorEnv,
+) error {
+	msgDeposit := govv1.NewMsgDeposit(
+		depositor.Bytes(),    // depositor
+		proposalId,           // proposal
+		sdk.NewCoins(amount), // deposit amount
+	)
+	originalGovEventsCount := e.contract.sdkEventsBridge.countTrackedEvents(env.ctx.EventManager())
+
+	if _, err := govkeeper.NewMsgServerImpl(e.contract.keeper.govKeeper).Deposit(env.ctx, msgDeposit); err != nil {
+		return err
+	}
+
+	return e.contract.sdkEventsBridge.emitEvmLogs(originalGovEventsCount, depositor, env)
+}
+
+func (e govCustomPrecompiledContractRwDeposit) Method4BytesSignatures() []byte {
+	return []byte{0x61, 0x70, 0xc4, 0xb1}
+}
+
+func (e govCustomPrecompiledContractRwDeposit) RequireGas() uint64 {
+	return 150_000
+}
+
+func (e govCustomPrecompiledContractRwDeposit) ReadOnly() bool {
+	return false
+}
+
+// voteOrDepositByActionMessage(GovMessage,bytes32,bytes32,uint8)
+// sig delivered from: voteOrDepositByActionMessage((string,address,uint64,string,uint256,string),bytes32,bytes32,uint8)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &govCustomPrecompiledContractRwVoteOrDepositByActionMessage{}
+
+type govCustomPrecompiledContractRwVoteOrDepositByActionMessage struct {
+	voteWeighted govCustomPrecompiledContractRwVoteWeighted
+	deposit      govCustomPrecompiledContractRwDeposit
+}
+
+func (e govCustomPrecompiledContractRwVoteOrDepositByActionMessage) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.GovCpcInfo.UnpackMethodInput("voteOrDepositByActionMessage", input)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := e.deposit.contract.keeper.stakingKeeper.BondDenom(env.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	govMessage := &abi.GovMessage{}
+	if err := govMessage.FromUnpackedStruct(ips[0]); err != nil {
+		return nil, fmt.Errorf("failed to parse gov message: %s", err.Error())
+	} else if err := govMessage.Validate(bondDenom); err != nil {
+		return nil, err
+	}
+	r := ips[1].([32]byte)
+	s := ips[2].([32]byte)
+	v := ips[3].(uint8)
+
+	if caller.Address() != govMessage.Account {
+		return nil, fmt.Errorf("not the caller: %s", govMessage.Account)
+	}
+
+	match, recoveredAddr, err := eip712.VerifySignature(govMessage.Account, govMessage, r, s, v, env.evm.ChainConfig().ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify signature: %s", err.Error())
+	}
+	if !match {
+		return nil, fmt.Errorf("signature does not match, got: %s", recoveredAddr.String())
+	}
+
+	switch govMessage.Action {
+	case abi.GovMessageActionVote:
+		options, err := govMessage.WeightedVoteOptions()
+		if err != nil {
+			panic(err) // should be validated
+		}
+		if err := e.voteWeighted.vote(govMessage.Account, govMessage.ProposalId, options, env); err != nil {
+			return nil, err
+		}
+	case abi.GovMessageActionDeposit:
+		if err := e.deposit.deposit(
+			govMessage.Account, govMessage.ProposalId,
+			sdk.NewCoin(bondDenom, sdkmath.NewIntFromBigInt(govMessage.Amount)),
+			env,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return abi.GovCpcInfo.PackMethodOutput("voteOrDepositByActionMessage", true)
+}
+
+func (e govCustomPrecompiledContractRwVoteOrDepositByActionMessage) Method4BytesSignatures() []byte {
+	return []byte{0xf4, 0x13, 0x99, 0x46}
+}
+
+func (e govCustomPrecompiledContractRwVoteOrDepositByActionMessage) RequireGas() uint64 {
+	return 150_000 + cpctypes.GasVerifyEIP712
+}
+
+func (e govCustomPrecompiledContractRwVoteOrDepositByActionMessage) ReadOnly() bool {
+	return false
+}
@@ -0,0 +1,404 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	itutiltypes "github.com/EscanBE/evermint/integration_test_util/types"
+	"github.com/EscanBE/evermint/x/cpc/abi"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	cpcutils "github.com/EscanBE/evermint/x/cpc/utils"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	topic0SubmitProposal = "0xf49a3a8232aff8553333cfd734e3a7ef1ab4764cd0494eb145216773b64bf349"
+	topic0Vote           = "0x100a07a0172b248dc40ae6a6db63a5401e761f6e102caf81a1a2030de4e6cc9a"
+	topic0GovDeposit     = "0x685c54f1ed866ac5147f6f2eb395af5c2402e0c09df9227ef0b61e1b3f83083d"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployGovCustomPrecompiledContract() {
+	suite.Run("pass - deployed at genesis", func() {
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcGovFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeGov, meta.CustomPrecompiledType)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcGovFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.Require().True(found)
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Topic0_Gov() {
+	suite.Equal(common.HexToHash(topic0SubmitProposal), abi.GovCpcInfo.ABI.Events["SubmitProposal"].ID)
+	suite.Equal(common.HexToHash(topic0Vote), abi.GovCpcInfo.ABI.Events["Vote"].ID)
+	suite.Equal(common.HexToHash(topic0GovDeposit), abi.GovCpcInfo.ABI.Events["Deposit"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_GovCustomPrecompiledContract() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+	validator1 := suite.CITS.ValidatorAccounts.Number(1)
+
+	oneWeight := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	buildInput := func(methodName string, args ...any) []byte {
+		method := abi.GovCpcInfo.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		suite.Require().NoError(err)
+		return append(append([]byte{}, method.ID...), input...)
+	}
+
+	requireLogs := func(res *evmtypes.MsgEthereumTxResponse) []*ethtypes.Log {
+		receipt := &ethtypes.Receipt{}
+		err := receipt.UnmarshalBinary(res.MarshalledReceipt)
+		suite.Require().NoError(err)
+		for _, log := range receipt.Logs {
+			suite.Equal(cpctypes.CpcGovFixedAddress, log.Address)
+		}
+		return receipt.Logs
+	}
+
+	requireVoteLog := func(log *ethtypes.Log, voter common.Address, proposalId uint64, option govv1.VoteOption, weight *big.Int) {
+		suite.Equal(topic0Vote, log.Topics[0].String())
+		suite.Len(log.Topics, 3)
+		suite.Equal(voter, common.BytesToAddress(log.Topics[1].Bytes()))
+		suite.Equal(proposalId, log.Topics[2].Big().Uint64())
+		suite.Require().Len(log.Data, 64)
+		suite.Equal(uint64(option), new(big.Int).SetBytes(log.Data[:32]).Uint64())
+		suite.Equal(weight.String(), new(big.Int).SetBytes(log.Data[32:]).String())
+	}
+
+	requireDepositLog := func(log *ethtypes.Log, depositor common.Address, proposalId uint64, amount *big.Int) {
+		suite.Equal(topic0GovDeposit, log.Topics[0].String())
+		suite.Len(log.Topics, 3)
+		suite.Equal(depositor, common.BytesToAddress(log.Topics[1].Bytes()))
+		suite.Equal(proposalId, log.Topics[2].Big().Uint64())
+		suite.Equal(amount.String(), new(big.Int).SetBytes(log.Data).String())
+	}
+
+	requireVote := func(proposalId uint64, voter common.Address, wantOptions govv1.WeightedVoteOptions) {
+		vote, err := suite.App().GovKeeper().Votes.Get(suite.Ctx(), collections.Join(proposalId, sdk.AccAddress(voter.Bytes())))
+		suite.Require().NoError(err)
+		suite.Require().Len(vote.Options, len(wantOptions))
+		for i, option := range vote.Options {
+			suite.Equal(wantOptions[i].Option, option.Option)
+			suite.Equal(sdkmath.LegacyMustNewDecFromStr(wantOptions[i].Weight).String(), sdkmath.LegacyMustNewDecFromStr(option.Weight).String())
+		}
+	}
+
+	bondDenom := suite.bondDenom(suite.Ctx())
+	govParams, err := suite.App().GovKeeper().Params.Get(suite.Ctx())
+	suite.Require().NoError(err)
+	minDeposit := sdk.Coins(govParams.MinDeposit).AmountOf(bondDenom)
+	suite.Require().True(minDeposit.IsPositive())
+
+	var proposalId uint64
+
+	suite.Run("name()", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcGovFixedAddress, buildInput("name"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotName, err := cpcutils.AbiDecodeString(res.Ret)
+		suite.Require().NoError(err)
+		suite.Equal("Gov - Precompiled Contract", gotName)
+	})
+
+	suite.Run("submitProposal(string,string,string,string,uint256)", func() {
+		suite.Run("fail - messages must be JSON array", func() {
+			input := buildInput("submitProposal", "{}", "", "title", "summary", minDeposit.BigInt())
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, input)
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "messages must be a JSON array")
+		})
+
+		suite.Run("fail - message signer must be gov module", func() {
+			messages := `[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"` + account1.GetCosmosAddress().String() + `","to_address":"` + account2.GetCosmosAddress().String() + `","amount":[{"denom":"` + bondDenom + `","amount":"1"}]}]`
+			input := buildInput("submitProposal", messages, "", "title", "summary", minDeposit.BigInt())
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, input)
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "expected gov account as only signer for proposal message")
+		})
+
+		suite.Run("pass - submit proposal with initial deposit", func() {
+			govModuleAddr := suite.App().AccountKeeper().GetModuleAddress("gov")
+			messages := `[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"` + govModuleAddr.String() + `","to_address":"` + account2.GetCosmosAddress().String() + `","amount":[{"denom":"` + bondDenom + `","amount":"1"}]}]`
+			input := buildInput("submitProposal", messages, "metadata", "title", "summary", minDeposit.BigInt())
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, input)
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			rets, err := abi.GovCpcInfo.ABI.Methods["submitProposal"].Outputs.Unpack(res.Ret)
+			suite.Require().NoError(err)
+			proposalId = rets[0].(uint64)
+			suite.Require().NotZero(proposalId)
+
+			logs := requireLogs(res)
+			suite.Require().Len(logs, 2)
+			suite.Equal(topic0SubmitProposal, logs[0].Topics[0].String())
+			suite.Equal(account1.GetEthAddress(), common.BytesToAddress(logs[0].Topics[1].Bytes()))
+			suite.Equal(proposalId, logs[0].Topics[2].Big().Uint64())
+			requireDepositLog(logs[1], account1.GetEthAddress(), proposalId, minDeposit.BigInt())
+
+			proposal, err := suite.App().GovKeeper().Proposals.Get(suite.Ctx(), proposalId)
+			suite.Require().NoError(err)
+			suite.Equal(account1.GetCosmosAddress().String(), proposal.Proposer)
+			suite.Equal(govv1.StatusVotingPeriod, proposal.Status)
+			suite.Len(proposal.Messages, 1)
+		})
+	})
+
+	suite.Require().NotZero(proposalId, "proposal must be submitted")
+
+	suite.Run("proposal(uint64)", func() {
+		suite.Run("pass - existing proposal", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcGovFixedAddress, buildInput("proposal", proposalId))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			rets, err := abi.GovCpcInfo.ABI.Methods["proposal"].Outputs.Unpack(res.Ret)
+			suite.Require().NoError(err)
+
+			var gotProposal abi.GovProposal
+			bz, err := json.Marshal(rets[0])
+			suite.Require().NoError(err)
+			suite.Require().NoError(json.Unmarshal(bz, &gotProposal))
+			suite.Equal(proposalId, gotProposal.Id)
+			suite.Equal(uint8(govv1.StatusVotingPeriod), gotProposal.Status)
+			suite.Equal(account1.GetEthAddress(), gotProposal.Proposer)
+			suite.Equal("title", gotProposal.Title)
+			suite.Equal("summary", gotProposal.Summary)
+			suite.Equal("metadata", gotProposal.Metadata)
+			suite.False(gotProposal.Expedited)
+			suite.NotZero(gotProposal.SubmitTime)
+			suite.NotZero(gotProposal.VotingStartTime)
+			suite.Greater(gotProposal.VotingEndTime, gotProposal.VotingStartTime)
+			suite.Equal(minDeposit.String(), gotProposal.TotalDeposit.String())
+		})
+
+		suite.Run("fail - non-existing proposal", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcGovFixedAddress, buildInput("proposal", proposalId+100))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "doesn't exist")
+		})
+	})
+
+	suite.Run("vote(uint64,uint8)", func() {
+		suite.Run("fail - invalid option", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildInput("vote", proposalId, uint8(5)))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "invalid vote option")
+		})
+
+		suite.Run("pass - vote", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildInput("vote", proposalId, uint8(govv1.OptionYes)))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+			suite.Require().NoError(err)
+			suite.True(gotSuccess)
+
+			logs := requireLogs(res)
+			suite.Require().Len(logs, 1)
+			requireVoteLog(logs[0], account1.GetEthAddress(), proposalId, govv1.OptionYes, oneWeight)
+
+			requireVote(proposalId, account1.GetEthAddress(), govv1.NewNonSplitVoteOption(govv1.OptionYes))
+		})
+	})
+
+	suite.Run("voteWeighted(uint64,(uint8,uint256)[])", func() {
+		weight60 := new(big.Int).Div(new(big.Int).Mul(oneWeight, big.NewInt(6)), big.NewInt(10))
+		weight40 := new(big.Int).Sub(oneWeight, weight60)
+
+		suite.Run("fail - total weight must be 1", func() {
+			options := []abi.GovWeightedVoteOption{
+				{Option: uint8(govv1.OptionYes), Weight: weight60},
+				{Option: uint8(govv1.OptionNo), Weight: weight60},
+			}
+			res, err := suite.EthCallApply(suite.Ctx(), account2.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildInput("voteWeighted", proposalId, options))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "total weight overflow")
+		})
+
+		suite.Run("pass - vote weighted", func() {
+			options := []abi.GovWeightedVoteOption{
+				{Option: uint8(govv1.OptionYes), Weight: weight60},
+				{Option: uint8(govv1.OptionNo), Weight: weight40},
+			}
+			res, err := suite.EthCallApply(suite.Ctx(), account2.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildInput("voteWeighted", proposalId, options))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			logs := requireLogs(res)
+			suite.Require().Len(logs, 2)
+			requireVoteLog(logs[0], account2.GetEthAddress(), proposalId, govv1.OptionYes, weight60)
+			requireVoteLog(logs[1], account2.GetEthAddress(), proposalId, govv1.OptionNo, weight40)
+
+			requireVote(proposalId, account2.GetEthAddress(), govv1.WeightedVoteOptions{
+				govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(6, 1)),
+				govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(4, 1)),
+			})
+		})
+	})
+
+	suite.Run("deposit(uint64,uint256)", func() {
+		suite.Run("fail - zero amount", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), account2.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildInput("deposit", proposalId, big.NewInt(0)))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "deposit amount must be positive")
+		})
+
+		suite.Run("pass - deposit", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), account2.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildInput("deposit", proposalId, big.NewInt(1000)))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			logs := requireLogs(res)
+			suite.Require().Len(logs, 1)
+			requireDepositLog(logs[0], account2.GetEthAddress(), proposalId, big.NewInt(1000))
+
+			proposal, err := suite.App().GovKeeper().Proposals.Get(suite.Ctx(), proposalId)
+			suite.Require().NoError(err)
+			suite.Equal(minDeposit.AddRaw(1000).String(), sdk.Coins(proposal.TotalDeposit).AmountOf(bondDenom).String())
+		})
+	})
+
+	suite.Run("voteOrDepositByActionMessage(GovMessage,bytes32,bytes32,uint8)", func() {
+		buildMessageInput := func(msg abi.GovMessage, signer *itutiltypes.TestAccount) []byte {
+			r, s, v := suite.hashEip712Message(msg, signer)
+			return buildInput("voteOrDepositByActionMessage", msg, r, s, v)
+		}
+
+		suite.Run("pass - vote using EIP-712", func() {
+			msg := abi.GovMessage{
+				Action:     abi.GovMessageActionVote,
+				Account:    account1.GetEthAddress(),
+				ProposalId: proposalId,
+				Options:    "no=0.5,abstain=0.5",
+				Amount:     big.NewInt(0),
+				Denom:      "-",
+			}
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildMessageInput(msg, account1))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			halfWeight := new(big.Int).Div(oneWeight, big.NewInt(2))
+			logs := requireLogs(res)
+			suite.Require().Len(logs, 2)
+			requireVoteLog(logs[0], account1.GetEthAddress(), proposalId, govv1.OptionNo, halfWeight)
+			requireVoteLog(logs[1], account1.GetEthAddress(), proposalId, govv1.OptionAbstain, halfWeight)
+
+			requireVote(proposalId, account1.GetEthAddress(), govv1.WeightedVoteOptions{
+				govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(5, 1)),
+				govv1.NewWeightedVoteOption(govv1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(5, 1)),
+			})
+		})
+
+		suite.Run("pass - deposit using EIP-712", func() {
+			msg := abi.GovMessage{
+				Action:     abi.GovMessageActionDeposit,
+				Account:    account1.GetEthAddress(),
+				ProposalId: proposalId,
+				Options:    "-",
+				Amount:     big.NewInt(500),
+				Denom:      bondDenom,
+			}
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildMessageInput(msg, account1))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			logs := requireLogs(res)
+			suite.Require().Len(logs, 1)
+			requireDepositLog(logs[0], account1.GetEthAddress(), proposalId, big.NewInt(500))
+		})
+
+		suite.Run("fail - not the caller", func() {
+			msg := abi.GovMessage{
+				Action:     abi.GovMessageActionVote,
+				Account:    account1.GetEthAddress(),
+				ProposalId: proposalId,
+				Options:    "yes",
+				Amount:     big.NewInt(0),
+				Denom:      "-",
+			}
+			res, err := suite.EthCallApply(suite.Ctx(), account2.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildMessageInput(msg, account1))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "not the caller")
+		})
+
+		suite.Run("fail - signature of another account", func() {
+			msg := abi.GovMessage{
+				Action:     abi.GovMessageActionVote,
+				Account:    account1.GetEthAddress(),
+				ProposalId: proposalId,
+				Options:    "yes",
+				Amount:     big.NewInt(0),
+				Denom:      "-",
+			}
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildMessageInput(msg, account2))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "signature does not match")
+		})
+
+		suite.Run("fail - options must be empty when deposit", func() {
+			msg := abi.GovMessage{
+				Action:     abi.GovMessageActionDeposit,
+				Account:    account1.GetEthAddress(),
+				ProposalId: proposalId,
+				Options:    "yes",
+				Amount:     big.NewInt(500),
+				Denom:      bondDenom,
+			}
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildMessageInput(msg, account1))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "options must be empty")
+		})
+	})
+
+	suite.Run("tally(uint64)", func() {
+		resBeforeVote, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcGovFixedAddress, buildInput("tally", proposalId))
+		suite.Require().NoError(err)
+		suite.Require().Empty(resBeforeVote.VmError)
+
+		// validator votes, so the tally is non-zero
+		res, err := suite.EthCallApply(suite.Ctx(), validator1.GetEthAddressP(), cpctypes.CpcGovFixedAddress, buildInput("vote", proposalId, uint8(govv1.OptionYes)))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		res, err = suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcGovFixedAddress, buildInput("tally", proposalId))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		rets, err := abi.GovCpcInfo.ABI.Methods["tally"].Outputs.Unpack(res.Ret)
+		suite.Require().NoError(err)
+		suite.Require().Len(rets, 4)
+		suite.Equal(1, rets[0].(*big.Int).Sign(), "yes must be positive")
+		suite.Equal(uint64(5_000), res.GasUsed-resBeforeVote.GasUsed, "gas must be charged per vote")
+
+		// tally must not remove the votes
+		requireVote(proposalId, validator1.GetEthAddress(), govv1.NewNonSplitVoteOption(govv1.OptionYes))
+		requireVote(proposalId, account2.GetEthAddress(), govv1.WeightedVoteOptions{
+			govv1.NewWeightedVoteOption(govv1.OptionYes, sdkmath.LegacyNewDecWithPrec(6, 1)),
+			govv1.NewWeightedVoteOption(govv1.OptionNo, sdkmath.LegacyNewDecWithPrec(4, 1)),
+		})
+	})
+}
@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
+
+	sdkmath "cosmossdk.io/math"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployIbcTransferCustomPrecompiledContract deploys a new IBC transfer custom precompiled contract.
+func (k Keeper) DeployIbcTransferCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcIbcTransferFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeIbcTransfer,
+		Name:                  "IBC Transfer - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// SetIbcTransferPacketSentByCpc marks the ICS-20 packet was sent by the IBC transfer custom precompiled contract,
+// so the refund log will be emitted when the tokens are refunded.
+// The records are not exported to genesis, so the refunds of the packets in-flight when exporting will not emit the log.
+func (k Keeper) SetIbcTransferPacketSentByCpc(ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(cpctypes.IbcTransferCustomPrecompiledContractPacketKey(sourcePort, sourceChannel, sequence), []byte{1})
+}
+
+// ConsumeIbcTransferPacketSentByCpc removes the record which marks the ICS-20 packet was sent by the IBC transfer
+// custom precompiled contract, returns true if the record existed.
+// Used when the packet is acknowledged or timed out, so the record is no longer needed.
+func (k Keeper) ConsumeIbcTransferPacketSentByCpc(ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := cpctypes.IbcTransferCustomPrecompiledContractPacketKey(sourcePort, sourceChannel, sequence)
+	if !store.Has(key) {
+		return false
+	}
+
+	store.Delete(key)
+	return true
+}
+
+// GetIbcTransferRefundLog returns the `IbcTransferRefund` log of the IBC transfer custom precompiled contract,
+// for the tokens of the given ICS-20 packet which were refunded to the sender because of failure acknowledgement or timeout.
+// Returns nil if the contract is not deployed or disabled.
+func (k Keeper) GetIbcTransferRefundLog(ctx sdk.Context, packet channeltypes.Packet, data ibctransfertypes.FungibleTokenPacketData) *ethtypes.Log {
+	contractMeta := k.GetCustomPrecompiledContractMeta(ctx, cpctypes.CpcIbcTransferFixedAddress)
+	if contractMeta == nil || contractMeta.Disabled {
+		return nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		// the refund would fail before reaching here
+		return nil
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		// the refund would fail before reaching here
+		return nil
+	}
+
+	// same as the refunded denom
+	denom := ibctransfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+
+	log, err := newEvmLog(
+		cpctypes.CpcIbcTransferFixedAddress, abi.IbcTransferCpcInfo, "IbcTransferRefund",
+		common.BytesToAddress(sender), packet.Sequence, packet.SourcePort, packet.SourceChannel, denom, amount.BigInt(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return log
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &ibcTransferCustomPrecompiledContract{}
+
+// ibcTransferCustomPrecompiledContract is a contract that can be used to interact with the ICS-20 `x/ibc-transfer` module,
+// to transfer tokens to the counterparty chains.
+type ibcTransferCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewIbcTransferCustomPrecompiledContract creates a new IBC transfer custom precompiled contract.
+func NewIbcTransferCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &ibcTransferCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&ibcTransferCustomPrecompiledContractRoName{contract: contract},
+		&ibcTransferCustomPrecompiledContractRoDenomTrace{contract: contract},
+		&ibcTransferCustomPrecompiledContractRoEscrowAddress{contract: contract},
+		&ibcTransferCustomPrecompiledContractRwTransfer{contract: contract},
+	}
+
+	return contract
+}
+
+func (m ibcTransferCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m ibcTransferCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+func (m ibcTransferCustomPrecompiledContract) emitsEventIbcTransfer(sender common.Address, sequence uint64, msg *ibctransfertypes.MsgTransfer, env cpcExecutorEnv) error {
+	return addEvmLog(
+		env.evm.StateDB, cpctypes.CpcIbcTransferFixedAddress, abi.IbcTransferCpcInfo, "IbcTransfer",
+		sender, sequence, msg.SourcePort, msg.SourceChannel, msg.Token.Denom, msg.Token.Amount.BigInt(), msg.Receiver, msg.Memo,
+	)
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &ibcTransferCustomPrecompiledContractRoName{}
+
+type ibcTransferCustomPrecompiledContractRoName struct {
+	contract *ibcTransferCustomPrecompiledContract
+}
+
+func (e ibcTransferCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.IbcTransferCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.IbcTransferCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e ibcTransferCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e ibcTransferCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e ibcTransferCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// denomTrace(string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &ibcTransferCustomPrecompiledContractRoDenomTrace{}
+
+type ibcTransferCustomPrecompiledContractRoDenomTrace struct {
+	contract *ibcTransferCustomPrecompiledContract
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomTrace) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.IbcTransferCpcInfo.UnpackMethodInput("denomTrace", input)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := ips[0].(string)
+
+	// accept both `ibc/{hash}` and `{hash}`
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "invalid denom trace hash: %s", denom)
+	}
+
+	denomTrace, found := e.contract.keeper.transferKeeper.GetDenomTrace(env.ctx, hash)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "denom trace not found: %s", denom)
+	}
+
+	return abi.IbcTransferCpcInfo.PackMethodOutput("denomTrace", denomTrace.Path, denomTrace.BaseDenom)
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomTrace) Method4BytesSignatures() []byte {
+	return []byte{0xa8, 0x15, 0xcd, 0xd9}
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomTrace) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e ibcTransferCustomPrecompiledContractRoDenomTrace) ReadOnly() bool {
+	return true
+}
+
+// escrowAddress(string,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &ibcTransferCustomPrecompiledContractRoEscrowAddress{}
+
+type ibcTransferCustomPrecompiledContractRoEscrowAddress struct {
+	contract *ibcTransferCustomPrecompiledContract
+}
+
+func (e ibcTransferCustomPrecompiledContractRoEscrowAddress) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.IbcTransferCpcInfo.UnpackMethodInput("escrowAddress", input)
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePort := ips[0].(string)
+	sourceChannel := ips[1].(string)
+
+	if err := host.PortIdentifierValidator(sourcePort); err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "invalid source port: %s", err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(sourceChannel); err != nil {
+		return nil, errorsmod.Wrapf(cpctypes.ErrInvalidCpcInput, "invalid source channel: %s", err.Error())
+	}
+
+	escrowAddress := ibctransfertypes.GetEscrowAddress(sourcePort, sourceChannel)
+
+	return abi.IbcTransferCpcInfo.PackMethodOutput("escrowAddress", common.BytesToAddress(escrowAddress))
+}
+
+func (e ibcTransferCustomPrecompiledContractRoEscrowAddress) Method4BytesSignatures() []byte {
+	return []byte{0x74, 0x07, 0x76, 0xce}
+}
+
+func (e ibcTransferCustomPrecompiledContractRoEscrowAddress) RequireGas() uint64 {
+	return 5_000
+}
+
+func (e ibcTransferCustomPrecompiledContractRoEscrowAddress) ReadOnly() bool {
+	return true
+}
+
+// transfer(string,string,string,uint256,string,(uint64,uint64),uint64,string)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &ibcTransferCustomPrecompiledContractRwTransfer{}
+
+type ibcTransferCustomPrecompiledContractRwTransfer struct {
+	contract *ibcTransferCustomPrecompiledContract
+}
+
+func (e ibcTransferCustomPrecompiledContractRwTransfer) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.IbcTransferCpcInfo.UnpackMethodInput("transfer", input)
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePort := ips[0].(string)
+	sourceChannel := ips[1].(string)
+	denom := ips[2].(string)
+	amount := ips[3].(*big.Int)
+	receiver := ips[4].(string)
+
+	var timeoutHeight abi.IbcTransferHeight
+	if bz, err := json.Marshal(ips[5]); err != nil {
+		return nil, err
+	} else if err := json.Unmarshal(bz, &timeoutHeight); err != nil {
+		return nil, fmt.Errorf("failed to parse timeout height: %s", err.Error())
+	}
+
+	timeoutTimestamp := ips[6].(uint64)
+	memo := ips[7].(string)
+
+	if amount.Sign() < 1 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "transfer amount must be positive")
+	}
+
+	sender := caller.Address()
+
+	msgTransfer := ibctransfertypes.NewMsgTransfer(
+		sourcePort, sourceChannel,
+		sdk.Coin{
+			Denom:  denom,
+			Amount: sdkmath.NewIntFromBigInt(amount),
+		},
+		sdk.AccAddress(sender.Bytes()).String(), receiver,
+		clienttypes.NewHeight(timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight), timeoutTimestamp,
+		memo,
+	)
+	if err := msgTransfer.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := e.contract.keeper.transferKeeper.Transfer(env.ctx, msgTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	e.contract.keeper.SetIbcTransferPacketSentByCpc(env.ctx, sourcePort, sourceChannel, res.Sequence)
+
+	if err := e.contract.emitsEventIbcTransfer(sender, res.Sequence, msgTransfer, env); err != nil {
+		return nil, err
+	}
+
+	return abi.IbcTransferCpcInfo.PackMethodOutput("transfer", res.Sequence)
+}
+
+func (e ibcTransferCustomPrecompiledContractRwTransfer) Method4BytesSignatures() []byte {
+	return []byte{0x39, 0x66, 0x9b, 0xdb}
+}
+
+func (e ibcTransferCustomPrecompiledContractRwTransfer) RequireGas() uint64 {
+	return 200_000
+}
+
+func (e ibcTransferCustomPrecompiledContractRwTransfer) ReadOnly() bool {
+	return false
+}
@@ -0,0 +1,328 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/evermint/x/cpc"
+	"github.com/EscanBE/evermint/x/cpc/abi"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	cpcutils "github.com/EscanBE/evermint/x/cpc/utils"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	topic0IbcTransfer       = "0x93bd6baf7906ad0e5971bc99f0902d5fd53674f6199379ffb537571105599dbc"
+	topic0IbcTransferRefund = "0x39a744aa524cd23b10b15b6f838e465c049277cfd9bef07cfd2fbbd4a8bfe108"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployIbcTransferCustomPrecompiledContract() {
+	suite.Run("pass - not deployed at genesis", func() {
+		suite.False(suite.App().CpcKeeper().HasCustomPrecompiledContract(suite.Ctx(), cpctypes.CpcIbcTransferFixedAddress))
+	})
+
+	suite.Run("pass - can deploy", func() {
+		addr, err := suite.App().CpcKeeper().DeployIbcTransferCustomPrecompiledContract(suite.Ctx())
+		suite.Require().NoError(err)
+		suite.Equal(cpctypes.CpcIbcTransferFixedAddress, addr)
+
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcIbcTransferFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeIbcTransfer, meta.CustomPrecompiledType)
+		suite.Equal(cpctypes.EmptyTypedMeta, meta.TypedMeta)
+		suite.False(meta.Disabled)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcIbcTransferFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.True(found)
+	})
+
+	suite.Run("fail - can not deploy twice", func() {
+		_, err := suite.App().CpcKeeper().DeployIbcTransferCustomPrecompiledContract(suite.Ctx())
+		suite.Require().ErrorContains(err, "contract address is being in use")
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Topic0_IbcTransfer() {
+	suite.Equal(common.HexToHash(topic0IbcTransfer), abi.IbcTransferCpcInfo.ABI.Events["IbcTransfer"].ID)
+	suite.Equal(common.HexToHash(topic0IbcTransferRefund), abi.IbcTransferCpcInfo.ABI.Events["IbcTransferRefund"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_IbcTransferCustomPrecompiledContract() {
+	_, err := suite.App().CpcKeeper().DeployIbcTransferCustomPrecompiledContract(suite.Ctx())
+	suite.Require().NoError(err)
+
+	account1 := suite.CITS.WalletAccounts.Number(1)
+
+	buildInput := func(methodName string, args ...any) []byte {
+		method := abi.IbcTransferCpcInfo.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		suite.Require().NoError(err)
+		return append(append([]byte{}, method.ID...), input...)
+	}
+
+	suite.Run("name()", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcIbcTransferFixedAddress, buildInput("name"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotName, err := cpcutils.AbiDecodeString(res.Ret)
+		suite.Require().NoError(err)
+		suite.Equal("IBC Transfer - Precompiled Contract", gotName)
+	})
+
+	suite.Run("escrowAddress(string,string)", func() {
+		suite.Run("pass", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcIbcTransferFixedAddress, buildInput("escrowAddress", "transfer", "channel-0"))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			wantAddress := ibctransfertypes.GetEscrowAddress("transfer", "channel-0")
+			suite.Equal(common.BytesToAddress(wantAddress), common.BytesToAddress(res.Ret))
+		})
+
+		suite.Run("fail - invalid channel", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcIbcTransferFixedAddress, buildInput("escrowAddress", "transfer", "channel/0"))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "invalid source channel")
+		})
+	})
+
+	suite.Run("denomTrace(string)", func() {
+		denomTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+		suite.App().IbcTransferKeeper().SetDenomTrace(suite.Ctx(), denomTrace)
+
+		for _, denom := range []string{denomTrace.IBCDenom(), denomTrace.Hash().String()} {
+			suite.Run("pass - "+denom, func() {
+				res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcIbcTransferFixedAddress, buildInput("denomTrace", denom))
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.VmError)
+
+				rets, err := abi.IbcTransferCpcInfo.ABI.Methods["denomTrace"].Outputs.Unpack(res.Ret)
+				suite.Require().NoError(err)
+				suite.Equal("transfer/channel-0", rets[0].(string))
+				suite.Equal("uatom", rets[1].(string))
+			})
+		}
+
+		suite.Run("fail - not found", func() {
+			notFound := ibctransfertypes.ParseDenomTrace("transfer/channel-9/uatom")
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcIbcTransferFixedAddress, buildInput("denomTrace", notFound.IBCDenom()))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "denom trace not found")
+		})
+
+		suite.Run("fail - invalid hash", func() {
+			res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcIbcTransferFixedAddress, buildInput("denomTrace", "ibc/invalid"))
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "invalid denom trace hash")
+		})
+	})
+
+	suite.Run("transfer(string,string,string,uint256,string,(uint64,uint64),uint64,string)", func() {
+		bondDenom := suite.bondDenom(suite.Ctx())
+		timeoutHeight := abi.IbcTransferHeight{RevisionNumber: 1, RevisionHeight: 1000}
+
+		suite.Run("fail - amount must be positive", func() {
+			input := buildInput("transfer", "transfer", "channel-0", bondDenom, big.NewInt(0), "receiver", timeoutHeight, uint64(0), "")
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcIbcTransferFixedAddress, input)
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "transfer amount must be positive")
+		})
+
+		suite.Run("fail - receiver is required", func() {
+			input := buildInput("transfer", "transfer", "channel-0", bondDenom, big.NewInt(1), "", timeoutHeight, uint64(0), "")
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcIbcTransferFixedAddress, input)
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "missing recipient address")
+		})
+
+		suite.Run("fail - channel does not exist, balance is not changed", func() {
+			balanceBefore := suite.App().BankKeeper().GetBalance(suite.Ctx(), account1.GetCosmosAddress(), bondDenom)
+
+			input := buildInput("transfer", "transfer", "channel-0", bondDenom, big.NewInt(1), "receiver", timeoutHeight, uint64(0), "")
+			res, err := suite.EthCallApply(suite.Ctx(), account1.GetEthAddressP(), cpctypes.CpcIbcTransferFixedAddress, input)
+			suite.Require().NoError(err)
+			suite.Contains(res.VmError, "channel not found")
+
+			balanceAfter := suite.App().BankKeeper().GetBalance(suite.Ctx(), account1.GetCosmosAddress(), bondDenom)
+			suite.Equal(balanceBefore.String(), balanceAfter.String())
+		})
+	})
+}
+
+func (suite *CpcTestSuite) TestIBCTransferMiddleware() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	bondDenom := suite.bondDenom(suite.Ctx())
+	amount := sdkmath.NewInt(1000)
+
+	const (
+		sourcePort    = "transfer"
+		sourceChannel = "channel-0"
+	)
+
+	middleware := cpc.NewIBCTransferMiddleware(
+		ibctransfer.NewIBCModule(*suite.App().IbcTransferKeeper()),
+		*suite.App().CpcKeeper(),
+		suite.App().EvmKeeper(),
+	)
+
+	// prepareRefund escrows the tokens like a packet sent by the contract, then returns the packet
+	prepareRefund := func(ctx sdk.Context, sequence uint64) channeltypes.Packet {
+		suite.App().CpcKeeper().SetIbcTransferPacketSentByCpc(ctx, sourcePort, sourceChannel, sequence)
+
+		escrowAddress := ibctransfertypes.GetEscrowAddress(sourcePort, sourceChannel)
+		err := suite.App().BankKeeper().SendCoins(ctx, account1.GetCosmosAddress(), escrowAddress, sdk.NewCoins(sdk.NewCoin(bondDenom, amount)))
+		suite.Require().NoError(err)
+		suite.App().IbcTransferKeeper().SetTotalEscrowForDenom(ctx, sdk.NewCoin(bondDenom, amount))
+
+		data := ibctransfertypes.NewFungibleTokenPacketData(bondDenom, amount.String(), account1.GetCosmosAddress().String(), "receiver", "")
+		return channeltypes.NewPacket(data.GetBytes(), sequence, sourcePort, sourceChannel, "transfer", "channel-1", clienttypes.NewHeight(1, 1000), 0)
+	}
+
+	// getEmittedLogs emits the pending logs of the tx and returns them
+	getEmittedLogs := func(ctx sdk.Context) []*ethtypes.Log {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		err := suite.App().EvmKeeper().EmitCosmosTxLogs(ctx)
+		suite.Require().NoError(err)
+
+		var logs []*ethtypes.Log
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != evmtypes.EventTypeCosmosTxLogs {
+				continue
+			}
+			attr, found := event.GetAttribute(evmtypes.AttributeKeyReceiptMarshalled)
+			suite.Require().True(found)
+			bz, err := hexutil.Decode(attr.Value)
+			suite.Require().NoError(err)
+			var eventLogs []*ethtypes.Log
+			suite.Require().NoError(rlp.DecodeBytes(bz, &eventLogs))
+			logs = append(logs, eventLogs...)
+		}
+		return logs
+	}
+
+	requireRefundLog := func(log *ethtypes.Log, sequence uint64) {
+		suite.Equal(cpctypes.CpcIbcTransferFixedAddress, log.Address)
+		suite.Require().Len(log.Topics, 3)
+		suite.Equal(topic0IbcTransferRefund, log.Topics[0].String())
+		suite.Equal(account1.GetEthAddress(), common.BytesToAddress(log.Topics[1].Bytes()))
+		suite.Equal(sequence, log.Topics[2].Big().Uint64())
+
+		values, err := abi.IbcTransferCpcInfo.ABI.Events["IbcTransferRefund"].Inputs.NonIndexed().Unpack(log.Data)
+		suite.Require().NoError(err)
+		suite.Equal([]any{sourcePort, sourceChannel, bondDenom, amount.BigInt()}, values)
+	}
+
+	findRefundLogs := func(logs []*ethtypes.Log) []*ethtypes.Log {
+		var refundLogs []*ethtypes.Log
+		for _, log := range logs {
+			if log.Address == cpctypes.CpcIbcTransferFixedAddress {
+				refundLogs = append(refundLogs, log)
+			}
+		}
+		return refundLogs
+	}
+
+	txCtx := func() sdk.Context {
+		ctx, _ := suite.Ctx().CacheContext()
+		return ctx.WithTxBytes([]byte("relayer tx"))
+	}
+
+	suite.Run("pass - no log when the contract is not deployed", func() {
+		ctx := txCtx()
+		packet := prepareRefund(ctx, 1)
+
+		err := middleware.OnTimeoutPacket(ctx, packet, nil)
+		suite.Require().NoError(err)
+
+		suite.Empty(findRefundLogs(getEmittedLogs(ctx)))
+	})
+
+	_, err := suite.App().CpcKeeper().DeployIbcTransferCustomPrecompiledContract(suite.Ctx())
+	suite.Require().NoError(err)
+
+	suite.Run("pass - timeout refund emits log", func() {
+		ctx := txCtx()
+		packet := prepareRefund(ctx, 2)
+		balanceBefore := suite.App().BankKeeper().GetBalance(ctx, account1.GetCosmosAddress(), bondDenom)
+
+		err := middleware.OnTimeoutPacket(ctx, packet, nil)
+		suite.Require().NoError(err)
+
+		balanceAfter := suite.App().BankKeeper().GetBalance(ctx, account1.GetCosmosAddress(), bondDenom)
+		suite.Equal(balanceBefore.Amount.Add(amount).String(), balanceAfter.Amount.String())
+
+		refundLogs := findRefundLogs(getEmittedLogs(ctx))
+		suite.Require().Len(refundLogs, 1)
+		requireRefundLog(refundLogs[0], 2)
+	})
+
+	suite.Run("pass - refund of transfer not sent by the contract does not emit log", func() {
+		ctx := txCtx()
+		packet := prepareRefund(ctx, 6)
+		suite.Require().True(suite.App().CpcKeeper().ConsumeIbcTransferPacketSentByCpc(ctx, sourcePort, sourceChannel, 6))
+
+		err := middleware.OnTimeoutPacket(ctx, packet, nil)
+		suite.Require().NoError(err)
+
+		suite.Empty(findRefundLogs(getEmittedLogs(ctx)))
+	})
+
+	suite.Run("pass - error acknowledgement refund emits log", func() {
+		ctx := txCtx()
+		packet := prepareRefund(ctx, 3)
+
+		ack := channeltypes.NewErrorAcknowledgement(ibctransfertypes.ErrInvalidAmount)
+		err := middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+		suite.Require().NoError(err)
+
+		refundLogs := findRefundLogs(getEmittedLogs(ctx))
+		suite.Require().Len(refundLogs, 1)
+		requireRefundLog(refundLogs[0], 3)
+	})
+
+	suite.Run("pass - success acknowledgement does not emit log", func() {
+		ctx := txCtx()
+		packet := prepareRefund(ctx, 4)
+
+		ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		err := middleware.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+		suite.Require().NoError(err)
+
+		suite.Empty(findRefundLogs(getEmittedLogs(ctx)))
+		suite.False(suite.App().CpcKeeper().ConsumeIbcTransferPacketSentByCpc(ctx, sourcePort, sourceChannel, 4), "record must be removed")
+	})
+
+	suite.Run("pass - disabled contract does not emit log", func() {
+		ctx := txCtx()
+		err := suite.App().CpcKeeper().SetCustomPrecompiledContractDisabled(ctx, cpctypes.CpcIbcTransferFixedAddress, true)
+		suite.Require().NoError(err)
+		packet := prepareRefund(ctx, 5)
+
+		err = middleware.OnTimeoutPacket(ctx, packet, nil)
+		suite.Require().NoError(err)
+
+		suite.Empty(findRefundLogs(getEmittedLogs(ctx)))
+	})
+}
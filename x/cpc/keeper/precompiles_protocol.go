@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+)
+
+// CustomPrecompiledContractMethodExecutorsFactory builds the method executors for the given contract.
+type CustomPrecompiledContractMethodExecutorsFactory func(contract CustomPrecompiledContractI) []ExtendedCustomPrecompiledContractMethodExecutorI
+
+// CustomPrecompiledContractMetaMigration rewrites the metadata of an existing contract when the protocol version is bumped.
+// Address and type of the contract must not be changed.
+type CustomPrecompiledContractMetaMigration func(ctx sdk.Context, contractMeta cpctypes.CustomPrecompiledContractMeta) (cpctypes.CustomPrecompiledContractMeta, error)
+
+// protocolCpcRegistry holds the method executors and the metadata migrations,
+// registered per protocol version and per custom precompiled contract type.
+// The executors provided by the contracts are the baseline of the first protocol version.
+type protocolCpcRegistry struct {
+	methodExecutors map[cpctypes.ProtocolCpc]map[uint32][]CustomPrecompiledContractMethodExecutorsFactory
+	metaMigrations  map[cpctypes.ProtocolCpc]map[uint32][]CustomPrecompiledContractMetaMigration
+}
+
+func newProtocolCpcRegistry() *protocolCpcRegistry {
+	return &protocolCpcRegistry{
+		methodExecutors: make(map[cpctypes.ProtocolCpc]map[uint32][]CustomPrecompiledContractMethodExecutorsFactory),
+		metaMigrations:  make(map[cpctypes.ProtocolCpc]map[uint32][]CustomPrecompiledContractMetaMigration),
+	}
+}
+
+// RegisterMethodExecutors registers the method executors those are activated from the given protocol version.
+// Executor with the same method signature as an executor of the previous versions replaces it, others are added.
+// This method will panic if the protocol version is not an upgraded version.
+func (k Keeper) RegisterMethodExecutors(protocolVersion cpctypes.ProtocolCpc, cpcType uint32, factory CustomPrecompiledContractMethodExecutorsFactory) {
+	mustBeUpgradedProtocolVersion(protocolVersion)
+
+	if k.protocolRegistry.methodExecutors[protocolVersion] == nil {
+		k.protocolRegistry.methodExecutors[protocolVersion] = make(map[uint32][]CustomPrecompiledContractMethodExecutorsFactory)
+	}
+	k.protocolRegistry.methodExecutors[protocolVersion][cpcType] = append(k.protocolRegistry.methodExecutors[protocolVersion][cpcType], factory)
+}
+
+// RegisterMetaMigration registers the migration, which is applied to metadata of the existing contracts of the given type,
+// when the protocol version is bumped to the given version.
+// This method will panic if the protocol version is not an upgraded version.
+func (k Keeper) RegisterMetaMigration(protocolVersion cpctypes.ProtocolCpc, cpcType uint32, migration CustomPrecompiledContractMetaMigration) {
+	mustBeUpgradedProtocolVersion(protocolVersion)
+
+	if k.protocolRegistry.metaMigrations[protocolVersion] == nil {
+		k.protocolRegistry.metaMigrations[protocolVersion] = make(map[uint32][]CustomPrecompiledContractMetaMigration)
+	}
+	k.protocolRegistry.metaMigrations[protocolVersion][cpcType] = append(k.protocolRegistry.metaMigrations[protocolVersion][cpcType], migration)
+}
+
+// registerProtocolCpcUpgrades registers the behavior changes shipped with each upgraded protocol version.
+func (k Keeper) registerProtocolCpcUpgrades() {
+	// V2: ERC-20 increaseAllowance/decreaseAllowance
+	k.RegisterMethodExecutors(cpctypes.ProtocolCpcV2, cpctypes.CpcTypeErc20, newErc20CustomPrecompiledContractV2MethodExecutors)
+}
+
+// GetMethodExecutors returns the method executors of the contract at the given protocol version.
+func (k Keeper) GetMethodExecutors(contract CustomPrecompiledContractI, protocolVersion cpctypes.ProtocolCpc) []ExtendedCustomPrecompiledContractMethodExecutorI {
+	executors := append([]ExtendedCustomPrecompiledContractMethodExecutorI{}, contract.GetMethodExecutors()...)
+
+	cpcType := contract.GetMetadata().CustomPrecompiledType
+	for version := cpctypes.ProtocolCpcV1 + 1; version <= protocolVersion; version++ {
+		for _, factory := range k.protocolRegistry.methodExecutors[version][cpcType] {
+			for _, upgradedExecutor := range factory(contract) {
+				var replaced bool
+				for i, executor := range executors {
+					if bytes.Equal(executor.Method4BytesSignatures(), upgradedExecutor.Method4BytesSignatures()) {
+						executors[i] = upgradedExecutor
+						replaced = true
+						break
+					}
+				}
+				if !replaced {
+					executors = append(executors, upgradedExecutor)
+				}
+			}
+		}
+	}
+
+	return executors
+}
+
+// migrateCustomPrecompiledContractsMeta applies the registered migrations of each protocol version
+// in range (fromVersion, toVersion] to metadata of the existing contracts.
+// The target protocol version must be already set into the params.
+func (k Keeper) migrateCustomPrecompiledContractsMeta(ctx sdk.Context, fromVersion, toVersion cpctypes.ProtocolCpc) error {
+	for version := fromVersion + 1; version <= toVersion; version++ {
+		migrationsByType := k.protocolRegistry.metaMigrations[version]
+		if len(migrationsByType) < 1 {
+			continue
+		}
+
+		for _, contractMeta := range k.GetAllCustomPrecompiledContractsMeta(ctx) {
+			migrations := migrationsByType[contractMeta.CustomPrecompiledType]
+			if len(migrations) < 1 {
+				continue
+			}
+
+			migratedMeta := contractMeta
+			for _, migration := range migrations {
+				var err error
+				migratedMeta, err = migration(ctx, migratedMeta)
+				if err != nil {
+					return errorsmod.Wrapf(err, "failed to migrate metadata of contract %s to protocol version %d", contractMeta.Name, version)
+				}
+			}
+
+			if !bytes.Equal(migratedMeta.Address, contractMeta.Address) || migratedMeta.CustomPrecompiledType != contractMeta.CustomPrecompiledType {
+				return errorsmod.Wrapf(sdkerrors.ErrLogic, "not allowed to change address or type of contract %s by migration to protocol version %d", contractMeta.Name, version)
+			}
+
+			if err := k.SetCustomPrecompiledContractMeta(ctx, migratedMeta, false); err != nil {
+				return errorsmod.Wrapf(err, "failed to set migrated metadata of contract %s", contractMeta.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func mustBeUpgradedProtocolVersion(protocolVersion cpctypes.ProtocolCpc) {
+	if protocolVersion <= cpctypes.ProtocolCpcV1 || protocolVersion > cpctypes.LatestProtocolCpc {
+		panic(fmt.Sprintf("not an upgraded protocol version: %d", protocolVersion))
+	}
+}
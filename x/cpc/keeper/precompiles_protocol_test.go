@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	chainapp "github.com/EscanBE/evermint/app"
+	cpckeeper "github.com/EscanBE/evermint/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// setProtocolVersion writes the protocol version into the params directly, bypassing the forward-only check.
+func (suite *CpcTestSuite) setProtocolVersion(ctx sdk.Context, protocolVersion cpctypes.ProtocolCpc) {
+	params := suite.App().CpcKeeper().GetParams(ctx)
+	params.ProtocolVersion = uint32(protocolVersion)
+
+	bz, err := params.Marshal()
+	suite.Require().NoError(err)
+
+	store := ctx.KVStore(suite.App().IbcTestingApp().(*chainapp.Evermint).GetKey(cpctypes.StoreKey))
+	store.Set(cpctypes.KeyPrefixParams, bz)
+}
+
+func (suite *CpcTestSuite) updateProtocolVersion(ctx sdk.Context, protocolVersion cpctypes.ProtocolCpc) error {
+	params := suite.App().CpcKeeper().GetParams(ctx)
+	params.ProtocolVersion = uint32(protocolVersion)
+
+	_, err := cpckeeper.NewMsgServerImpl(*suite.App().CpcKeeper()).UpdateParams(ctx, &cpctypes.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		NewParams: params,
+	})
+	return err
+}
+
+func (suite *CpcTestSuite) TestKeeper_RegisterMethodExecutors() {
+	bech32Meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcBech32FixedAddress)
+	suite.Require().NotNil(bech32Meta)
+	bech32Executors := cpckeeper.NewBech32CustomPrecompiledContract(*bech32Meta).GetMethodExecutors()
+
+	// the bech32 methods are added into the gov contract from protocol version 2
+	suite.App().CpcKeeper().RegisterMethodExecutors(cpctypes.ProtocolCpcV2, cpctypes.CpcTypeGov, func(_ cpckeeper.CustomPrecompiledContractI) []cpckeeper.ExtendedCustomPrecompiledContractMethodExecutorI {
+		return bech32Executors
+	})
+
+	govMeta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcGovFixedAddress)
+	suite.Require().NotNil(govMeta)
+	govContract := cpckeeper.NewCustomPrecompiledContract(*govMeta, *suite.App().CpcKeeper())
+	govExecutorsCount := len(govContract.GetMethodExecutors())
+
+	suite.Run("pass - registered executors are not available at previous protocol version", func() {
+		executors := suite.App().CpcKeeper().GetMethodExecutors(govContract, cpctypes.ProtocolCpcV1)
+		suite.Len(executors, govExecutorsCount)
+
+		ctx, _ := suite.Ctx().CacheContext()
+		suite.setProtocolVersion(ctx, cpctypes.ProtocolCpcV1)
+
+		res, err := suite.EthCallApply(ctx, nil, cpctypes.CpcGovFixedAddress, get4BytesSignature("bech32AccountAddrPrefix()"))
+		suite.Require().NoError(err)
+		suite.NotEmpty(res.VmError)
+	})
+
+	suite.Run("pass - registered executors are available from the registered protocol version", func() {
+		executors := suite.App().CpcKeeper().GetMethodExecutors(govContract, cpctypes.ProtocolCpcV2)
+		suite.Len(executors, govExecutorsCount+len(bech32Executors))
+
+		ctx, _ := suite.Ctx().CacheContext()
+		suite.setProtocolVersion(ctx, cpctypes.ProtocolCpcV2)
+
+		res, err := suite.EthCallApply(ctx, nil, cpctypes.CpcGovFixedAddress, get4BytesSignature("bech32AccountAddrPrefix()"))
+		suite.Require().NoError(err)
+		suite.Empty(res.VmError)
+	})
+
+	suite.Run("pass - executor with the same method signature replaces the previous one", func() {
+		suite.App().CpcKeeper().RegisterMethodExecutors(cpctypes.ProtocolCpcV2, cpctypes.CpcTypeGov, func(contract cpckeeper.CustomPrecompiledContractI) []cpckeeper.ExtendedCustomPrecompiledContractMethodExecutorI {
+			return contract.GetMethodExecutors()[:1]
+		})
+
+		executors := suite.App().CpcKeeper().GetMethodExecutors(govContract, cpctypes.ProtocolCpcV2)
+		suite.Len(executors, govExecutorsCount+len(bech32Executors))
+	})
+
+	suite.Run("fail - panic if registering at the first protocol version", func() {
+		suite.Panics(func() {
+			suite.App().CpcKeeper().RegisterMethodExecutors(cpctypes.ProtocolCpcV1, cpctypes.CpcTypeGov, nil)
+		})
+	})
+
+	suite.Run("fail - panic if registering at unknown protocol version", func() {
+		suite.Panics(func() {
+			suite.App().CpcKeeper().RegisterMethodExecutors(cpctypes.LatestProtocolCpc+1, cpctypes.CpcTypeGov, nil)
+		})
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_RegisterMetaMigration() {
+	getContractName := func(ctx sdk.Context, contractAddress common.Address) string {
+		contractMeta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(ctx, contractAddress)
+		suite.Require().NotNil(contractMeta)
+		return contractMeta.Name
+	}
+
+	govName := getContractName(suite.Ctx(), cpctypes.CpcGovFixedAddress)
+	bech32Name := getContractName(suite.Ctx(), cpctypes.CpcBech32FixedAddress)
+
+	suite.App().CpcKeeper().RegisterMetaMigration(cpctypes.ProtocolCpcV2, cpctypes.CpcTypeGov, func(_ sdk.Context, contractMeta cpctypes.CustomPrecompiledContractMeta) (cpctypes.CustomPrecompiledContractMeta, error) {
+		contractMeta.Name += " V2"
+		return contractMeta, nil
+	})
+
+	suite.Run("pass - metadata is migrated when protocol version is bumped", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		suite.setProtocolVersion(ctx, cpctypes.ProtocolCpcV1)
+
+		err := suite.updateProtocolVersion(ctx, cpctypes.ProtocolCpcV2)
+		suite.Require().NoError(err)
+
+		suite.Equal(cpctypes.ProtocolCpcV2, suite.App().CpcKeeper().GetProtocolCpcVersion(ctx))
+
+		suite.Equal(govName+" V2", getContractName(ctx, cpctypes.CpcGovFixedAddress))
+		suite.Equal(bech32Name, getContractName(ctx, cpctypes.CpcBech32FixedAddress), "contracts of other types should not be migrated")
+	})
+
+	suite.Run("pass - metadata is not migrated when protocol version is not changed", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		err := suite.updateProtocolVersion(ctx, cpctypes.ProtocolCpcV2)
+		suite.Require().NoError(err)
+
+		suite.Equal(govName, getContractName(ctx, cpctypes.CpcGovFixedAddress))
+	})
+
+	suite.Run("fail - protocol version can not be downgraded", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		err := suite.updateProtocolVersion(ctx, cpctypes.ProtocolCpcV1)
+		suite.Require().ErrorContains(err, "downgrade of protocol version is not allowed")
+
+		suite.Equal(cpctypes.ProtocolCpcV2, suite.App().CpcKeeper().GetProtocolCpcVersion(ctx))
+	})
+
+	suite.Run("fail - migration is not allowed to change type of the contract", func() {
+		suite.App().CpcKeeper().RegisterMetaMigration(cpctypes.ProtocolCpcV2, cpctypes.CpcTypeBech32, func(_ sdk.Context, contractMeta cpctypes.CustomPrecompiledContractMeta) (cpctypes.CustomPrecompiledContractMeta, error) {
+			contractMeta.CustomPrecompiledType = cpctypes.CpcTypeGov
+			return contractMeta, nil
+		})
+
+		ctx, _ := suite.Ctx().CacheContext()
+		suite.setProtocolVersion(ctx, cpctypes.ProtocolCpcV1)
+
+		err := suite.updateProtocolVersion(ctx, cpctypes.ProtocolCpcV2)
+		suite.Require().ErrorContains(err, "not allowed to change address or type of contract")
+	})
+}
@@ -0,0 +1,347 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
+)
+
+// sdkEventArgKind defines how the value of an argument of the Solidity event is resolved.
+type sdkEventArgKind uint8
+
+const (
+	// sdkEventArgActor resolves to the actor provided by the executor, usually the caller.
+	sdkEventArgActor sdkEventArgKind = iota
+	// sdkEventArgAccAddress resolves the bech32 account address at the attribute into an address.
+	sdkEventArgAccAddress
+	// sdkEventArgValAddress resolves the bech32 validator operator address at the attribute into an address.
+	sdkEventArgValAddress
+	// sdkEventArgBondDenomAmount resolves the amount of bond denom of the coins at the attribute into an uint256.
+	sdkEventArgBondDenomAmount
+	// sdkEventArgUint64 resolves the decimal number at the attribute into an uint64.
+	sdkEventArgUint64
+)
+
+// sdkEventArg declares the source of value of an argument of the Solidity event.
+type sdkEventArg struct {
+	kind      sdkEventArgKind
+	attribute string
+}
+
+func sdkEventArgFromActor() sdkEventArg {
+	return sdkEventArg{kind: sdkEventArgActor}
+}
+
+func sdkEventArgFromAccAddress(attribute string) sdkEventArg {
+	return sdkEventArg{kind: sdkEventArgAccAddress, attribute: attribute}
+}
+
+func sdkEventArgFromValAddress(attribute string) sdkEventArg {
+	return sdkEventArg{kind: sdkEventArgValAddress, attribute: attribute}
+}
+
+func sdkEventArgFromBondDenomAmount(attribute string) sdkEventArg {
+	return sdkEventArg{kind: sdkEventArgBondDenomAmount, attribute: attribute}
+}
+
+func sdkEventArgFromUint64(attribute string) sdkEventArg {
+	return sdkEventArg{kind: sdkEventArgUint64, attribute: attribute}
+}
+
+// sdkEventToEvmLogMapping declares how a Cosmos SDK event is bridged into an EVM log.
+// Multiple mappings of the same SDK event type are allowed, each produces a log, in the declared order,
+// they must declare the same set of attributes.
+type sdkEventToEvmLogMapping struct {
+	// sdkEventType is the type of the SDK event.
+	sdkEventType string
+	// sdkEventAttributes is the exact set of attributes of the SDK event, events with different set of attributes are ignored.
+	sdkEventAttributes []string
+	// evmEvent is the name of the Solidity event, declared in the ABI of the contract.
+	evmEvent string
+	// evmEventArgs is the source of value of each argument of the Solidity event, in the declared order.
+	// Logs with non-positive amount are not emitted.
+	evmEventArgs []sdkEventArg
+}
+
+// sdkEventsBridge bridges the Cosmos SDK events, emitted by modules during execution of a custom precompiled contract,
+// into EVM logs of the contract, following the declared mappings.
+type sdkEventsBridge struct {
+	keeper          Keeper
+	contractAddress common.Address
+	cpcInfo         abi.CustomPrecompiledContractInfo
+	mappings        []sdkEventToEvmLogMapping
+}
+
+// newSdkEventsBridge returns a new sdkEventsBridge, panics if any mapping does not match the ABI of the contract.
+func newSdkEventsBridge(
+	keeper Keeper,
+	contractAddress common.Address,
+	cpcInfo abi.CustomPrecompiledContractInfo,
+	mappings ...sdkEventToEvmLogMapping,
+) sdkEventsBridge {
+	attributesByEventType := make(map[string]string)
+	for _, mapping := range mappings {
+		if err := mapping.validate(cpcInfo); err != nil {
+			panic(fmt.Sprintf("invalid mapping of SDK event %s to %s: %s", mapping.sdkEventType, cpcInfo.Name, err.Error()))
+		}
+
+		// the SDK events are filtered by the attributes, so they must be consistent across mappings of the same event type
+		attributes := strings.Join(mapping.sdkEventAttributes, ",")
+		if existing, found := attributesByEventType[mapping.sdkEventType]; found && existing != attributes {
+			panic(fmt.Sprintf("inconsistent attributes of SDK event %s to %s: [%s] != [%s]", mapping.sdkEventType, cpcInfo.Name, existing, attributes))
+		}
+		attributesByEventType[mapping.sdkEventType] = attributes
+	}
+
+	return sdkEventsBridge{
+		keeper:          keeper,
+		contractAddress: contractAddress,
+		cpcInfo:         cpcInfo,
+		mappings:        mappings,
+	}
+}
+
+func (m sdkEventToEvmLogMapping) validate(cpcInfo abi.CustomPrecompiledContractInfo) error {
+	if m.sdkEventType == "" {
+		return fmt.Errorf("empty SDK event type")
+	}
+
+	if len(m.sdkEventAttributes) < 1 {
+		return fmt.Errorf("empty SDK event attributes")
+	}
+
+	event, found := cpcInfo.ABI.Events[m.evmEvent]
+	if !found {
+		return fmt.Errorf("event %s does not exist in ABI", m.evmEvent)
+	}
+
+	if len(event.Inputs) != len(m.evmEventArgs) {
+		return fmt.Errorf("event %s requires %d arguments, got %d", m.evmEvent, len(event.Inputs), len(m.evmEventArgs))
+	}
+
+	for i, arg := range m.evmEventArgs {
+		input := event.Inputs[i]
+		switch arg.kind {
+		case sdkEventArgActor, sdkEventArgAccAddress, sdkEventArgValAddress:
+			if input.Type.T != ethabi.AddressTy {
+				return fmt.Errorf("argument %s of event %s is not an address", input.Name, m.evmEvent)
+			}
+		case sdkEventArgBondDenomAmount:
+			if input.Type.T != ethabi.UintTy || input.Type.Size != 256 {
+				return fmt.Errorf("argument %s of event %s is not an uint256", input.Name, m.evmEvent)
+			}
+		case sdkEventArgUint64:
+			if input.Type.T != ethabi.UintTy || input.Type.Size != 64 {
+				return fmt.Errorf("argument %s of event %s is not an uint64", input.Name, m.evmEvent)
+			}
+		default:
+			return fmt.Errorf("unknown kind %d of argument %s", arg.kind, input.Name)
+		}
+
+		if arg.kind == sdkEventArgActor {
+			continue
+		}
+
+		var isDeclaredAttribute bool
+		for _, attribute := range m.sdkEventAttributes {
+			if attribute == arg.attribute {
+				isDeclaredAttribute = true
+				break
+			}
+		}
+		if !isDeclaredAttribute {
+			return fmt.Errorf("attribute %s of argument %s is not declared", arg.attribute, input.Name)
+		}
+	}
+
+	return nil
+}
+
+// countTrackedEvents returns number of the SDK events, which are tracked by the bridge, currently in the event manager.
+// Used to take a snapshot before execution so only new events are bridged.
+func (b sdkEventsBridge) countTrackedEvents(em sdk.EventManagerI) int {
+	return len(b.getTrackedEvents(em))
+}
+
+// emitEvmLogs emits EVM logs for the tracked SDK events, those were emitted after the snapshot.
+func (b sdkEventsBridge) emitEvmLogs(originalEventsCount int, actor common.Address, env cpcExecutorEnv) error {
+	events := b.getTrackedEvents(env.ctx.EventManager())
+	if len(events) <= originalEventsCount {
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "no new event found")
+	}
+
+	if originalEventsCount > 0 {
+		// emit new events only to avoid re-emitting the same events which was already emitted
+		events = events[originalEventsCount:]
+	}
+
+	var bondDenom string
+	getBondDenom := func() (string, error) {
+		if bondDenom == "" {
+			var err error
+			bondDenom, err = b.keeper.stakingKeeper.BondDenom(env.ctx)
+			if err != nil {
+				return "", errorsmod.Wrapf(err, "failed to get bond denom")
+			}
+		}
+		return bondDenom, nil
+	}
+
+	for _, event := range events {
+		for _, mapping := range b.mappings {
+			if mapping.sdkEventType != event.Type {
+				continue
+			}
+
+			args := make([]any, len(mapping.evmEventArgs))
+			skip := false
+			for i, arg := range mapping.evmEventArgs {
+				value := event.Attributes[arg.attribute]
+
+				switch arg.kind {
+				case sdkEventArgActor:
+					args[i] = actor
+				case sdkEventArgAccAddress:
+					accAddr, err := sdk.AccAddressFromBech32(value)
+					if err != nil {
+						return errorsmod.Wrapf(err, "failed to parse %s address: %s", arg.attribute, value)
+					}
+					args[i] = common.BytesToAddress(accAddr)
+				case sdkEventArgValAddress:
+					valAddr, err := b.keeper.stakingKeeper.ValidatorAddressCodec().StringToBytes(value)
+					if err != nil {
+						return errorsmod.Wrapf(err, "failed to convert %s address: %s", arg.attribute, value)
+					}
+					args[i] = common.BytesToAddress(valAddr)
+				case sdkEventArgBondDenomAmount:
+					coins, err := sdk.ParseCoinsNormalized(value)
+					if err != nil {
+						return errorsmod.Wrapf(err, "failed to parse coins: %s", value)
+					}
+					if coins.IsZero() {
+						skip = true
+						args[i] = new(big.Int)
+						break
+					}
+					bondDenom, err := getBondDenom()
+					if err != nil {
+						return err
+					}
+					amount := coins.AmountOf(bondDenom).BigInt()
+					if amount.Sign() != 1 {
+						skip = true
+					}
+					args[i] = amount
+				case sdkEventArgUint64:
+					number, err := strconv.ParseUint(value, 10, 64)
+					if err != nil {
+						return errorsmod.Wrapf(err, "failed to parse %s number: %s", arg.attribute, value)
+					}
+					args[i] = number
+				default:
+					panic(fmt.Sprintf("unknown kind %d", arg.kind))
+				}
+			}
+
+			if skip {
+				continue
+			}
+
+			if err := addEvmLog(env.evm.StateDB, b.contractAddress, b.cpcInfo, mapping.evmEvent, args...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getTrackedEvents returns the SDK events which match any of the mappings.
+func (b sdkEventsBridge) getTrackedEvents(em sdk.EventManagerI) []normalizedEvent {
+	return findEvents(em, func(event sdk.Event) *normalizedEvent {
+		for _, mapping := range b.mappings {
+			if mapping.sdkEventType != event.Type {
+				continue
+			}
+
+			wantAttributesCount := len(mapping.sdkEventAttributes)
+			if len(event.Attributes) != wantAttributesCount {
+				return nil
+			}
+
+			ne := &normalizedEvent{
+				Type:       event.Type,
+				Attributes: make(map[string]string),
+			}
+			return ne.putWantedAttrsByKey(event.Attributes, mapping.sdkEventAttributes...).requireAttributesCountOrNil(wantAttributesCount)
+		}
+
+		return nil
+	})
+}
+
+// addEvmLog packs the arguments following the Solidity event declared in the ABI of the contract,
+// then adds the log into the state DB.
+// Used by the bridge, and directly by the contracts whose logs carry values which are not available in the SDK events.
+func addEvmLog(stateDB corevm.StateDB, contractAddress common.Address, cpcInfo abi.CustomPrecompiledContractInfo, eventName string, args ...any) error {
+	log, err := newEvmLog(contractAddress, cpcInfo, eventName, args...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(log)
+
+	return nil
+}
+
+// newEvmLog packs the arguments following the Solidity event declared in the ABI of the contract, into a log.
+func newEvmLog(contractAddress common.Address, cpcInfo abi.CustomPrecompiledContractInfo, eventName string, args ...any) (*ethtypes.Log, error) {
+	event, found := cpcInfo.ABI.Events[eventName]
+	if !found {
+		return nil, fmt.Errorf("event %s does not exist in ABI of %s", eventName, cpcInfo.Name)
+	}
+
+	if len(args) != len(event.Inputs) {
+		return nil, fmt.Errorf("event %s requires %d arguments, got %d", eventName, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.ID}
+	var nonIndexedArgs []any
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexedArgs = append(nonIndexedArgs, args[i])
+			continue
+		}
+
+		indexedTopics, err := ethabi.MakeTopics([]any{args[i]})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to make topic for argument %s of event %s", input.Name, eventName)
+		}
+		topics = append(topics, indexedTopics[0][0])
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexedArgs...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to pack data of event %s", eventName)
+	}
+	if data == nil {
+		data = []byte{}
+	}
+
+	return &ethtypes.Log{
+		Address: contractAddress,
+		Topics:  topics,
+		Data:    data,
+	}, nil
+}
@@ -0,0 +1,341 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
+)
+
+// logsCollectorStateDB collects the logs, other methods of the state DB are not implemented.
+type logsCollectorStateDB struct {
+	corevm.StateDB
+	logs []*ethtypes.Log
+}
+
+func (db *logsCollectorStateDB) AddLog(log *ethtypes.Log) {
+	db.logs = append(db.logs, log)
+}
+
+func newSdkEventsBridgeTestEnv() (cpcExecutorEnv, *logsCollectorStateDB) {
+	stateDB := &logsCollectorStateDB{}
+	return cpcExecutorEnv{
+		ctx: sdk.Context{}.WithEventManager(sdk.NewEventManager()),
+		evm: &corevm.EVM{StateDB: stateDB},
+	}, stateDB
+}
+
+var testSdkEventSubmitProposalAttributes = []string{
+	govtypes.AttributeKeyProposalID,
+	govtypes.AttributeKeyProposalProposer,
+	govtypes.AttributeKeyProposalMessages,
+}
+
+func Test_newSdkEventsBridge(t *testing.T) {
+	validMapping := sdkEventToEvmLogMapping{
+		sdkEventType:       govtypes.EventTypeSubmitProposal,
+		sdkEventAttributes: testSdkEventSubmitProposalAttributes,
+		evmEvent:           "SubmitProposal",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromAccAddress(govtypes.AttributeKeyProposalProposer),
+			sdkEventArgFromUint64(govtypes.AttributeKeyProposalID),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mutate         func(m *sdkEventToEvmLogMapping)
+		extraMappings  []sdkEventToEvmLogMapping
+		wantPanicMatch string
+	}{
+		{
+			name:   "pass - valid mapping",
+			mutate: func(_ *sdkEventToEvmLogMapping) {},
+		},
+		{
+			name:          "pass - several mappings of the same SDK event",
+			mutate:        func(_ *sdkEventToEvmLogMapping) {},
+			extraMappings: []sdkEventToEvmLogMapping{validMapping},
+		},
+		{
+			name: "fail - empty SDK event type",
+			mutate: func(m *sdkEventToEvmLogMapping) {
+				m.sdkEventType = ""
+			},
+			wantPanicMatch: "empty SDK event type",
+		},
+		{
+			name: "fail - empty SDK event attributes",
+			mutate: func(m *sdkEventToEvmLogMapping) {
+				m.sdkEventAttributes = nil
+			},
+			wantPanicMatch: "empty SDK event attributes",
+		},
+		{
+			name: "fail - event does not exist in ABI",
+			mutate: func(m *sdkEventToEvmLogMapping) {
+				m.evmEvent = "NonExisting"
+			},
+			wantPanicMatch: "event NonExisting does not exist in ABI",
+		},
+		{
+			name: "fail - mis-match number of arguments",
+			mutate: func(m *sdkEventToEvmLogMapping) {
+				m.evmEventArgs = m.evmEventArgs[:1]
+			},
+			wantPanicMatch: "event SubmitProposal requires 2 arguments, got 1",
+		},
+		{
+			name: "fail - address argument from non-address input",
+			mutate: func(m *sdkEventToEvmLogMapping) {
+				m.evmEventArgs = []sdkEventArg{m.evmEventArgs[0], sdkEventArgFromActor()}
+			},
+			wantPanicMatch: "argument proposalId of event SubmitProposal is not an address",
+		},
+		{
+			name: "fail - uint64 argument from non-uint64 input",
+			mutate: func(m *sdkEventToEvmLogMapping) {
+				m.evmEventArgs = []sdkEventArg{sdkEventArgFromUint64(govtypes.AttributeKeyProposalID), m.evmEventArgs[1]}
+			},
+			wantPanicMatch: "argument proposer of event SubmitProposal is not an uint64",
+		},
+		{
+			name: "fail - uint256 argument from non-uint256 input",
+			mutate: func(m *sdkEventToEvmLogMapping) {
+				m.evmEventArgs = []sdkEventArg{m.evmEventArgs[0], sdkEventArgFromBondDenomAmount(govtypes.AttributeKeyProposalID)}
+			},
+			wantPanicMatch: "argument proposalId of event SubmitProposal is not an uint256",
+		},
+		{
+			name: "fail - attribute of argument is not declared",
+			mutate: func(m *sdkEventToEvmLogMapping) {
+				m.evmEventArgs = []sdkEventArg{sdkEventArgFromAccAddress("non-declared"), m.evmEventArgs[1]}
+			},
+			wantPanicMatch: "attribute non-declared of argument proposer is not declared",
+		},
+		{
+			name:   "fail - inconsistent attributes across mappings of the same SDK event",
+			mutate: func(_ *sdkEventToEvmLogMapping) {},
+			extraMappings: []sdkEventToEvmLogMapping{
+				{
+					sdkEventType:       govtypes.EventTypeSubmitProposal,
+					sdkEventAttributes: testSdkEventSubmitProposalAttributes[:2],
+					evmEvent:           "SubmitProposal",
+					evmEventArgs: []sdkEventArg{
+						sdkEventArgFromAccAddress(govtypes.AttributeKeyProposalProposer),
+						sdkEventArgFromUint64(govtypes.AttributeKeyProposalID),
+					},
+				},
+			},
+			wantPanicMatch: "inconsistent attributes of SDK event submit_proposal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping := validMapping
+			mapping.evmEventArgs = append([]sdkEventArg{}, validMapping.evmEventArgs...)
+			tt.mutate(&mapping)
+
+			mappings := append([]sdkEventToEvmLogMapping{mapping}, tt.extraMappings...)
+
+			var recovered any
+			func() {
+				defer func() {
+					recovered = recover()
+				}()
+				_ = newSdkEventsBridge(Keeper{}, common.Address{}, abi.GovCpcInfo, mappings...)
+			}()
+
+			if tt.wantPanicMatch == "" {
+				require.Nil(t, recovered)
+				return
+			}
+
+			require.NotNil(t, recovered)
+			require.Contains(t, recovered.(string), tt.wantPanicMatch)
+		})
+	}
+}
+
+func Test_sdkEventsBridge_emitEvmLogs(t *testing.T) {
+	contractAddress := common.BytesToAddress([]byte("contract"))
+	actor := common.BytesToAddress([]byte("actor"))
+	proposer := common.BytesToAddress([]byte("proposer"))
+	depositor := common.BytesToAddress([]byte("depositor"))
+
+	topic0SubmitProposal := crypto.Keccak256Hash([]byte("SubmitProposal(address,uint64)"))
+
+	newSubmitProposalEvent := func(proposalId string) sdk.Event {
+		return sdk.NewEvent(
+			govtypes.EventTypeSubmitProposal,
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, proposalId),
+			sdk.NewAttribute(govtypes.AttributeKeyProposalProposer, sdk.AccAddress(proposer.Bytes()).String()),
+			sdk.NewAttribute(govtypes.AttributeKeyProposalMessages, ",/cosmos.bank.v1beta1.MsgSend"),
+		)
+	}
+
+	t.Run("pass - several mappings per event, topics and data are encoded following ABI", func(t *testing.T) {
+		bridge := newSdkEventsBridge(Keeper{}, contractAddress, abi.GovCpcInfo,
+			sdkEventToEvmLogMapping{
+				sdkEventType:       govtypes.EventTypeSubmitProposal,
+				sdkEventAttributes: testSdkEventSubmitProposalAttributes,
+				evmEvent:           "SubmitProposal",
+				evmEventArgs: []sdkEventArg{
+					sdkEventArgFromAccAddress(govtypes.AttributeKeyProposalProposer),
+					sdkEventArgFromUint64(govtypes.AttributeKeyProposalID),
+				},
+			},
+			sdkEventToEvmLogMapping{
+				sdkEventType:       govtypes.EventTypeSubmitProposal,
+				sdkEventAttributes: testSdkEventSubmitProposalAttributes,
+				evmEvent:           "SubmitProposal",
+				evmEventArgs: []sdkEventArg{
+					sdkEventArgFromActor(),
+					sdkEventArgFromUint64(govtypes.AttributeKeyProposalID),
+				},
+			},
+		)
+
+		env, stateDB := newSdkEventsBridgeTestEnv()
+		em := env.ctx.EventManager()
+
+		em.EmitEvent(newSubmitProposalEvent("1")) // emitted before the snapshot
+		originalCount := bridge.countTrackedEvents(em)
+		require.Equal(t, 1, originalCount)
+
+		em.EmitEvent(newSubmitProposalEvent("2"))
+		em.EmitEvent(sdk.NewEvent(
+			govtypes.EventTypeSubmitProposal,
+			sdk.NewAttribute(govtypes.AttributeKeyVotingPeriodStart, "2"),
+		)) // different set of attributes, ignored
+
+		require.NoError(t, bridge.emitEvmLogs(originalCount, actor, env))
+		require.Len(t, stateDB.logs, 2)
+
+		for i, wantFirstTopicAddress := range []common.Address{proposer, actor} {
+			log := stateDB.logs[i]
+			require.Equal(t, contractAddress, log.Address)
+			require.Equal(t, []common.Hash{
+				topic0SubmitProposal,
+				common.BytesToHash(wantFirstTopicAddress.Bytes()),
+				common.BigToHash(big.NewInt(2)),
+			}, log.Topics)
+			require.Empty(t, log.Data)
+		}
+	})
+
+	t.Run("pass - skip zero amount", func(t *testing.T) {
+		bridge := newSdkEventsBridge(Keeper{}, contractAddress, abi.GovCpcInfo,
+			sdkEventToEvmLogMapping{
+				sdkEventType:       govtypes.EventTypeProposalDeposit,
+				sdkEventAttributes: []string{govtypes.AttributeKeyDepositor, sdk.AttributeKeyAmount, govtypes.AttributeKeyProposalID},
+				evmEvent:           "Deposit",
+				evmEventArgs: []sdkEventArg{
+					sdkEventArgFromAccAddress(govtypes.AttributeKeyDepositor),
+					sdkEventArgFromUint64(govtypes.AttributeKeyProposalID),
+					sdkEventArgFromBondDenomAmount(sdk.AttributeKeyAmount),
+				},
+			},
+		)
+
+		for _, amount := range []string{"", "0stake"} {
+			env, stateDB := newSdkEventsBridgeTestEnv()
+			env.ctx.EventManager().EmitEvent(sdk.NewEvent(
+				govtypes.EventTypeProposalDeposit,
+				sdk.NewAttribute(govtypes.AttributeKeyDepositor, sdk.AccAddress(depositor.Bytes()).String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount),
+				sdk.NewAttribute(govtypes.AttributeKeyProposalID, "1"),
+			))
+
+			require.NoError(t, bridge.emitEvmLogs(0, actor, env))
+			require.Empty(t, stateDB.logs, "log of zero amount %q should not be emitted", amount)
+		}
+	})
+
+	t.Run("fail - no new event", func(t *testing.T) {
+		bridge := newSdkEventsBridge(Keeper{}, contractAddress, abi.GovCpcInfo, govSdkEventsMappings...)
+
+		env, stateDB := newSdkEventsBridgeTestEnv()
+		env.ctx.EventManager().EmitEvent(newSubmitProposalEvent("1"))
+
+		err := bridge.emitEvmLogs(bridge.countTrackedEvents(env.ctx.EventManager()), actor, env)
+		require.ErrorContains(t, err, "no new event found")
+		require.Empty(t, stateDB.logs)
+	})
+}
+
+func Test_addEvmLog(t *testing.T) {
+	contractAddress := common.BytesToAddress([]byte("contract"))
+	voter := common.BytesToAddress([]byte("voter"))
+
+	t.Run("pass - indexed arguments are topics, others are data", func(t *testing.T) {
+		stateDB := &logsCollectorStateDB{}
+
+		err := addEvmLog(stateDB, contractAddress, abi.GovCpcInfo, "Vote", voter, uint64(9), uint8(1), big.NewInt(1e18))
+		require.NoError(t, err)
+		require.Len(t, stateDB.logs, 1)
+
+		log := stateDB.logs[0]
+		require.Equal(t, contractAddress, log.Address)
+		require.Equal(t, []common.Hash{
+			crypto.Keccak256Hash([]byte("Vote(address,uint64,uint8,uint256)")),
+			common.BytesToHash(voter.Bytes()),
+			common.BigToHash(big.NewInt(9)),
+		}, log.Topics)
+		require.Equal(t, append(
+			common.BigToHash(big.NewInt(1)).Bytes(),
+			common.BigToHash(big.NewInt(1e18)).Bytes()...,
+		), log.Data)
+	})
+
+	t.Run("pass - dynamic non-indexed arguments", func(t *testing.T) {
+		stateDB := &logsCollectorStateDB{}
+
+		from := common.BytesToAddress([]byte("from"))
+		to := common.BytesToAddress([]byte("to"))
+		err := addEvmLog(stateDB, contractAddress, abi.BankCpcInfo, "Send", from, to, "stake", big.NewInt(100))
+		require.NoError(t, err)
+		require.Len(t, stateDB.logs, 1)
+
+		log := stateDB.logs[0]
+		require.Len(t, log.Topics, 3)
+		require.Equal(t, crypto.Keccak256Hash([]byte("Send(address,address,string,uint256)")), log.Topics[0])
+
+		unpacked, err := abi.BankCpcInfo.ABI.Events["Send"].Inputs.NonIndexed().Unpack(log.Data)
+		require.NoError(t, err)
+		require.Equal(t, "stake", unpacked[0])
+		require.Equal(t, big.NewInt(100), unpacked[1])
+	})
+
+	t.Run("fail - event does not exist", func(t *testing.T) {
+		stateDB := &logsCollectorStateDB{}
+
+		err := addEvmLog(stateDB, contractAddress, abi.GovCpcInfo, "NonExisting")
+		require.ErrorContains(t, err, "event NonExisting does not exist")
+		require.Empty(t, stateDB.logs)
+	})
+
+	t.Run("fail - mis-match number of arguments", func(t *testing.T) {
+		stateDB := &logsCollectorStateDB{}
+
+		err := addEvmLog(stateDB, contractAddress, abi.GovCpcInfo, "Vote", voter, uint64(9))
+		require.ErrorContains(t, err, "event Vote requires 4 arguments, got 2")
+		require.Empty(t, stateDB.logs)
+	})
+
+	t.Run("fail - argument type mis-match", func(t *testing.T) {
+		stateDB := &logsCollectorStateDB{}
+
+		err := addEvmLog(stateDB, contractAddress, abi.GovCpcInfo, "Vote", voter, uint64(9), "option", big.NewInt(1))
+		require.Error(t, err)
+		require.Empty(t, stateDB.logs)
+	})
+}
@@ -18,7 +18,6 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	corevm "github.com/ethereum/go-ethereum/core/vm"
@@ -69,6 +68,7 @@ type stakingCustomPrecompiledContract struct {
 	keeper               Keeper
 	executors            []ExtendedCustomPrecompiledContractMethodExecutorI
 	cacheStakingMetadata *cpctypes.StakingCustomPrecompiledContractMeta
+	sdkEventsBridge      sdkEventsBridge
 }
 
 // NewStakingCustomPrecompiledContract creates a new Staking custom precompiled contract.
@@ -77,8 +77,9 @@ func NewStakingCustomPrecompiledContract(
 	keeper Keeper,
 ) CustomPrecompiledContractI {
 	contract := &stakingCustomPrecompiledContract{
-		metadata: metadata,
-		keeper:   keeper,
+		metadata:        metadata,
+		keeper:          keeper,
+		sdkEventsBridge: newSdkEventsBridge(keeper, cpctypes.CpcStakingFixedAddress, abi.StakingCpcInfo, stakingSdkEventsMappings...),
 	}
 
 	rewardsOfME := stakingCustomPrecompiledContractRoRewardsOf{contract: contract}
@@ -148,198 +149,69 @@ func (m *stakingCustomPrecompiledContract) minimumRewardWithdrawalAmount() sdkma
 	return oneCoin.QuoRaw(1_000)
 }
 
-func (m stakingCustomPrecompiledContract) emitsEventDelegate(delegator, validator common.Address, amount *big.Int, env cpcExecutorEnv) {
-	env.evm.StateDB.AddLog(&ethtypes.Log{
-		Address: cpctypes.CpcStakingFixedAddress,
-		Topics: []common.Hash{
-			common.HexToHash("0x510b11bb3f3c799b11307c01ab7db0d335683ef5b2da98f7697de744f465eacc"), // Delegate(address,address,uint256)
-			common.BytesToHash(delegator.Bytes()),
-			common.BytesToHash(validator.Bytes()),
+// stakingSdkEventsMappings declares the SDK events to be bridged into Delegate/Undelegate/WithdrawReward logs.
+var stakingSdkEventsMappings = []sdkEventToEvmLogMapping{
+	{
+		sdkEventType: stakingtypes.EventTypeDelegate,
+		sdkEventAttributes: []string{
+			stakingtypes.AttributeKeyValidator, stakingtypes.AttributeKeyDelegator, sdk.AttributeKeyAmount, stakingtypes.AttributeKeyNewShares,
 		},
-		Data: common.BytesToHash(amount.Bytes()).Bytes(),
-	})
-}
-
-func (m stakingCustomPrecompiledContract) emitsEventUnDelegate(delegator, validator common.Address, amount *big.Int, env cpcExecutorEnv) {
-	env.evm.StateDB.AddLog(&ethtypes.Log{
-		Address: cpctypes.CpcStakingFixedAddress,
-		Topics: []common.Hash{
-			common.HexToHash("0xbda8c0e95802a0e6788c3e9027292382d5a41b86556015f846b03a9874b2b827"), // Undelegate(address,address,uint256)
-			common.BytesToHash(delegator.Bytes()),
-			common.BytesToHash(validator.Bytes()),
+		evmEvent: "Delegate",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromAccAddress(stakingtypes.AttributeKeyDelegator),
+			sdkEventArgFromValAddress(stakingtypes.AttributeKeyValidator),
+			sdkEventArgFromBondDenomAmount(sdk.AttributeKeyAmount),
 		},
-		Data: common.BytesToHash(amount.Bytes()).Bytes(),
-	})
-}
-
-func (m stakingCustomPrecompiledContract) emitsEventWithdrawReward(delegator, validator common.Address, amount *big.Int, env cpcExecutorEnv) {
-	env.evm.StateDB.AddLog(&ethtypes.Log{
-		Address: cpctypes.CpcStakingFixedAddress,
-		Topics: []common.Hash{
-			common.HexToHash("0xad71f93891cecc86a28a627d5495c28fabbd31cdd2e93851b16ce3421fdab2e5"), // WithdrawReward(address,address,uint256)
-			common.BytesToHash(delegator.Bytes()),
-			common.BytesToHash(validator.Bytes()),
+	},
+	{
+		sdkEventType: stakingtypes.EventTypeUnbond,
+		sdkEventAttributes: []string{
+			stakingtypes.AttributeKeyValidator, stakingtypes.AttributeKeyDelegator, sdk.AttributeKeyAmount, stakingtypes.AttributeKeyCompletionTime,
 		},
-		Data: common.BytesToHash(amount.Bytes()).Bytes(),
-	})
-}
-
-// autoEmitEventsFromSdkEvents emits Delegate/Undelegate/WithdrawReward events based on sdk events emitted by modules.
-func (m stakingCustomPrecompiledContract) autoEmitEventsFromSdkEvents(
-	em sdk.EventManagerI, originalEventCounts int, delegator sdk.AccAddress, env cpcExecutorEnv,
-) error {
-	events := m.getSdkEventsFromEventManager(em)
-	if len(events) <= originalEventCounts {
-		return errorsmod.Wrapf(sdkerrors.ErrLogic, "no old-event found")
-	}
-
-	if originalEventCounts > 0 {
-		// emit new events only to avoid re-emitting the same events which was already emitted
-		events = events[originalEventCounts:]
-	}
-
-	valAddrCodec := m.keeper.stakingKeeper.ValidatorAddressCodec()
-
-	bondDenom, err := m.keeper.stakingKeeper.BondDenom(env.ctx)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to get bond denom")
-	}
-
-	for _, event := range events {
-		genericExtractValidatorDelegatorAmount := func() (validator, delegator sdk.AccAddress, amount *big.Int, err error) {
-			avValidator := event.Attributes[stakingtypes.AttributeKeyValidator]
-			validator, err = valAddrCodec.StringToBytes(avValidator)
-			if err != nil {
-				err = errorsmod.Wrapf(err, "failed to convert validator address: %s", avValidator)
-				return
-			}
-
-			avDelegator := event.Attributes[stakingtypes.AttributeKeyDelegator]
-			delegator, err = sdk.AccAddressFromBech32(avDelegator)
-			if err != nil {
-				err = errorsmod.Wrapf(err, "failed to parse delegator address: %s", avDelegator)
-				return
-			}
-
-			avAmount := event.Attributes[sdk.AttributeKeyAmount]
-			var coins sdk.Coins
-			coins, err = sdk.ParseCoinsNormalized(avAmount)
-			if err != nil {
-				err = errorsmod.Wrapf(err, "failed to parse coins: %s", avAmount)
-				return
-			}
-
-			amount = coins.AmountOf(bondDenom).BigInt()
-			return
-		}
-
-		if event.Type == stakingtypes.EventTypeDelegate {
-			validator, delegator, amount, err := genericExtractValidatorDelegatorAmount()
-			if err != nil {
-				return err
-			}
-
-			if amount.Sign() == 1 {
-				m.emitsEventDelegate(common.BytesToAddress(delegator), common.BytesToAddress(validator), amount, env)
-			}
-		} else if event.Type == stakingtypes.EventTypeUnbond {
-			validator, delegator, amount, err := genericExtractValidatorDelegatorAmount()
-			if err != nil {
-				return err
-			}
-
-			if amount.Sign() == 1 {
-				m.emitsEventUnDelegate(common.BytesToAddress(delegator), common.BytesToAddress(validator), amount, env)
-			}
-		} else if event.Type == stakingtypes.EventTypeRedelegate {
-			avSrcValidator := event.Attributes[stakingtypes.AttributeKeySrcValidator]
-			srcValidator, err := valAddrCodec.StringToBytes(avSrcValidator)
-			if err != nil {
-				return errorsmod.Wrapf(err, "failed to convert source validator address: %s", avSrcValidator)
-			}
-
-			avDstValidator := event.Attributes[stakingtypes.AttributeKeyDstValidator]
-			dstValidator, err := valAddrCodec.StringToBytes(avDstValidator)
-			if err != nil {
-				return errorsmod.Wrapf(err, "failed to convert destination validator address: %s", avDstValidator)
-			}
-
-			avAmount := event.Attributes[sdk.AttributeKeyAmount]
-			var coins sdk.Coins
-			coins, err = sdk.ParseCoinsNormalized(avAmount)
-			if err != nil {
-				return errorsmod.Wrapf(err, "failed to parse coins: %s", avAmount)
-			}
-
-			amount := coins.AmountOf(bondDenom).BigInt()
-
-			if amount.Sign() == 1 {
-				m.emitsEventUnDelegate(common.BytesToAddress(delegator), common.BytesToAddress(srcValidator), amount, env)
-				m.emitsEventDelegate(common.BytesToAddress(delegator), common.BytesToAddress(dstValidator), amount, env)
-			}
-		} else if event.Type == disttypes.EventTypeWithdrawRewards {
-			validator, delegator, amount, err := genericExtractValidatorDelegatorAmount()
-			if err != nil {
-				return err
-			}
-
-			if amount.Sign() == 1 {
-				m.emitsEventWithdrawReward(common.BytesToAddress(delegator), common.BytesToAddress(validator), amount, env)
-			}
-		}
-	}
-
-	return nil
-}
-
-func (m stakingCustomPrecompiledContract) getSdkEventsFromEventManager(em sdk.EventManagerI) []normalizedEvent {
-	return findEvents(em, func(event sdk.Event) *normalizedEvent {
-		newNormalizedEvent := func(wantedKeys ...string) *normalizedEvent {
-			ne := &normalizedEvent{
-				Type:       event.Type,
-				Attributes: make(map[string]string),
-			}
-			ne.putWantedAttrsByKey(event.Attributes, wantedKeys...)
-			return ne
-		}
-
-		switch event.Type {
-		case stakingtypes.EventTypeDelegate:
-			const wantAttributesCount = 4
-			if len(event.Attributes) != wantAttributesCount {
-				return nil
-			}
-			return newNormalizedEvent(
-				stakingtypes.AttributeKeyValidator, stakingtypes.AttributeKeyDelegator, sdk.AttributeKeyAmount, stakingtypes.AttributeKeyNewShares,
-			).requireAttributesCountOrNil(wantAttributesCount)
-		case stakingtypes.EventTypeUnbond:
-			const wantAttributesCount = 4
-			if len(event.Attributes) != wantAttributesCount {
-				return nil
-			}
-			return newNormalizedEvent(
-				stakingtypes.AttributeKeyValidator, stakingtypes.AttributeKeyDelegator, sdk.AttributeKeyAmount, stakingtypes.AttributeKeyCompletionTime,
-			).requireAttributesCountOrNil(wantAttributesCount)
-		case stakingtypes.EventTypeRedelegate:
-			const wantAttributesCount = 4
-			if len(event.Attributes) != wantAttributesCount {
-				return nil
-			}
-			return newNormalizedEvent(
-				stakingtypes.AttributeKeySrcValidator, stakingtypes.AttributeKeyDstValidator, sdk.AttributeKeyAmount, stakingtypes.AttributeKeyCompletionTime,
-			).requireAttributesCountOrNil(wantAttributesCount)
-		case disttypes.EventTypeWithdrawRewards:
-			const wantAttributesCount = 3
-			if len(event.Attributes) != wantAttributesCount {
-				return nil
-			}
-			return newNormalizedEvent(
-				sdk.AttributeKeyAmount, disttypes.AttributeKeyValidator, disttypes.AttributeKeyDelegator,
-			).requireAttributesCountOrNil(wantAttributesCount)
-		default:
-			return nil
-		}
-	})
+		evmEvent: "Undelegate",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromAccAddress(stakingtypes.AttributeKeyDelegator),
+			sdkEventArgFromValAddress(stakingtypes.AttributeKeyValidator),
+			sdkEventArgFromBondDenomAmount(sdk.AttributeKeyAmount),
+		},
+	},
+	// redelegation is represented as undelegate from the source validator then delegate to the destination validator
+	{
+		sdkEventType: stakingtypes.EventTypeRedelegate,
+		sdkEventAttributes: []string{
+			stakingtypes.AttributeKeySrcValidator, stakingtypes.AttributeKeyDstValidator, sdk.AttributeKeyAmount, stakingtypes.AttributeKeyCompletionTime,
+		},
+		evmEvent: "Undelegate",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromActor(),
+			sdkEventArgFromValAddress(stakingtypes.AttributeKeySrcValidator),
+			sdkEventArgFromBondDenomAmount(sdk.AttributeKeyAmount),
+		},
+	},
+	{
+		sdkEventType: stakingtypes.EventTypeRedelegate,
+		sdkEventAttributes: []string{
+			stakingtypes.AttributeKeySrcValidator, stakingtypes.AttributeKeyDstValidator, sdk.AttributeKeyAmount, stakingtypes.AttributeKeyCompletionTime,
+		},
+		evmEvent: "Delegate",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromActor(),
+			sdkEventArgFromValAddress(stakingtypes.AttributeKeyDstValidator),
+			sdkEventArgFromBondDenomAmount(sdk.AttributeKeyAmount),
+		},
+	},
+	{
+		sdkEventType: disttypes.EventTypeWithdrawRewards,
+		sdkEventAttributes: []string{
+			sdk.AttributeKeyAmount, disttypes.AttributeKeyValidator, disttypes.AttributeKeyDelegator,
+		},
+		evmEvent: "WithdrawReward",
+		evmEventArgs: []sdkEventArg{
+			sdkEventArgFromAccAddress(disttypes.AttributeKeyDelegator),
+			sdkEventArgFromValAddress(disttypes.AttributeKeyValidator),
+			sdkEventArgFromBondDenomAmount(sdk.AttributeKeyAmount),
+		},
+	},
 }
 
 // name()
@@ -714,7 +586,7 @@ func (e stakingCustomPrecompiledContractRwDelegate) Execute(caller corevm.Contra
 		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "delegate amount must be positive")
 	}
 
-	originalStakingEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+	originalStakingEventsCount := e.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
 
 	if err := e.delegate(
 		ctx,
@@ -724,7 +596,7 @@ func (e stakingCustomPrecompiledContractRwDelegate) Execute(caller corevm.Contra
 		return nil, err
 	}
 
-	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator, env); err != nil {
+	if err := e.contract.sdkEventsBridge.emitEvmLogs(originalStakingEventsCount, common.BytesToAddress(delegator), env); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to emit events")
 	}
 
@@ -797,7 +669,7 @@ func (e stakingCustomPrecompiledContractRwUnDelegate) Execute(caller corevm.Cont
 		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "undelegate amount must be positive")
 	}
 
-	originalStakingEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+	originalStakingEventsCount := e.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
 
 	if err := e.undelegate(
 		ctx,
@@ -807,7 +679,7 @@ func (e stakingCustomPrecompiledContractRwUnDelegate) Execute(caller corevm.Cont
 		return nil, err
 	}
 
-	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator, env); err != nil {
+	if err := e.contract.sdkEventsBridge.emitEvmLogs(originalStakingEventsCount, common.BytesToAddress(delegator), env); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to emit events")
 	}
 
@@ -881,7 +753,7 @@ func (e stakingCustomPrecompiledContractRwReDelegate) Execute(caller corevm.Cont
 		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "redelegate amount must be positive")
 	}
 
-	originalStakingEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+	originalStakingEventsCount := e.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
 
 	if err := e.redelegate(
 		ctx,
@@ -892,7 +764,7 @@ func (e stakingCustomPrecompiledContractRwReDelegate) Execute(caller corevm.Cont
 		return nil, err
 	}
 
-	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator, env); err != nil {
+	if err := e.contract.sdkEventsBridge.emitEvmLogs(originalStakingEventsCount, common.BytesToAddress(delegator), env); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to emit events")
 	}
 
@@ -995,7 +867,7 @@ func (e stakingCustomPrecompiledContractRwDelegateByActionMessage) Execute(calle
 		return nil, fmt.Errorf("signature does not match, got: %s", recoveredAddr.String())
 	}
 
-	originalStakingEventsCount := len(e.delegate.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+	originalStakingEventsCount := e.delegate.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
 
 	switch delegateMessage.Action {
 	case abi.StakingMessageActionDelegate:
@@ -1030,7 +902,7 @@ func (e stakingCustomPrecompiledContractRwDelegateByActionMessage) Execute(calle
 		}
 	}
 
-	if err := e.delegate.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator.Bytes(), env); err != nil {
+	if err := e.delegate.contract.sdkEventsBridge.emitEvmLogs(originalStakingEventsCount, delegator, env); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to emit events")
 	}
 
@@ -1068,13 +940,13 @@ func (e stakingCustomPrecompiledContractRwWithdrawReward) Execute(caller corevm.
 	delegator := sdk.AccAddress(caller.Address().Bytes())
 	valAddr := ips[0].(common.Address)
 
-	originalStakingEventsCount := len(e.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+	originalStakingEventsCount := e.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
 
 	if err := e.withdrawReward(ctx, delegator, valAddr.Bytes()); err != nil {
 		return nil, err
 	}
 
-	if err := e.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator, env); err != nil {
+	if err := e.contract.sdkEventsBridge.emitEvmLogs(originalStakingEventsCount, common.BytesToAddress(delegator), env); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to emit events")
 	}
 
@@ -1137,14 +1009,14 @@ func (e stakingCustomPrecompiledContractRwWithdrawRewards) Execute(caller corevm
 
 	delegator := sdk.AccAddress(caller.Address().Bytes())
 
-	originalStakingEventsCount := len(e.withdrawReward.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+	originalStakingEventsCount := e.withdrawReward.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
 
 	any, err := e.withdrawRewards(ctx, delegator)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := e.withdrawReward.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator, env); err != nil {
+	if err := e.withdrawReward.contract.sdkEventsBridge.emitEvmLogs(originalStakingEventsCount, common.BytesToAddress(delegator), env); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to emit events")
 	}
 
@@ -1250,7 +1122,7 @@ func (e stakingCustomPrecompiledContractRwWithdrawRewardsByMessage) Execute(call
 		return nil, fmt.Errorf("signature does not match, got: %s", recoveredAddr.String())
 	}
 
-	originalStakingEventsCount := len(e.withdrawReward.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+	originalStakingEventsCount := e.withdrawReward.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
 
 	var any bool
 
@@ -1266,7 +1138,7 @@ func (e stakingCustomPrecompiledContractRwWithdrawRewardsByMessage) Execute(call
 		any = true
 	}
 
-	if err := e.withdrawReward.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, delegator.Bytes(), env); err != nil {
+	if err := e.withdrawReward.contract.sdkEventsBridge.emitEvmLogs(originalStakingEventsCount, delegator, env); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to emit events")
 	}
 
@@ -1371,7 +1243,7 @@ func (e stakingCustomPrecompiledContractRwTransfer) Execute(caller corevm.Contra
 		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "delegation amount must be positive")
 	}
 
-	originalStakingEventsCount := len(e.delegate.contract.getSdkEventsFromEventManager(ctx.EventManager()))
+	originalStakingEventsCount := e.delegate.contract.sdkEventsBridge.countTrackedEvents(ctx.EventManager())
 	// withdraw rewards
 	if _, err := e.withdrawRewards.withdrawRewards(ctx, caller.Address().Bytes()); err != nil {
 		return nil, err
@@ -1461,7 +1333,7 @@ func (e stakingCustomPrecompiledContractRwTransfer) Execute(caller corevm.Contra
 		return nil, err
 	}
 
-	if err := e.delegate.contract.autoEmitEventsFromSdkEvents(ctx.EventManager(), originalStakingEventsCount, from.Bytes(), env); err != nil {
+	if err := e.delegate.contract.sdkEventsBridge.emitEvmLogs(originalStakingEventsCount, from, env); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to emit events")
 	}
 
@@ -1,17 +1,25 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/EscanBE/evermint/constants"
+	"github.com/EscanBE/evermint/x/cpc/abi"
 	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 )
 
 func (suite *CpcTestSuite) TestKeeper_GetSetHasCustomPrecompiledContractMeta() {
@@ -178,6 +186,23 @@ func (suite *CpcTestSuite) TestKeeper_GetNextDynamicCustomPrecompiledContractAdd
 	}
 }
 
+func (suite *CpcTestSuite) TestKeeper_GetSetModuleAccountNonce() {
+	nonce := suite.App().CpcKeeper().GetModuleAccountNonce(suite.Ctx())
+
+	suite.Run("pass - can set nonce forward", func() {
+		err := suite.App().CpcKeeper().SetModuleAccountNonce(suite.Ctx(), nonce+10)
+		suite.Require().NoError(err)
+		suite.Equal(nonce+10, suite.App().CpcKeeper().GetModuleAccountNonce(suite.Ctx()))
+		suite.Equal(crypto.CreateAddress(cpctypes.CpcModuleAddress, nonce+10), suite.App().CpcKeeper().GetNextDynamicCustomPrecompiledContractAddress(suite.Ctx()))
+	})
+
+	suite.Run("fail - can not set nonce backward", func() {
+		err := suite.App().CpcKeeper().SetModuleAccountNonce(suite.Ctx(), nonce)
+		suite.Require().ErrorContains(err, "nonce cannot go backward")
+		suite.Equal(nonce+11, suite.App().CpcKeeper().GetModuleAccountNonce(suite.Ctx()))
+	})
+}
+
 func (suite *CpcTestSuite) TestKeeper_GetErc20CustomPrecompiledContractAddressByMinDenom() {
 	var moduleNonce uint64
 
@@ -210,6 +235,356 @@ func (suite *CpcTestSuite) TestKeeper_GetErc20CustomPrecompiledContractAddressBy
 	}
 }
 
+func (suite *CpcTestSuite) TestKeeper_SetCustomPrecompiledContractDisabled() {
+	contractAddr := cpctypes.CpcBech32FixedAddress
+	input := get4BytesSignature("bech32AccountAddrPrefix()")
+
+	suite.Run("pass - contract is callable while enabled", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, contractAddr, input)
+		suite.Require().NoError(err)
+		suite.Empty(res.VmError)
+	})
+
+	suite.Run("fail - reject enable when contract is not disabled", func() {
+		err := suite.App().CpcKeeper().SetCustomPrecompiledContractDisabled(suite.Ctx(), contractAddr, false)
+		suite.Require().ErrorContains(err, "contract is not disabled")
+	})
+
+	suite.Run("fail - reject when contract does not exist", func() {
+		err := suite.App().CpcKeeper().SetCustomPrecompiledContractDisabled(suite.Ctx(), common.BytesToAddress([]byte("non-exists")), true)
+		suite.Require().ErrorContains(err, "contract does not exist by address")
+	})
+
+	suite.Run("pass - can disable", func() {
+		ctx := suite.Ctx().WithEventManager(sdk.NewEventManager())
+		err := suite.App().CpcKeeper().SetCustomPrecompiledContractDisabled(ctx, contractAddr, true)
+		suite.Require().NoError(err)
+
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), contractAddr)
+		suite.Require().NotNil(meta)
+		suite.True(meta.Disabled)
+
+		var foundEvent bool
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == cpctypes.EventTypeCustomPrecompiledContractDisabled {
+				foundEvent = true
+				break
+			}
+		}
+		suite.True(foundEvent, "missing event")
+	})
+
+	suite.Run("fail - reject disable when contract is already disabled", func() {
+		err := suite.App().CpcKeeper().SetCustomPrecompiledContractDisabled(suite.Ctx(), contractAddr, true)
+		suite.Require().ErrorContains(err, "contract is already disabled")
+	})
+
+	suite.Run("pass - call to disabled contract is reverted", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, contractAddr, input)
+		suite.Require().NoError(err)
+		suite.NotEmpty(res.VmError)
+		suite.Empty(res.Ret)
+	})
+
+	suite.Run("pass - can re-enable", func() {
+		err := suite.App().CpcKeeper().SetCustomPrecompiledContractDisabled(suite.Ctx(), contractAddr, false)
+		suite.Require().NoError(err)
+
+		res, err := suite.EthCallApply(suite.Ctx(), nil, contractAddr, input)
+		suite.Require().NoError(err)
+		suite.Empty(res.VmError)
+	})
+}
+
+func (suite *CpcTestSuite) TestCustomPrecompiledContractMethodExecutor_CaptureCpcCall() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+
+	sender := account1.GetEthAddress()
+	receiver := account2.GetEthAddress()
+
+	traceBankSend := func(ctx sdk.Context, coins []abi.BankCoin) (*evmtypes.MsgEthereumTxResponse, json.RawMessage) {
+		method := abi.BankCpcInfo.ABI.Methods["send"]
+		args, err := method.Inputs.Pack(receiver, coins)
+		suite.Require().NoError(err)
+		input := append(append([]byte{}, method.ID...), args...)
+
+		baseFee := suite.App().EvmKeeper().GetBaseFee(ctx).BigInt()
+		txArgs := evmtypes.TransactionArgs{
+			From:     &sender,
+			To:       &cpctypes.CpcBankFixedAddress,
+			Data:     (*hexutil.Bytes)(&input),
+			GasPrice: (*hexutil.Big)(baseFee),
+		}
+		msg, err := txArgs.ToMessage(0, baseFee)
+		suite.Require().NoError(err)
+
+		callTracer, err := tracers.New(evmtypes.TracerCall, &tracers.Context{}, nil)
+		suite.Require().NoError(err)
+		tracer := evmtypes.NewCpcCallTracer(callTracer)
+
+		res, err := suite.App().EvmKeeper().ApplyMessage(ctx, msg, tracer, true)
+		suite.Require().NoError(err)
+
+		result, err := tracer.GetResult()
+		suite.Require().NoError(err)
+
+		return res, result
+	}
+
+	type cosmosEvent struct {
+		Type       string `json:"type"`
+		Attributes []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"attributes"`
+	}
+	type callFrame struct {
+		Type         string         `json:"type"`
+		From         common.Address `json:"from"`
+		To           common.Address `json:"to"`
+		Gas          hexutil.Uint64 `json:"gas"`
+		GasUsed      hexutil.Uint64 `json:"gasUsed"`
+		Error        string         `json:"error"`
+		Method       string         `json:"method"`
+		Args         map[string]any `json:"args"`
+		CosmosEvents []cosmosEvent  `json:"cosmosEvents"`
+		Calls        []callFrame    `json:"calls"`
+	}
+
+	suite.Run("pass - decoded call with SDK events is nested into the call frame", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		res, result := traceBankSend(ctx, []abi.BankCoin{{Denom: suite.bondDenom(ctx), Amount: big.NewInt(100)}})
+		suite.Require().Empty(res.VmError)
+
+		var root callFrame
+		suite.Require().NoError(json.Unmarshal(result, &root))
+		suite.Equal("CALL", root.Type)
+		suite.Require().Len(root.Calls, 1)
+
+		cpcFrame := root.Calls[0]
+		suite.Equal(evmtypes.CpcCallFrameType, cpcFrame.Type)
+		suite.Equal(sender, cpcFrame.From)
+		suite.Equal(cpctypes.CpcBankFixedAddress, cpcFrame.To)
+		suite.Equal("send(address,(string,uint256)[])", cpcFrame.Method)
+		suite.Equal(strings.ToLower(receiver.Hex()), strings.ToLower(cpcFrame.Args["to"].(string)))
+		suite.NotZero(cpcFrame.GasUsed)
+		suite.Equal(root.Gas, cpcFrame.Gas, "gas provided to the contract")
+		suite.Empty(cpcFrame.Error)
+
+		var foundTransferEvent bool
+		for _, event := range cpcFrame.CosmosEvents {
+			if event.Type == banktypes.EventTypeTransfer {
+				foundTransferEvent = true
+				break
+			}
+		}
+		suite.True(foundTransferEvent, "expect SDK event transfer")
+	})
+
+	suite.Run("pass - failed call is reported with error", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		res, result := traceBankSend(ctx, []abi.BankCoin{})
+		suite.Require().NotEmpty(res.VmError)
+
+		var root callFrame
+		suite.Require().NoError(json.Unmarshal(result, &root))
+		suite.Require().Len(root.Calls, 1)
+
+		cpcFrame := root.Calls[0]
+		suite.Equal(evmtypes.CpcCallFrameType, cpcFrame.Type)
+		suite.Contains(cpcFrame.Error, "coins cannot be empty")
+		suite.Empty(cpcFrame.CosmosEvents)
+	})
+}
+
+func (suite *CpcTestSuite) TestCustomPrecompiledContractMethodExecutor_Gas() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	account2 := suite.CITS.WalletAccounts.Number(2)
+
+	sender := account1.GetEthAddress()
+	receiver := account2.GetEthAddress()
+
+	method := abi.BankCpcInfo.ABI.Methods["send"]
+	methodSelector := "0x" + hex.EncodeToString(method.ID)
+
+	setParams := func(ctx sdk.Context, dynamicGasEnabled bool, methodGas uint64) {
+		params := suite.App().CpcKeeper().GetParams(ctx)
+		params.DynamicGasEnabled = dynamicGasEnabled
+		params.MethodGasTable = nil
+		if methodGas > 0 {
+			params.MethodGasTable = []cpctypes.CpcMethodGas{
+				{ContractType: cpctypes.CpcTypeBank, MethodSelector: methodSelector, Gas: methodGas},
+			}
+		}
+		suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+	}
+
+	bankSend := func(ctx sdk.Context, gas uint64) *evmtypes.MsgEthereumTxResponse {
+		args, err := method.Inputs.Pack(receiver, []abi.BankCoin{{Denom: suite.bondDenom(ctx), Amount: big.NewInt(100)}})
+		suite.Require().NoError(err)
+		input := append(append([]byte{}, method.ID...), args...)
+
+		baseFee := suite.App().EvmKeeper().GetBaseFee(ctx).BigInt()
+		txArgs := evmtypes.TransactionArgs{
+			From:     &sender,
+			To:       &cpctypes.CpcBankFixedAddress,
+			Data:     (*hexutil.Bytes)(&input),
+			GasPrice: (*hexutil.Big)(baseFee),
+		}
+		if gas > 0 {
+			txArgs.Gas = (*hexutil.Uint64)(&gas)
+		}
+		msg, err := txArgs.ToMessage(0, baseFee)
+		suite.Require().NoError(err)
+
+		res, err := suite.App().EvmKeeper().ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	suite.Run("pass - gas table overrides the gas required by the method", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		setParams(ctx, false, 100_000)
+		res1 := bankSend(ctx, 0)
+		suite.Require().Empty(res1.VmError)
+
+		setParams(ctx, false, 200_000)
+		res2 := bankSend(ctx, 0)
+		suite.Require().Empty(res2.VmError)
+
+		suite.Equal(uint64(100_000), res2.GasUsed-res1.GasUsed)
+	})
+
+	suite.Run("pass - SDK gas consumed exceeds the gas required by the method is charged", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		setParams(ctx, false, 1)
+		resStatic := bankSend(ctx, 0)
+		suite.Require().Empty(resStatic.VmError)
+
+		setParams(ctx, true, 1)
+		resDynamic := bankSend(ctx, 0)
+		suite.Require().Empty(resDynamic.VmError)
+
+		suite.Greater(resDynamic.GasUsed, resStatic.GasUsed)
+	})
+
+	suite.Run("pass - SDK gas consumed within the gas required by the method is not charged", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		setParams(ctx, false, 10_000_000)
+		resStatic := bankSend(ctx, 0)
+		suite.Require().Empty(resStatic.VmError)
+
+		setParams(ctx, true, 10_000_000)
+		resDynamic := bankSend(ctx, 0)
+		suite.Require().Empty(resDynamic.VmError)
+
+		suite.Equal(resStatic.GasUsed, resDynamic.GasUsed)
+	})
+
+	suite.Run("fail - out of gas when remaining gas is not enough for the dynamic gas, state changes are reverted", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		setParams(ctx, false, 1)
+		resStatic := bankSend(ctx, 0)
+		suite.Require().Empty(resStatic.VmError)
+
+		ctx, _ = suite.Ctx().CacheContext()
+		setParams(ctx, true, 1)
+		receiverBalance := suite.App().BankKeeper().GetBalance(ctx, account2.GetCosmosAddress(), suite.bondDenom(ctx))
+
+		res := bankSend(ctx, resStatic.GasUsed)
+		suite.Equal(corevm.ErrOutOfGas.Error(), res.VmError)
+		suite.Equal(resStatic.GasUsed, res.GasUsed)
+
+		suite.Equal(receiverBalance.String(), suite.App().BankKeeper().GetBalance(ctx, account2.GetCosmosAddress(), suite.bondDenom(ctx)).String())
+	})
+}
+
+func (suite *CpcTestSuite) TestCustomPrecompiledContractMethodExecutor_GasLoopOfCalls() {
+	account1 := suite.CITS.WalletAccounts.Number(1)
+	sender := account1.GetEthAddress()
+
+	method := abi.BankCpcInfo.ABI.Methods["balances"]
+	args, err := method.Inputs.Pack(sender)
+	suite.Require().NoError(err)
+	input := append(append([]byte{}, method.ID...), args...)
+
+	// loopCode forwards the call data to the bank CPC in an infinite loop, ignoring the result
+	loopCode := append(append(
+		common.FromHex("0x5b3660006000376000600036600073"), // JUMPDEST, CALLDATACOPY(0, 0, CALLDATASIZE), args of STATICCALL, PUSH20
+		cpctypes.CpcBankFixedAddress.Bytes()...),
+		common.FromHex("0x5afa50600056")..., // GAS, STATICCALL, POP, JUMP(0)
+	)
+
+	const txGasLimit uint64 = 1_000_000
+
+	ctx, _ := suite.Ctx().CacheContext()
+
+	params := suite.App().CpcKeeper().GetParams(ctx)
+	params.DynamicGasEnabled = true
+	params.MethodGasTable = []cpctypes.CpcMethodGas{
+		{ContractType: cpctypes.CpcTypeBank, MethodSelector: "0x" + hex.EncodeToString(method.ID), Gas: 1},
+	}
+	suite.Require().NoError(suite.App().CpcKeeper().SetParams(ctx, params))
+
+	loopContract := common.BytesToAddress(crypto.Keccak256(loopCode)[:20])
+	accountKeeper := suite.App().AccountKeeper()
+	accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, loopContract.Bytes()))
+	codeHash := crypto.Keccak256Hash(loopCode)
+	suite.App().EvmKeeper().SetCode(ctx, codeHash.Bytes(), loopCode)
+	suite.App().EvmKeeper().SetCodeHash(ctx, loopContract, codeHash)
+
+	gas := txGasLimit
+	baseFee := suite.App().EvmKeeper().GetBaseFee(ctx).BigInt()
+	txArgs := evmtypes.TransactionArgs{
+		From:     &sender,
+		To:       &loopContract,
+		Data:     (*hexutil.Bytes)(&input),
+		Gas:      (*hexutil.Uint64)(&gas),
+		GasPrice: (*hexutil.Big)(baseFee),
+	}
+	msg, err := txArgs.ToMessage(0, baseFee)
+	suite.Require().NoError(err)
+
+	tracer := &cpcCallCollectorTracer{}
+	res, err := suite.App().EvmKeeper().ApplyMessage(ctx, msg, tracer, true)
+	suite.Require().NoError(err)
+
+	suite.Equal(corevm.ErrOutOfGas.Error(), res.VmError)
+	suite.Equal(txGasLimit, res.GasUsed)
+
+	suite.Require().NotEmpty(tracer.frames)
+	var sdkGasConsumed uint64
+	var outOfGasCalls int
+	for _, frame := range tracer.frames {
+		sdkGasConsumed += frame.GasUsed
+		if frame.Err != nil && strings.Contains(frame.Err.Error(), "out of gas") {
+			outOfGasCalls++
+		}
+	}
+	suite.NotZero(outOfGasCalls, "expect the calls to be aborted when the gas limit of the tx is reached")
+	// each call is charged the static gas of 1, regardless of the remaining gas
+	suite.LessOrEqual(sdkGasConsumed, txGasLimit+uint64(len(tracer.frames)), "SDK work must be bounded by the gas limit of the tx")
+}
+
+var _ cpctypes.CpcCallTracer = &cpcCallCollectorTracer{}
+
+// cpcCallCollectorTracer collects the calls into the custom precompiled contracts.
+type cpcCallCollectorTracer struct {
+	evmtypes.NoOpTracer
+	frames []cpctypes.CpcCallFrame
+}
+
+func (t *cpcCallCollectorTracer) CaptureCpcCall(frame cpctypes.CpcCallFrame) {
+	t.frames = append(t.frames, frame)
+}
+
 // simpleBuildContractInput is a helper function to build contract input for testing.
 // Each args is expected to take 32 bytes.
 func simpleBuildContractInput(sig []byte, args ...any) []byte {
@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
+
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DeployVAuthCustomPrecompiledContract deploys a new vauth custom precompiled contract.
+func (k Keeper) DeployVAuthCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcVAuthFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeVAuth,
+		Name:                  "VAuth - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &vauthCustomPrecompiledContract{}
+
+// vauthCustomPrecompiledContract is a contract that can be used to query, submit and revoke proof of external owned account (EOA) of `x/vauth` module.
+type vauthCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewVAuthCustomPrecompiledContract creates a new vauth custom precompiled contract.
+func NewVAuthCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &vauthCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&vauthCustomPrecompiledContractRoName{contract: contract},
+		&vauthCustomPrecompiledContractRoHasProofExternalOwnedAccount{contract: contract},
+		&vauthCustomPrecompiledContractRoProofOf{contract: contract},
+		&vauthCustomPrecompiledContractRwSubmitProofExternalOwnedAccount{contract: contract},
+		&vauthCustomPrecompiledContractRwRevokeProofExternalOwnedAccount{contract: contract},
+	}
+
+	return contract
+}
+
+func (m vauthCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m vauthCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vauthCustomPrecompiledContractRoName{}
+
+type vauthCustomPrecompiledContractRoName struct {
+	contract *vauthCustomPrecompiledContract
+}
+
+func (e vauthCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.VAuthCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.VAuthCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e vauthCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e vauthCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e vauthCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// hasProofExternalOwnedAccount(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vauthCustomPrecompiledContractRoHasProofExternalOwnedAccount{}
+
+type vauthCustomPrecompiledContractRoHasProofExternalOwnedAccount struct {
+	contract *vauthCustomPrecompiledContract
+}
+
+func (e vauthCustomPrecompiledContractRoHasProofExternalOwnedAccount) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VAuthCpcInfo.UnpackMethodInput("hasProofExternalOwnedAccount", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := ips[0].(common.Address)
+
+	hasProof := e.contract.keeper.vauthKeeper.HasProofExternalOwnedAccount(env.ctx, account.Bytes())
+
+	return abi.VAuthCpcInfo.PackMethodOutput("hasProofExternalOwnedAccount", hasProof)
+}
+
+func (e vauthCustomPrecompiledContractRoHasProofExternalOwnedAccount) Method4BytesSignatures() []byte {
+	return []byte{0x81, 0xd7, 0x76, 0xb9}
+}
+
+func (e vauthCustomPrecompiledContractRoHasProofExternalOwnedAccount) RequireGas() uint64 {
+	return 1000
+}
+
+func (e vauthCustomPrecompiledContractRoHasProofExternalOwnedAccount) ReadOnly() bool {
+	return true
+}
+
+// proofOf(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vauthCustomPrecompiledContractRoProofOf{}
+
+type vauthCustomPrecompiledContractRoProofOf struct {
+	contract *vauthCustomPrecompiledContract
+}
+
+func (e vauthCustomPrecompiledContractRoProofOf) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VAuthCpcInfo.UnpackMethodInput("proofOf", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := ips[0].(common.Address)
+
+	proof := e.contract.keeper.vauthKeeper.GetProofExternalOwnedAccount(env.ctx, account.Bytes())
+	if proof == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "proof not found for account: %s", account.Hex())
+	}
+
+	signature, err := hex.DecodeString(proof.Signature[2:])
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to decode signature: %s", err.Error())
+	}
+
+	return abi.VAuthCpcInfo.PackMethodOutput("proofOf", common.HexToHash(proof.Hash), signature)
+}
+
+func (e vauthCustomPrecompiledContractRoProofOf) Method4BytesSignatures() []byte {
+	return []byte{0xa4, 0x6a, 0xa1, 0xed}
+}
+
+func (e vauthCustomPrecompiledContractRoProofOf) RequireGas() uint64 {
+	return 5000
+}
+
+func (e vauthCustomPrecompiledContractRoProofOf) ReadOnly() bool {
+	return true
+}
+
+// submitProofExternalOwnedAccount(address,bytes)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vauthCustomPrecompiledContractRwSubmitProofExternalOwnedAccount{}
+
+type vauthCustomPrecompiledContractRwSubmitProofExternalOwnedAccount struct {
+	contract *vauthCustomPrecompiledContract
+}
+
+func (e vauthCustomPrecompiledContractRwSubmitProofExternalOwnedAccount) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VAuthCpcInfo.UnpackMethodInput("submitProofExternalOwnedAccount", input)
+	if err != nil {
+		return nil, err
+	}
+
+	submitter := caller.Address()
+	account := ips[0].(common.Address)
+	signature := ips[1].([]byte)
+
+	if account == (common.Address{}) {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "account cannot be the zero address")
+	}
+
+	msg := &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+		Submitter: sdk.AccAddress(submitter.Bytes()).String(),
+		Account:   sdk.AccAddress(account.Bytes()).String(),
+		Signature: "0x" + hex.EncodeToString(signature),
+	}
+
+	// same validation and fee as the Cosmos message
+	if err := e.contract.keeper.vauthKeeper.ProcessSubmitProofExternalOwnedAccount(env.ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := addEvmLog(env.evm.StateDB, cpctypes.CpcVAuthFixedAddress, abi.VAuthCpcInfo, "SubmitProofExternalOwnedAccount", submitter, account); err != nil {
+		return nil, err
+	}
+
+	return abi.VAuthCpcInfo.PackMethodOutput("submitProofExternalOwnedAccount", true)
+}
+
+func (e vauthCustomPrecompiledContractRwSubmitProofExternalOwnedAccount) Method4BytesSignatures() []byte {
+	return []byte{0xca, 0x33, 0x78, 0x0d}
+}
+
+func (e vauthCustomPrecompiledContractRwSubmitProofExternalOwnedAccount) RequireGas() uint64 {
+	return 50_000
+}
+
+func (e vauthCustomPrecompiledContractRwSubmitProofExternalOwnedAccount) ReadOnly() bool {
+	return false
+}
+
+// revokeProofExternalOwnedAccount()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vauthCustomPrecompiledContractRwRevokeProofExternalOwnedAccount{}
+
+type vauthCustomPrecompiledContractRwRevokeProofExternalOwnedAccount struct {
+	contract *vauthCustomPrecompiledContract
+}
+
+func (e vauthCustomPrecompiledContractRwRevokeProofExternalOwnedAccount) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.VAuthCpcInfo.UnpackMethodInput("revokeProofExternalOwnedAccount", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := caller.Address()
+
+	msg := &vauthtypes.MsgRevokeProofExternalOwnedAccount{
+		Account: sdk.AccAddress(account.Bytes()).String(),
+	}
+
+	// same validation as the Cosmos message, the caller is the signer
+	if err := e.contract.keeper.vauthKeeper.ProcessRevokeProofExternalOwnedAccount(env.ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := addEvmLog(env.evm.StateDB, cpctypes.CpcVAuthFixedAddress, abi.VAuthCpcInfo, "RevokeProofExternalOwnedAccount", account); err != nil {
+		return nil, err
+	}
+
+	return abi.VAuthCpcInfo.PackMethodOutput("revokeProofExternalOwnedAccount", true)
+}
+
+func (e vauthCustomPrecompiledContractRwRevokeProofExternalOwnedAccount) Method4BytesSignatures() []byte {
+	return []byte{0x13, 0x0b, 0x09, 0x7e}
+}
+
+func (e vauthCustomPrecompiledContractRwRevokeProofExternalOwnedAccount) RequireGas() uint64 {
+	return 20_000
+}
+
+func (e vauthCustomPrecompiledContractRwRevokeProofExternalOwnedAccount) ReadOnly() bool {
+	return false
+}
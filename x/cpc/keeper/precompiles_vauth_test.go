@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/EscanBE/evermint/rename_chain/marker"
+	"github.com/EscanBE/evermint/x/cpc/abi"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	cpcutils "github.com/EscanBE/evermint/x/cpc/utils"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	topic0SubmitProofExternalOwnedAccount = "0xa28d4f7b9a2b6f0dfdc24991e0209dbea1332dc76e02b180bf157e97b618243a"
+	topic0RevokeProofExternalOwnedAccount = "0x6904a5fe18bfc43fda242cf9a0a7b0eac767a7a5ff48c27756fa0ced939fa02c"
+)
+
+func (suite *CpcTestSuite) TestKeeper_DeployVAuthCustomPrecompiledContract() {
+	suite.Run("pass - deployed at genesis", func() {
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcVAuthFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeVAuth, meta.CustomPrecompiledType)
+		suite.Equal(cpctypes.EmptyTypedMeta, meta.TypedMeta)
+		suite.False(meta.Disabled)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcVAuthFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.True(found)
+	})
+
+	suite.Run("fail - can not deploy twice", func() {
+		_, err := suite.App().CpcKeeper().DeployVAuthCustomPrecompiledContract(suite.Ctx())
+		suite.Require().ErrorContains(err, "contract address is being in use")
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Topic0_VAuth() {
+	suite.Equal(common.HexToHash(topic0SubmitProofExternalOwnedAccount), abi.VAuthCpcInfo.ABI.Events["SubmitProofExternalOwnedAccount"].ID)
+	suite.Equal(common.HexToHash(topic0RevokeProofExternalOwnedAccount), abi.VAuthCpcInfo.ABI.Events["RevokeProofExternalOwnedAccount"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_VAuthCustomPrecompiledContract() {
+	submitter := suite.CITS.WalletAccounts.Number(1)
+
+	//goland:noinspection SpellCheckingInspection
+	account := common.BytesToAddress(sdk.MustAccAddressFromBech32(marker.ReplaceAbleAddress("evm1xx2enpw8wzlr64xkdz2gh3c7epucfdftnqtcem")))
+	signature, err := hex.DecodeString("e665110439b1d18002ef866285f7e532090065ad74274560db5e8373d0cdb6297afefc70a5dd46c23e74bd3f0f262195f089b2923242a14e8e0791f4b0621a2c00")
+	suite.Require().NoError(err)
+
+	buildInput := func(methodName string, args ...any) []byte {
+		method := abi.VAuthCpcInfo.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		suite.Require().NoError(err)
+		return append(append([]byte{}, method.ID...), input...)
+	}
+
+	evmDenom := suite.App().EvmKeeper().GetParams(suite.Ctx()).EvmDenom
+
+	suite.Run("name()", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcVAuthFixedAddress, buildInput("name"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotName, err := cpcutils.AbiDecodeString(res.Ret)
+		suite.Require().NoError(err)
+		suite.Equal("VAuth - Precompiled Contract", gotName)
+	})
+
+	suite.Run("hasProofExternalOwnedAccount(address) & proofOf(address) when not proven", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		res, err := suite.EthCallApply(ctx, nil, cpctypes.CpcVAuthFixedAddress, buildInput("hasProofExternalOwnedAccount", account))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotHasProof, err := cpcutils.AbiDecodeBool(res.Ret)
+		suite.Require().NoError(err)
+		suite.False(gotHasProof)
+
+		res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVAuthFixedAddress, buildInput("proofOf", account))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "proof not found for account")
+	})
+
+	suite.Run("submitProofExternalOwnedAccount(address,bytes)", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		fee := suite.App().VAuthKeeper().GetParams(ctx).SubmitProofFee
+		suite.Require().Equal(evmDenom, fee.Denom)
+		suite.Require().True(fee.IsPositive())
+
+		balanceBefore := suite.App().BankKeeper().GetBalance(ctx, submitter.GetCosmosAddress(), evmDenom)
+		suite.Require().True(balanceBefore.Amount.GTE(fee.Amount))
+		supplyBefore := suite.App().BankKeeper().GetSupply(ctx, evmDenom)
+
+		res, err := suite.EthCallApply(ctx, submitter.GetEthAddressP(), cpctypes.CpcVAuthFixedAddress, buildInput("submitProofExternalOwnedAccount", account, signature))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+		suite.Require().NoError(err)
+		suite.True(gotSuccess)
+
+		suite.True(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, account.Bytes()))
+
+		// fee is charged from submitter then burned, same as the Cosmos message
+		balanceAfter := suite.App().BankKeeper().GetBalance(ctx, submitter.GetCosmosAddress(), evmDenom)
+		suite.Equal(
+			balanceBefore.Amount.Sub(fee.Amount).String(),
+			balanceAfter.Amount.String(),
+		)
+		supplyAfter := suite.App().BankKeeper().GetSupply(ctx, evmDenom)
+		suite.Equal(
+			supplyBefore.Amount.Sub(fee.Amount).String(),
+			supplyAfter.Amount.String(),
+		)
+
+		var receipt ethtypes.Receipt
+		err = receipt.UnmarshalBinary(res.MarshalledReceipt)
+		suite.Require().NoError(err)
+		suite.Require().Len(receipt.Logs, 1, "expect event SubmitProofExternalOwnedAccount")
+		log := receipt.Logs[0]
+		suite.Equal(cpctypes.CpcVAuthFixedAddress, log.Address)
+		suite.Require().Len(log.Topics, 3)
+		suite.Equal(topic0SubmitProofExternalOwnedAccount, log.Topics[0].String())
+		suite.Equal(submitter.GetEthAddress(), common.BytesToAddress(log.Topics[1].Bytes()))
+		suite.Equal(account, common.BytesToAddress(log.Topics[2].Bytes()))
+
+		res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVAuthFixedAddress, buildInput("hasProofExternalOwnedAccount", account))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotHasProof, err := cpcutils.AbiDecodeBool(res.Ret)
+		suite.Require().NoError(err)
+		suite.True(gotHasProof)
+
+		res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVAuthFixedAddress, buildInput("proofOf", account))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		outputs, err := abi.VAuthCpcInfo.ABI.Methods["proofOf"].Outputs.Unpack(res.Ret)
+		suite.Require().NoError(err)
+		suite.Require().Len(outputs, 2)
+		suite.Equal(crypto.Keccak256Hash([]byte(vauthtypes.MessageToSign)), common.Hash(outputs[0].([32]byte)))
+		suite.Equal(signature, outputs[1].([]byte))
+
+		// can not submit twice
+		res, err = suite.EthCallApply(ctx, submitter.GetEthAddressP(), cpctypes.CpcVAuthFixedAddress, buildInput("submitProofExternalOwnedAccount", account, signature))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "account already have proof")
+	})
+
+	suite.Run("fail - submitProofExternalOwnedAccount(address,bytes) mis-match signature", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		otherAccount := common.BytesToAddress([]byte("other"))
+		res, err := suite.EthCallApply(ctx, submitter.GetEthAddressP(), cpctypes.CpcVAuthFixedAddress, buildInput("submitProofExternalOwnedAccount", otherAccount, signature))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "mis-match signature")
+
+		suite.False(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, otherAccount.Bytes()))
+	})
+
+	suite.Run("fail - submitProofExternalOwnedAccount(address,bytes) zero address", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		res, err := suite.EthCallApply(ctx, submitter.GetEthAddressP(), cpctypes.CpcVAuthFixedAddress, buildInput("submitProofExternalOwnedAccount", common.Address{}, signature))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "account cannot be the zero address")
+	})
+
+	suite.Run("fail - submitProofExternalOwnedAccount(address,bytes) insufficient fee", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		poorSubmitter := common.BytesToAddress([]byte("poor"))
+		suite.App().AccountKeeper().SetAccount(ctx, suite.App().AccountKeeper().NewAccountWithAddress(ctx, poorSubmitter.Bytes()))
+
+		res, err := suite.EthCallApply(ctx, &poorSubmitter, cpctypes.CpcVAuthFixedAddress, buildInput("submitProofExternalOwnedAccount", account, signature))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "failed to deduct fee from submitter")
+
+		suite.False(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, account.Bytes()))
+	})
+
+	suite.Run("revokeProofExternalOwnedAccount()", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		err := suite.App().VAuthKeeper().ProcessSubmitProofExternalOwnedAccount(ctx, &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+			Submitter: submitter.GetCosmosAddress().String(),
+			Account:   sdk.AccAddress(account.Bytes()).String(),
+			Signature: "0x" + hex.EncodeToString(signature),
+		})
+		suite.Require().NoError(err)
+		suite.Require().True(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, account.Bytes()))
+
+		// other account can not revoke
+		res, err := suite.EthCallApply(ctx, submitter.GetEthAddressP(), cpctypes.CpcVAuthFixedAddress, buildInput("revokeProofExternalOwnedAccount"))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "account does not have proof")
+		suite.True(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, account.Bytes()))
+
+		res, err = suite.EthCallApply(ctx, &account, cpctypes.CpcVAuthFixedAddress, buildInput("revokeProofExternalOwnedAccount"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+		suite.Require().NoError(err)
+		suite.True(gotSuccess)
+
+		suite.False(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, account.Bytes()))
+
+		var receipt ethtypes.Receipt
+		err = receipt.UnmarshalBinary(res.MarshalledReceipt)
+		suite.Require().NoError(err)
+		suite.Require().Len(receipt.Logs, 1, "expect event RevokeProofExternalOwnedAccount")
+		log := receipt.Logs[0]
+		suite.Equal(cpctypes.CpcVAuthFixedAddress, log.Address)
+		suite.Require().Len(log.Topics, 2)
+		suite.Equal(topic0RevokeProofExternalOwnedAccount, log.Topics[0].String())
+		suite.Equal(account, common.BytesToAddress(log.Topics[1].Bytes()))
+
+		// can not revoke twice
+		res, err = suite.EthCallApply(ctx, &account, cpctypes.CpcVAuthFixedAddress, buildInput("revokeProofExternalOwnedAccount"))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "account does not have proof")
+	})
+}
@@ -0,0 +1,421 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/EscanBE/evermint/x/cpc/abi"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	vestingTypeContinuous      uint8 = 1
+	vestingTypeDelayed         uint8 = 2
+	vestingTypePeriodic        uint8 = 3
+	vestingTypePermanentLocked uint8 = 4
+)
+
+// DeployVestingCustomPrecompiledContract deploys a new vesting custom precompiled contract.
+func (k Keeper) DeployVestingCustomPrecompiledContract(ctx sdk.Context) (common.Address, error) {
+	contractAddress := cpctypes.CpcVestingFixedAddress
+
+	// deployment
+	contractMeta := cpctypes.CustomPrecompiledContractMeta{
+		Address:               contractAddress.Bytes(),
+		CustomPrecompiledType: cpctypes.CpcTypeVesting,
+		Name:                  "Vesting - Precompiled Contract",
+		TypedMeta:             cpctypes.EmptyTypedMeta,
+		Disabled:              false,
+	}
+
+	if err := k.SetCustomPrecompiledContractMeta(ctx, contractMeta, true); err != nil {
+		return common.Address{}, err
+	}
+
+	return contractAddress, nil
+}
+
+// contract
+
+var _ CustomPrecompiledContractI = &vestingCustomPrecompiledContract{}
+
+// vestingCustomPrecompiledContract is a contract that can be used to create vesting accounts of `x/auth/vesting` module.
+type vestingCustomPrecompiledContract struct {
+	metadata  cpctypes.CustomPrecompiledContractMeta
+	keeper    Keeper
+	executors []ExtendedCustomPrecompiledContractMethodExecutorI
+}
+
+// NewVestingCustomPrecompiledContract creates a new vesting custom precompiled contract.
+func NewVestingCustomPrecompiledContract(
+	metadata cpctypes.CustomPrecompiledContractMeta,
+	keeper Keeper,
+) CustomPrecompiledContractI {
+	contract := &vestingCustomPrecompiledContract{
+		metadata: metadata,
+		keeper:   keeper,
+	}
+
+	contract.executors = []ExtendedCustomPrecompiledContractMethodExecutorI{
+		&vestingCustomPrecompiledContractRoName{contract: contract},
+		&vestingCustomPrecompiledContractRwCreateVestingAccount{contract: contract},
+		&vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount{contract: contract},
+		&vestingCustomPrecompiledContractRwCreatePermanentLockedAccount{contract: contract},
+		&vestingCustomPrecompiledContractRoVestedCoins{contract: contract},
+		&vestingCustomPrecompiledContractRoLockedCoins{contract: contract},
+		&vestingCustomPrecompiledContractRoSpendableCoins{contract: contract},
+	}
+
+	return contract
+}
+
+func (m vestingCustomPrecompiledContract) GetMetadata() cpctypes.CustomPrecompiledContractMeta {
+	return m.metadata
+}
+
+func (m vestingCustomPrecompiledContract) GetMethodExecutors() []ExtendedCustomPrecompiledContractMethodExecutorI {
+	return m.executors
+}
+
+// checkEoaProofRules applies the EOA proof rules defined in `x/vauth` params to the message,
+// the same way `CLVestingMessagesAuthorizationDecorator` does for Cosmos messages.
+// By default, the vesting account can only be created for account which has proof of EOA via `x/vauth`.
+func (m vestingCustomPrecompiledContract) checkEoaProofRules(ctx sdk.Context, account common.Address, msg sdk.Msg) error {
+	if account == (common.Address{}) {
+		return errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "vesting account cannot be the zero address")
+	}
+
+	return m.keeper.vauthKeeper.CheckEoaProofRules(ctx, []sdk.Msg{msg})
+}
+
+func (m vestingCustomPrecompiledContract) emitsEventCreateVestingAccount(from, to common.Address, vestingType uint8, env cpcExecutorEnv) error {
+	return addEvmLog(env.evm.StateDB, cpctypes.CpcVestingFixedAddress, abi.VestingCpcInfo, "CreateVestingAccount", from, to, vestingType)
+}
+
+func (m vestingCustomPrecompiledContract) msgServer() vestingtypes.MsgServer {
+	return vesting.NewMsgServerImpl(m.keeper.accountKeeper, m.keeper.bankKeeper)
+}
+
+// name()
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRoName{}
+
+type vestingCustomPrecompiledContractRoName struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRoName) Execute(_ corevm.ContractRef, _ common.Address, input []byte, _ cpcExecutorEnv) ([]byte, error) {
+	_, err := abi.VestingCpcInfo.UnpackMethodInput("name", input)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.VestingCpcInfo.PackMethodOutput("name", e.contract.metadata.Name)
+}
+
+func (e vestingCustomPrecompiledContractRoName) Method4BytesSignatures() []byte {
+	return []byte{0x06, 0xfd, 0xde, 0x03}
+}
+
+func (e vestingCustomPrecompiledContractRoName) RequireGas() uint64 {
+	return 0
+}
+
+func (e vestingCustomPrecompiledContractRoName) ReadOnly() bool {
+	return true
+}
+
+// createVestingAccount(address,(string,uint256)[],int64,bool)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRwCreateVestingAccount{}
+
+type vestingCustomPrecompiledContractRwCreateVestingAccount struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRwCreateVestingAccount) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("createVestingAccount", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	from := caller.Address()
+	to := ips[0].(common.Address)
+	endTime := ips[2].(int64)
+	delayed := ips[3].(bool)
+
+	amount, err := fromAbiCoins(ips[1])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := vestingtypes.NewMsgCreateVestingAccount(from.Bytes(), to.Bytes(), amount, endTime, delayed)
+	if err := e.contract.checkEoaProofRules(ctx, to, msg); err != nil {
+		return nil, err
+	}
+	if _, err := e.contract.msgServer().CreateVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	vestingType := vestingTypeContinuous
+	if delayed {
+		vestingType = vestingTypeDelayed
+	}
+	if err := e.contract.emitsEventCreateVestingAccount(from, to, vestingType, env); err != nil {
+		return nil, err
+	}
+
+	return abi.VestingCpcInfo.PackMethodOutput("createVestingAccount", true)
+}
+
+func (e vestingCustomPrecompiledContractRwCreateVestingAccount) Method4BytesSignatures() []byte {
+	return []byte{0xa3, 0x19, 0xa6, 0xc4}
+}
+
+func (e vestingCustomPrecompiledContractRwCreateVestingAccount) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e vestingCustomPrecompiledContractRwCreateVestingAccount) ReadOnly() bool {
+	return false
+}
+
+// createPeriodicVestingAccount(address,int64,(int64,(string,uint256)[])[])
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount{}
+
+type vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("createPeriodicVestingAccount", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	from := caller.Address()
+	to := ips[0].(common.Address)
+	startTime := ips[1].(int64)
+
+	var inputPeriods []struct {
+		Length int64 `json:"length"`
+		Amount any   `json:"amount"`
+	}
+	if bz, err := json.Marshal(ips[2]); err != nil {
+		return nil, err
+	} else if err := json.Unmarshal(bz, &inputPeriods); err != nil {
+		return nil, fmt.Errorf("failed to parse periods: %s", err.Error())
+	}
+
+	if len(inputPeriods) == 0 {
+		return nil, errorsmod.Wrap(cpctypes.ErrInvalidCpcInput, "periods cannot be empty")
+	}
+
+	periods := make([]vestingtypes.Period, len(inputPeriods))
+	for i, inputPeriod := range inputPeriods {
+		amount, err := fromAbiCoins(inputPeriod.Amount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid period %d", i)
+		}
+		periods[i] = vestingtypes.Period{
+			Length: inputPeriod.Length,
+			Amount: amount,
+		}
+	}
+
+	msg := vestingtypes.NewMsgCreatePeriodicVestingAccount(from.Bytes(), to.Bytes(), startTime, periods)
+	if err := e.contract.checkEoaProofRules(ctx, to, msg); err != nil {
+		return nil, err
+	}
+	if _, err := e.contract.msgServer().CreatePeriodicVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.emitsEventCreateVestingAccount(from, to, vestingTypePeriodic, env); err != nil {
+		return nil, err
+	}
+
+	return abi.VestingCpcInfo.PackMethodOutput("createPeriodicVestingAccount", true)
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount) Method4BytesSignatures() []byte {
+	return []byte{0xdc, 0x32, 0x77, 0x52}
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount) RequireGas() uint64 {
+	return 150_000
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePeriodicVestingAccount) ReadOnly() bool {
+	return false
+}
+
+// createPermanentLockedAccount(address,(string,uint256)[])
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRwCreatePermanentLockedAccount{}
+
+type vestingCustomPrecompiledContractRwCreatePermanentLockedAccount struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePermanentLockedAccount) Execute(caller corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("createPermanentLockedAccount", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+
+	from := caller.Address()
+	to := ips[0].(common.Address)
+
+	amount, err := fromAbiCoins(ips[1])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := vestingtypes.NewMsgCreatePermanentLockedAccount(from.Bytes(), to.Bytes(), amount)
+	if err := e.contract.checkEoaProofRules(ctx, to, msg); err != nil {
+		return nil, err
+	}
+	if _, err := e.contract.msgServer().CreatePermanentLockedAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := e.contract.emitsEventCreateVestingAccount(from, to, vestingTypePermanentLocked, env); err != nil {
+		return nil, err
+	}
+
+	return abi.VestingCpcInfo.PackMethodOutput("createPermanentLockedAccount", true)
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePermanentLockedAccount) Method4BytesSignatures() []byte {
+	return []byte{0xb8, 0x8a, 0xbe, 0x14}
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePermanentLockedAccount) RequireGas() uint64 {
+	return 100_000
+}
+
+func (e vestingCustomPrecompiledContractRwCreatePermanentLockedAccount) ReadOnly() bool {
+	return false
+}
+
+// vestedCoins(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRoVestedCoins{}
+
+type vestingCustomPrecompiledContractRoVestedCoins struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRoVestedCoins) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("vestedCoins", input)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := env.ctx
+	account := ips[0].(common.Address)
+
+	var vestedCoins sdk.Coins
+	if vestingAccount, ok := e.contract.keeper.accountKeeper.GetAccount(ctx, account.Bytes()).(vestingexported.VestingAccount); ok {
+		vestedCoins = vestingAccount.GetVestedCoins(ctx.BlockTime())
+	}
+
+	return abi.VestingCpcInfo.PackMethodOutput("vestedCoins", toAbiCoins(vestedCoins))
+}
+
+func (e vestingCustomPrecompiledContractRoVestedCoins) Method4BytesSignatures() []byte {
+	return []byte{0x63, 0x18, 0x6f, 0xa6}
+}
+
+func (e vestingCustomPrecompiledContractRoVestedCoins) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e vestingCustomPrecompiledContractRoVestedCoins) ReadOnly() bool {
+	return true
+}
+
+// lockedCoins(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRoLockedCoins{}
+
+type vestingCustomPrecompiledContractRoLockedCoins struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRoLockedCoins) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("lockedCoins", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := ips[0].(common.Address)
+
+	lockedCoins := e.contract.keeper.bankKeeper.LockedCoins(env.ctx, account.Bytes())
+
+	return abi.VestingCpcInfo.PackMethodOutput("lockedCoins", toAbiCoins(lockedCoins))
+}
+
+func (e vestingCustomPrecompiledContractRoLockedCoins) Method4BytesSignatures() []byte {
+	return []byte{0xbf, 0x17, 0x28, 0x2a}
+}
+
+func (e vestingCustomPrecompiledContractRoLockedCoins) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e vestingCustomPrecompiledContractRoLockedCoins) ReadOnly() bool {
+	return true
+}
+
+// spendableCoins(address)
+
+var _ ExtendedCustomPrecompiledContractMethodExecutorI = &vestingCustomPrecompiledContractRoSpendableCoins{}
+
+type vestingCustomPrecompiledContractRoSpendableCoins struct {
+	contract *vestingCustomPrecompiledContract
+}
+
+func (e vestingCustomPrecompiledContractRoSpendableCoins) Execute(_ corevm.ContractRef, _ common.Address, input []byte, env cpcExecutorEnv) ([]byte, error) {
+	ips, err := abi.VestingCpcInfo.UnpackMethodInput("spendableCoins", input)
+	if err != nil {
+		return nil, err
+	}
+
+	account := ips[0].(common.Address)
+
+	spendableCoins := e.contract.keeper.bankKeeper.SpendableCoins(env.ctx, account.Bytes())
+
+	return abi.VestingCpcInfo.PackMethodOutput("spendableCoins", toAbiCoins(spendableCoins))
+}
+
+func (e vestingCustomPrecompiledContractRoSpendableCoins) Method4BytesSignatures() []byte {
+	return []byte{0x5a, 0x34, 0x88, 0x12}
+}
+
+func (e vestingCustomPrecompiledContractRoSpendableCoins) RequireGas() uint64 {
+	return 10_000
+}
+
+func (e vestingCustomPrecompiledContractRoSpendableCoins) ReadOnly() bool {
+	return true
+}
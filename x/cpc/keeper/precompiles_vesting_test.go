@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/evermint/rename_chain/marker"
+	"github.com/EscanBE/evermint/x/cpc/abi"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	cpcutils "github.com/EscanBE/evermint/x/cpc/utils"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const topic0CreateVestingAccount = "0x50008c79d49d6a65ead25f0a49a4c903b4f9ca69db804fa94de8811fe0e1e33f"
+
+func (suite *CpcTestSuite) TestKeeper_DeployVestingCustomPrecompiledContract() {
+	suite.Run("pass - deployed at genesis", func() {
+		meta := suite.App().CpcKeeper().GetCustomPrecompiledContractMeta(suite.Ctx(), cpctypes.CpcVestingFixedAddress)
+		suite.Require().NotNil(meta)
+		suite.Equal(cpctypes.CpcTypeVesting, meta.CustomPrecompiledType)
+		suite.Equal(cpctypes.EmptyTypedMeta, meta.TypedMeta)
+		suite.False(meta.Disabled)
+	})
+
+	suite.Run("pass - contract must be found in list of contracts", func() {
+		addrBz := cpctypes.CpcVestingFixedAddress.Bytes()
+
+		metas := suite.App().CpcKeeper().GetAllCustomPrecompiledContractsMeta(suite.Ctx())
+		var found bool
+		for _, m := range metas {
+			if bytes.Equal(addrBz, m.Address) {
+				found = true
+				break
+			}
+		}
+		suite.True(found)
+	})
+
+	suite.Run("fail - can not deploy twice", func() {
+		_, err := suite.App().CpcKeeper().DeployVestingCustomPrecompiledContract(suite.Ctx())
+		suite.Require().ErrorContains(err, "contract address is being in use")
+	})
+}
+
+func (suite *CpcTestSuite) TestKeeper_Topic0_Vesting() {
+	suite.Equal(common.HexToHash(topic0CreateVestingAccount), abi.VestingCpcInfo.ABI.Events["CreateVestingAccount"].ID)
+}
+
+func (suite *CpcTestSuite) TestKeeper_VestingCustomPrecompiledContract() {
+	funder := suite.CITS.WalletAccounts.Number(1)
+
+	proof := vauthtypes.ProofExternalOwnedAccount{
+		Account:   marker.ReplaceAbleAddress("evm1xx2enpw8wzlr64xkdz2gh3c7epucfdftnqtcem"),
+		Hash:      "0x" + hex.EncodeToString(crypto.Keccak256([]byte(vauthtypes.MessageToSign))),
+		Signature: "0xe665110439b1d18002ef866285f7e532090065ad74274560db5e8373d0cdb6297afefc70a5dd46c23e74bd3f0f262195f089b2923242a14e8e0791f4b0621a2c00",
+	}
+	provenAccount := common.BytesToAddress(sdk.MustAccAddressFromBech32(proof.Account))
+	notProvenAccount := common.BytesToAddress([]byte("not-proven"))
+
+	buildInput := func(methodName string, args ...any) []byte {
+		method := abi.VestingCpcInfo.ABI.Methods[methodName]
+		input, err := method.Inputs.Pack(args...)
+		suite.Require().NoError(err)
+		return append(append([]byte{}, method.ID...), input...)
+	}
+
+	unpackCoins := func(methodName string, ret []byte) sdk.Coins {
+		outputs, err := abi.VestingCpcInfo.ABI.Methods[methodName].Outputs.Unpack(ret)
+		suite.Require().NoError(err)
+		var coins sdk.Coins
+		for _, coin := range outputs[0].([]struct {
+			Denom  string   `json:"denom"`
+			Amount *big.Int `json:"amount"`
+		}) {
+			coins = append(coins, sdk.NewCoin(coin.Denom, sdkmath.NewIntFromBigInt(coin.Amount)))
+		}
+		return coins
+	}
+
+	requireEventCreateVestingAccount := func(bzReceipt []byte, to common.Address, vestingType uint8) {
+		var receipt ethtypes.Receipt
+		err := receipt.UnmarshalBinary(bzReceipt)
+		suite.Require().NoError(err)
+		suite.Require().Len(receipt.Logs, 1, "expect event CreateVestingAccount")
+
+		log := receipt.Logs[0]
+		suite.Equal(cpctypes.CpcVestingFixedAddress, log.Address)
+		suite.Require().Len(log.Topics, 3)
+		suite.Equal(topic0CreateVestingAccount, log.Topics[0].String())
+		suite.Equal(funder.GetEthAddress(), common.BytesToAddress(log.Topics[1].Bytes()))
+		suite.Equal(to, common.BytesToAddress(log.Topics[2].Bytes()))
+		suite.Equal(big.NewInt(int64(vestingType)), new(big.Int).SetBytes(log.Data))
+	}
+
+	bondDenom := suite.bondDenom(suite.Ctx())
+	amount := []abi.BankCoin{{Denom: bondDenom, Amount: big.NewInt(1e9)}}
+	endTime := suite.Ctx().BlockTime().Add(24 * time.Hour).Unix()
+
+	err := suite.App().VAuthKeeper().SaveProofExternalOwnedAccount(suite.Ctx(), proof)
+	suite.Require().NoError(err)
+
+	suite.Run("name()", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcVestingFixedAddress, buildInput("name"))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		gotName, err := cpcutils.AbiDecodeString(res.Ret)
+		suite.Require().NoError(err)
+		suite.Equal("Vesting - Precompiled Contract", gotName)
+	})
+
+	suite.Run("createVestingAccount(address,(string,uint256)[],int64,bool)", func() {
+		for _, delayed := range []bool{false, true} {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, buildInput("createVestingAccount", provenAccount, amount, endTime, delayed))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+
+			gotSuccess, err := cpcutils.AbiDecodeBool(res.Ret)
+			suite.Require().NoError(err)
+			suite.True(gotSuccess)
+
+			account := suite.App().AccountKeeper().GetAccount(ctx, provenAccount.Bytes())
+			if delayed {
+				suite.IsType(&vestingtypes.DelayedVestingAccount{}, account)
+				requireEventCreateVestingAccount(res.MarshalledReceipt, provenAccount, 2)
+			} else {
+				suite.IsType(&vestingtypes.ContinuousVestingAccount{}, account)
+				requireEventCreateVestingAccount(res.MarshalledReceipt, provenAccount, 1)
+			}
+
+			res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVestingFixedAddress, buildInput("lockedCoins", provenAccount))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e9)).String(), unpackCoins("lockedCoins", res.Ret).String())
+
+			res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVestingFixedAddress, buildInput("spendableCoins", provenAccount))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Empty(unpackCoins("spendableCoins", res.Ret))
+
+			res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVestingFixedAddress, buildInput("vestedCoins", provenAccount))
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Empty(unpackCoins("vestedCoins", res.Ret))
+		}
+	})
+
+	suite.Run("createPeriodicVestingAccount(address,int64,(int64,(string,uint256)[])[])", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		startTime := ctx.BlockTime().Add(-time.Hour).Unix()
+		periods := []abi.VestingPeriod{
+			{Length: 60, Amount: []abi.BankCoin{{Denom: bondDenom, Amount: big.NewInt(100)}}},
+			{Length: 86400, Amount: []abi.BankCoin{{Denom: bondDenom, Amount: big.NewInt(200)}}},
+		}
+
+		res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, buildInput("createPeriodicVestingAccount", provenAccount, startTime, periods))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		account := suite.App().AccountKeeper().GetAccount(ctx, provenAccount.Bytes())
+		suite.IsType(&vestingtypes.PeriodicVestingAccount{}, account)
+		requireEventCreateVestingAccount(res.MarshalledReceipt, provenAccount, 3)
+
+		// first period passed
+		res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVestingFixedAddress, buildInput("vestedCoins", provenAccount))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)).String(), unpackCoins("vestedCoins", res.Ret).String())
+
+		res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVestingFixedAddress, buildInput("lockedCoins", provenAccount))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200)).String(), unpackCoins("lockedCoins", res.Ret).String())
+
+		res, err = suite.EthCallApply(ctx, nil, cpctypes.CpcVestingFixedAddress, buildInput("spendableCoins", provenAccount))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)).String(), unpackCoins("spendableCoins", res.Ret).String())
+	})
+
+	suite.Run("createPermanentLockedAccount(address,(string,uint256)[])", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, buildInput("createPermanentLockedAccount", provenAccount, amount))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		account := suite.App().AccountKeeper().GetAccount(ctx, provenAccount.Bytes())
+		suite.IsType(&vestingtypes.PermanentLockedAccount{}, account)
+		requireEventCreateVestingAccount(res.MarshalledReceipt, provenAccount, 4)
+	})
+
+	suite.Run("fail - account without proof of EOA", func() {
+		inputs := map[string][]byte{
+			"createVestingAccount":         buildInput("createVestingAccount", notProvenAccount, amount, endTime, false),
+			"createPeriodicVestingAccount": buildInput("createPeriodicVestingAccount", notProvenAccount, endTime, []abi.VestingPeriod{{Length: 60, Amount: amount}}),
+			"createPermanentLockedAccount": buildInput("createPermanentLockedAccount", notProvenAccount, amount),
+		}
+		for method, input := range inputs {
+			suite.Run(method, func() {
+				ctx, _ := suite.Ctx().CacheContext()
+
+				res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, input)
+				suite.Require().NoError(err)
+				suite.Contains(res.VmError, "must prove account is external owned account (EOA)")
+
+				suite.Nil(suite.App().AccountKeeper().GetAccount(ctx, notProvenAccount.Bytes()))
+			})
+		}
+	})
+
+	suite.Run("pass - account without proof of EOA when governance removed the rules", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		params := suite.App().VAuthKeeper().GetParams(ctx)
+		params.EoaProofRules = nil
+		suite.Require().NoError(suite.App().VAuthKeeper().SetParams(ctx, params))
+
+		res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, buildInput("createPermanentLockedAccount", notProvenAccount, amount))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		account := suite.App().AccountKeeper().GetAccount(ctx, notProvenAccount.Bytes())
+		suite.IsType(&vestingtypes.PermanentLockedAccount{}, account)
+	})
+
+	suite.Run("fail - funder without proof of EOA when governance added a rule", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		params := suite.App().VAuthKeeper().GetParams(ctx)
+		params.EoaProofRules = append(params.EoaProofRules, vauthtypes.EoaProofRule{
+			MsgTypeUrl:   sdk.MsgTypeURL(&vestingtypes.MsgCreatePermanentLockedAccount{}),
+			AddressField: "from_address",
+		})
+		suite.Require().NoError(suite.App().VAuthKeeper().SetParams(ctx, params))
+
+		res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, buildInput("createPermanentLockedAccount", provenAccount, amount))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "must prove account is external owned account (EOA)")
+		suite.Contains(res.VmError, "from_address")
+
+		suite.Nil(suite.App().AccountKeeper().GetAccount(ctx, provenAccount.Bytes()))
+	})
+
+	suite.Run("fail - account already exists", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		accountKeeper := suite.App().AccountKeeper()
+		accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, provenAccount.Bytes()))
+
+		res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, buildInput("createVestingAccount", provenAccount, amount, endTime, false))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "already exists")
+	})
+
+	suite.Run("fail - invalid coins", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, buildInput("createPermanentLockedAccount", provenAccount, []abi.BankCoin{}))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "coins cannot be empty")
+	})
+
+	suite.Run("fail - empty periods", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		res, err := suite.EthCallApply(ctx, funder.GetEthAddressP(), cpctypes.CpcVestingFixedAddress, buildInput("createPeriodicVestingAccount", provenAccount, endTime, []abi.VestingPeriod{}))
+		suite.Require().NoError(err)
+		suite.Contains(res.VmError, "periods cannot be empty")
+	})
+
+	suite.Run("queries of non-vesting account", func() {
+		res, err := suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcVestingFixedAddress, buildInput("vestedCoins", funder.GetEthAddress()))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Empty(unpackCoins("vestedCoins", res.Ret))
+
+		res, err = suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcVestingFixedAddress, buildInput("lockedCoins", funder.GetEthAddress()))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Empty(unpackCoins("lockedCoins", res.Ret))
+
+		res, err = suite.EthCallApply(suite.Ctx(), nil, cpctypes.CpcVestingFixedAddress, buildInput("spendableCoins", funder.GetEthAddress()))
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Equal(
+			suite.App().BankKeeper().SpendableCoins(suite.Ctx(), funder.GetCosmosAddress()).String(),
+			unpackCoins("spendableCoins", res.Ret).String(),
+		)
+	})
+}
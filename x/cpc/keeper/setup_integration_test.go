@@ -217,6 +217,11 @@ func (suite *CpcTestSuite) hashEip712Message(msg eip712.TypedMessage, account *i
 func (suite *CpcTestSuite) getGenesisDeployedCPCs(ctx sdk.Context) []common.Address {
 	genesisDeployedContractAddrs := []common.Address{
 		cpctypes.CpcBech32FixedAddress,
+		cpctypes.CpcGovFixedAddress,
+		cpctypes.CpcBankFixedAddress,
+		cpctypes.CpcDistributionFixedAddress,
+		cpctypes.CpcVestingFixedAddress,
+		cpctypes.CpcVAuthFixedAddress,
 	}
 
 	for _, genesisDeployedContractAddr := range genesisDeployedContractAddrs {
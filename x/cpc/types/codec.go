@@ -11,6 +11,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "evermint/cpc/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgDeployErc20ContractRequest{}, "evermint/cpc/MsgDeployErc20ContractRequest", nil)
 	cdc.RegisterConcrete(&MsgDeployStakingContractRequest{}, "evermint/cpc/MsgDeployStakingContractRequest", nil)
+	cdc.RegisterConcrete(&MsgDeployIbcTransferContractRequest{}, "evermint/cpc/MsgDeployIbcTransferContractRequest", nil)
+	cdc.RegisterConcrete(&MsgDisableCustomPrecompiledContract{}, "evermint/cpc/MsgDisableCustomPrecompiledContract", nil)
+	cdc.RegisterConcrete(&MsgEnableCustomPrecompiledContract{}, "evermint/cpc/MsgEnableCustomPrecompiledContract", nil)
 }
 
 // RegisterInterfaces registers implementations by its interface, for the module
@@ -20,6 +23,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgDeployErc20ContractRequest{},
 		&MsgDeployStakingContractRequest{},
+		&MsgDeployIbcTransferContractRequest{},
+		&MsgDisableCustomPrecompiledContract{},
+		&MsgEnableCustomPrecompiledContract{},
 	)
 }
 
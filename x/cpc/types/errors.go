@@ -8,6 +8,7 @@ const (
 	codeErrInvalidCpcInput = uint32(iota) + 2
 	codeErrNotSupportedByCpc
 	codeErrExecFailure
+	codeErrDisabledCpc
 )
 
 var (
@@ -19,4 +20,7 @@ var (
 
 	// ErrExecFailure returns an error if the execution of the custom precompiled contract fails
 	ErrExecFailure = errorsmod.Register(ModuleName, codeErrExecFailure, "execution of the custom precompiled contract failed")
+
+	// ErrDisabledCpc returns an error if the custom precompiled contract was disabled
+	ErrDisabledCpc = errorsmod.Register(ModuleName, codeErrDisabledCpc, "custom precompiled contract is disabled")
 )
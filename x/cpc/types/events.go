@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeCustomPrecompiledContractDeployed = "cpc_deployed"
 	EventTypeCustomPrecompiledContractUpdated  = "cpc_updated"
+	EventTypeCustomPrecompiledContractDisabled = "cpc_disabled"
+	EventTypeCustomPrecompiledContractEnabled  = "cpc_enabled"
 
 	AttributeKeyCpcAddress   = "address"
 	AttributeKeyCpcName      = "name"
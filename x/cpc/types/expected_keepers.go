@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+)
+
+// EvmKeeper defines the expected interface needed to emit EVM logs from Cosmos txs.
+type EvmKeeper interface {
+	AddCosmosTxLogs(ctx sdk.Context, logs ...*ethtypes.Log)
+}
+
+// VAuthKeeper defines the expected interface needed to check, submit and revoke proof of external owned account (EOA),
+// and to apply the EOA proof rules.
+type VAuthKeeper interface {
+	CheckEoaProofRules(ctx sdk.Context, msgs []sdk.Msg) error
+	HasProofExternalOwnedAccount(ctx sdk.Context, accAddr sdk.AccAddress) bool
+	GetProofExternalOwnedAccount(ctx sdk.Context, accAddr sdk.AccAddress) *vauthtypes.ProofExternalOwnedAccount
+	ProcessSubmitProofExternalOwnedAccount(ctx sdk.Context, msg *vauthtypes.MsgSubmitProofExternalOwnedAccount) error
+	ProcessRevokeProofExternalOwnedAccount(ctx sdk.Context, msg *vauthtypes.MsgRevokeProofExternalOwnedAccount) error
+}
@@ -1,11 +1,25 @@
 package types
 
+import (
+	"encoding/json"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params:                DefaultParams(),
 		DeployErc20Native:     false,
 		DeployStakingContract: false,
+		DeployedContracts:     []CustomPrecompiledContractMeta{},
+		Erc20Allowances:       []Erc20CpcAllowance{},
+		ModuleAccountNonce:    0,
+		Erc20PermitNonces:     []Erc20CpcPermitNonce{},
 	}
 }
 
@@ -16,5 +30,168 @@ func (m GenesisState) Validate() error {
 		return err
 	}
 
+	protocolVersion := ProtocolCpc(m.Params.ProtocolVersion)
+
+	deployedContracts := make(map[common.Address]CustomPrecompiledContractMeta)
+	erc20MinDenoms := make(map[string]struct{})
+	for _, contract := range m.DeployedContracts {
+		if err := contract.Validate(protocolVersion); err != nil {
+			return errorsmod.Wrapf(err, "invalid deployed contract: %s", contract.Name)
+		}
+
+		contractAddress := common.BytesToAddress(contract.Address)
+		if _, found := deployedContracts[contractAddress]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate deployed contract address: %s", contractAddress)
+		}
+		deployedContracts[contractAddress] = contract
+
+		switch contract.CustomPrecompiledType {
+		case CpcTypeErc20:
+			var erc20Meta Erc20CustomPrecompiledContractMeta
+			if err := json.Unmarshal([]byte(contract.TypedMeta), &erc20Meta); err != nil {
+				return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidRequest, err), "failed to unmarshal ERC20 metadata of %s", contractAddress)
+			}
+			if _, found := erc20MinDenoms[erc20Meta.MinDenom]; found {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate ERC20 contract for %s", erc20Meta.MinDenom)
+			}
+			erc20MinDenoms[erc20Meta.MinDenom] = struct{}{}
+		case CpcTypeStaking:
+			if contractAddress != CpcStakingFixedAddress {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "staking contract must be deployed at %s", CpcStakingFixedAddress)
+			}
+			if m.DeployStakingContract {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "staking contract is already deployed, must not deploy again")
+			}
+		case CpcTypeBech32:
+			if contractAddress != CpcBech32FixedAddress {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bech32 contract must be deployed at %s", CpcBech32FixedAddress)
+			}
+		case CpcTypeGov:
+			if contractAddress != CpcGovFixedAddress {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "gov contract must be deployed at %s", CpcGovFixedAddress)
+			}
+		case CpcTypeIbcTransfer:
+			if contractAddress != CpcIbcTransferFixedAddress {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "IBC transfer contract must be deployed at %s", CpcIbcTransferFixedAddress)
+			}
+		case CpcTypeBank:
+			if contractAddress != CpcBankFixedAddress {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bank contract must be deployed at %s", CpcBankFixedAddress)
+			}
+		case CpcTypeDistribution:
+			if contractAddress != CpcDistributionFixedAddress {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "distribution contract must be deployed at %s", CpcDistributionFixedAddress)
+			}
+		case CpcTypeVesting:
+			if contractAddress != CpcVestingFixedAddress {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "vesting contract must be deployed at %s", CpcVestingFixedAddress)
+			}
+		case CpcTypeVAuth:
+			if contractAddress != CpcVAuthFixedAddress {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "vauth contract must be deployed at %s", CpcVAuthFixedAddress)
+			}
+		}
+	}
+
+	// the native ERC20 contract is deployed for the bond denom, which is not known here,
+	// so it must not be deployed when any ERC20 contract is imported, that one might be of the bond denom.
+	if len(erc20MinDenoms) > 0 && m.DeployErc20Native {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ERC20 contracts are imported, must not deploy the native ERC20 contract again")
+	}
+
+	// each ERC20 contract consumed a nonce of the module account
+	if uint64(len(erc20MinDenoms)) > m.ModuleAccountNonce {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "module account nonce %d is less than number of ERC20 contracts %d", m.ModuleAccountNonce, len(erc20MinDenoms))
+	}
+
+	type allowanceKey struct {
+		contract, owner, spender common.Address
+	}
+	uniqueAllowances := make(map[allowanceKey]struct{})
+	for _, allowance := range m.Erc20Allowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+
+		key := allowanceKey{
+			contract: common.HexToAddress(allowance.Contract),
+			owner:    common.HexToAddress(allowance.Owner),
+			spender:  common.HexToAddress(allowance.Spender),
+		}
+
+		if contract, found := deployedContracts[key.contract]; !found || contract.CustomPrecompiledType != CpcTypeErc20 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "allowance of non-existing ERC20 contract: %s", allowance.Contract)
+		}
+
+		if _, found := uniqueAllowances[key]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowance of %s, owner %s, spender %s", allowance.Contract, allowance.Owner, allowance.Spender)
+		}
+		uniqueAllowances[key] = struct{}{}
+	}
+
+	type permitNonceKey struct {
+		contract, owner common.Address
+	}
+	uniquePermitNonces := make(map[permitNonceKey]struct{})
+	for _, permitNonce := range m.Erc20PermitNonces {
+		if err := permitNonce.Validate(); err != nil {
+			return err
+		}
+
+		key := permitNonceKey{
+			contract: common.HexToAddress(permitNonce.Contract),
+			owner:    common.HexToAddress(permitNonce.Owner),
+		}
+
+		if contract, found := deployedContracts[key.contract]; !found || contract.CustomPrecompiledType != CpcTypeErc20 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "permit nonce of non-existing ERC20 contract: %s", permitNonce.Contract)
+		}
+
+		if _, found := uniquePermitNonces[key]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate permit nonce of %s, owner %s", permitNonce.Contract, permitNonce.Owner)
+		}
+		uniquePermitNonces[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the allowance record.
+func (m Erc20CpcAllowance) Validate() error {
+	if err := validateContractAddressHex(m.Contract); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(m.Owner) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", m.Owner)
+	}
+
+	if !common.IsHexAddress(m.Spender) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid spender address: %s", m.Spender)
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowance amount must be positive")
+	} else if m.Amount.BigInt().BitLen() > 256 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowance amount must not exceed max uint256")
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the permit nonce record.
+func (m Erc20CpcPermitNonce) Validate() error {
+	if err := validateContractAddressHex(m.Contract); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(m.Owner) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", m.Owner)
+	}
+
+	if m.Nonce == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "permit nonce must be positive")
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +33,15 @@ type GenesisState struct {
 	DeployErc20Native bool `protobuf:"varint,2,opt,name=deploy_erc20_native,json=deployErc20Native,proto3" json:"deploy_erc20_native,omitempty"`
 	// deploy_staking_contract defines if the module should deploy the staking contract.
 	DeployStakingContract bool `protobuf:"varint,3,opt,name=deploy_staking_contract,json=deployStakingContract,proto3" json:"deploy_staking_contract,omitempty"`
+	// deployed_contracts is the list of the deployed custom precompiled contracts.
+	DeployedContracts []CustomPrecompiledContractMeta `protobuf:"bytes,4,rep,name=deployed_contracts,json=deployedContracts,proto3" json:"deployed_contracts"`
+	// erc20_allowances is the list of the allowances of the ERC20 custom precompiled contracts.
+	Erc20Allowances []Erc20CpcAllowance `protobuf:"bytes,5,rep,name=erc20_allowances,json=erc20Allowances,proto3" json:"erc20_allowances"`
+	// module_account_nonce is the nonce of the module account,
+	// used to generate address for the dynamic custom precompiled contracts.
+	ModuleAccountNonce uint64 `protobuf:"varint,6,opt,name=module_account_nonce,json=moduleAccountNonce,proto3" json:"module_account_nonce,omitempty"`
+	// erc20_permit_nonces is the list of the EIP-2612 permit nonces of the ERC20 custom precompiled contracts.
+	Erc20PermitNonces []Erc20CpcPermitNonce `protobuf:"bytes,7,rep,name=erc20_permit_nonces,json=erc20PermitNonces,proto3" json:"erc20_permit_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,19 +98,121 @@ func (m *GenesisState) GetDeployStakingContract() bool {
 	return false
 }
 
+func (m *GenesisState) GetDeployedContracts() []CustomPrecompiledContractMeta {
+	if m != nil {
+		return m.DeployedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20Allowances() []Erc20CpcAllowance {
+	if m != nil {
+		return m.Erc20Allowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetModuleAccountNonce() uint64 {
+	if m != nil {
+		return m.ModuleAccountNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetErc20PermitNonces() []Erc20CpcPermitNonce {
+	if m != nil {
+		return m.Erc20PermitNonces
+	}
+	return nil
+}
+
+// Erc20CpcAllowance defines an allowance record of the ERC20 custom precompiled contract.
+type Erc20CpcAllowance struct {
+	// contract is the hex address of the ERC20 custom precompiled contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// owner is the hex address of the token owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the hex address of the spender.
+	Spender string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	// amount is the allowance amount.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *Erc20CpcAllowance) Reset()         { *m = Erc20CpcAllowance{} }
+func (m *Erc20CpcAllowance) String() string { return proto.CompactTextString(m) }
+func (*Erc20CpcAllowance) ProtoMessage()    {}
+func (*Erc20CpcAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd3704f3b12e5567, []int{1}
+}
+func (m *Erc20CpcAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Erc20CpcAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Erc20CpcAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Erc20CpcAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Erc20CpcAllowance.Merge(m, src)
+}
+func (m *Erc20CpcAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Erc20CpcAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Erc20CpcAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Erc20CpcAllowance proto.InternalMessageInfo
+
+func (m *Erc20CpcAllowance) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Erc20CpcAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Erc20CpcAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
 // Params defines the cpc module params
 type Params struct {
 	// protocol_version is the protocol version of the cpc module
 	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 	// whitelisted_deployers is the address of the accounts permitted to deploy the Custom Precompiled Contracts
 	WhitelistedDeployers []string `protobuf:"bytes,2,rep,name=whitelisted_deployers,json=whitelistedDeployers,proto3" json:"whitelisted_deployers,omitempty"`
+	// method_gas_table overrides the gas required by the methods of the Custom Precompiled Contracts.
+	// Methods those are not in the table require the built-in gas.
+	MethodGasTable []CpcMethodGas `protobuf:"bytes,3,rep,name=method_gas_table,json=methodGasTable,proto3" json:"method_gas_table"`
+	// dynamic_gas_enabled defines if the SDK gas consumed inside the method executors,
+	// which exceeds the gas required by the method, is charged additionally to the transaction.
+	DynamicGasEnabled bool `protobuf:"varint,4,opt,name=dynamic_gas_enabled,json=dynamicGasEnabled,proto3" json:"dynamic_gas_enabled,omitempty"`
+	// erc20_auto_deploy defines the automatic deployment of the ERC20 Custom Precompiled Contracts for the bank denoms.
+	Erc20AutoDeploy Erc20AutoDeploy `protobuf:"bytes,5,opt,name=erc20_auto_deploy,json=erc20AutoDeploy,proto3" json:"erc20_auto_deploy"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bd3704f3b12e5567, []int{1}
+	return fileDescriptor_bd3704f3b12e5567, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,36 +255,294 @@ func (m *Params) GetWhitelistedDeployers() []string {
 	return nil
 }
 
+func (m *Params) GetMethodGasTable() []CpcMethodGas {
+	if m != nil {
+		return m.MethodGasTable
+	}
+	return nil
+}
+
+func (m *Params) GetDynamicGasEnabled() bool {
+	if m != nil {
+		return m.DynamicGasEnabled
+	}
+	return false
+}
+
+func (m *Params) GetErc20AutoDeploy() Erc20AutoDeploy {
+	if m != nil {
+		return m.Erc20AutoDeploy
+	}
+	return Erc20AutoDeploy{}
+}
+
+// Erc20AutoDeploy defines the automatic deployment of the ERC20 Custom Precompiled Contracts for the bank denoms.
+// Name, symbol and decimals of the contracts are taken from the denom metadata of `x/bank`,
+// denoms without metadata are not deployed.
+type Erc20AutoDeploy struct {
+	// on_ibc_receive enables the deployment when IBC vouchers of a new denom are received.
+	OnIbcReceive bool `protobuf:"varint,1,opt,name=on_ibc_receive,json=onIbcReceive,proto3" json:"on_ibc_receive,omitempty"`
+	// on_denom_metadata_set enables the deployment when the denom metadata is set into `x/bank`.
+	OnDenomMetadataSet bool `protobuf:"varint,2,opt,name=on_denom_metadata_set,json=onDenomMetadataSet,proto3" json:"on_denom_metadata_set,omitempty"`
+	// allowed_denoms is the patterns of the denoms those can be deployed, empty means all.
+	// A pattern is either an exact denom or a prefix followed by `*`, like `ibc/*`.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// denied_denoms is the patterns of the denoms those can not be deployed, takes precedence over the allowed_denoms.
+	DeniedDenoms []string `protobuf:"bytes,4,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+}
+
+func (m *Erc20AutoDeploy) Reset()         { *m = Erc20AutoDeploy{} }
+func (m *Erc20AutoDeploy) String() string { return proto.CompactTextString(m) }
+func (*Erc20AutoDeploy) ProtoMessage()    {}
+func (*Erc20AutoDeploy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd3704f3b12e5567, []int{3}
+}
+func (m *Erc20AutoDeploy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Erc20AutoDeploy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Erc20AutoDeploy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Erc20AutoDeploy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Erc20AutoDeploy.Merge(m, src)
+}
+func (m *Erc20AutoDeploy) XXX_Size() int {
+	return m.Size()
+}
+func (m *Erc20AutoDeploy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Erc20AutoDeploy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Erc20AutoDeploy proto.InternalMessageInfo
+
+func (m *Erc20AutoDeploy) GetOnIbcReceive() bool {
+	if m != nil {
+		return m.OnIbcReceive
+	}
+	return false
+}
+
+func (m *Erc20AutoDeploy) GetOnDenomMetadataSet() bool {
+	if m != nil {
+		return m.OnDenomMetadataSet
+	}
+	return false
+}
+
+func (m *Erc20AutoDeploy) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Erc20AutoDeploy) GetDeniedDenoms() []string {
+	if m != nil {
+		return m.DeniedDenoms
+	}
+	return nil
+}
+
+// CpcMethodGas defines the gas required by a method of a Custom Precompiled Contract type.
+type CpcMethodGas struct {
+	// contract_type is the type of the Custom Precompiled Contract.
+	ContractType uint32 `protobuf:"varint,1,opt,name=contract_type,json=contractType,proto3" json:"contract_type,omitempty"`
+	// method_selector is the 0x-prefixed lowercase hex of the 4 bytes method selector.
+	MethodSelector string `protobuf:"bytes,2,opt,name=method_selector,json=methodSelector,proto3" json:"method_selector,omitempty"`
+	// gas is the gas required by the method.
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *CpcMethodGas) Reset()         { *m = CpcMethodGas{} }
+func (m *CpcMethodGas) String() string { return proto.CompactTextString(m) }
+func (*CpcMethodGas) ProtoMessage()    {}
+func (*CpcMethodGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd3704f3b12e5567, []int{4}
+}
+func (m *CpcMethodGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CpcMethodGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CpcMethodGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CpcMethodGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CpcMethodGas.Merge(m, src)
+}
+func (m *CpcMethodGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *CpcMethodGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_CpcMethodGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CpcMethodGas proto.InternalMessageInfo
+
+func (m *CpcMethodGas) GetContractType() uint32 {
+	if m != nil {
+		return m.ContractType
+	}
+	return 0
+}
+
+func (m *CpcMethodGas) GetMethodSelector() string {
+	if m != nil {
+		return m.MethodSelector
+	}
+	return ""
+}
+
+func (m *CpcMethodGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// Erc20CpcPermitNonce defines an EIP-2612 permit nonce record of the ERC20 custom precompiled contract.
+type Erc20CpcPermitNonce struct {
+	// contract is the hex address of the ERC20 custom precompiled contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// owner is the hex address of the token owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// nonce is the current permit nonce of the owner.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *Erc20CpcPermitNonce) Reset()         { *m = Erc20CpcPermitNonce{} }
+func (m *Erc20CpcPermitNonce) String() string { return proto.CompactTextString(m) }
+func (*Erc20CpcPermitNonce) ProtoMessage()    {}
+func (*Erc20CpcPermitNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd3704f3b12e5567, []int{5}
+}
+func (m *Erc20CpcPermitNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Erc20CpcPermitNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Erc20CpcPermitNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Erc20CpcPermitNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Erc20CpcPermitNonce.Merge(m, src)
+}
+func (m *Erc20CpcPermitNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *Erc20CpcPermitNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_Erc20CpcPermitNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Erc20CpcPermitNonce proto.InternalMessageInfo
+
+func (m *Erc20CpcPermitNonce) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Erc20CpcPermitNonce) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Erc20CpcPermitNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evermint.cpc.v1.GenesisState")
+	proto.RegisterType((*Erc20CpcAllowance)(nil), "evermint.cpc.v1.Erc20CpcAllowance")
 	proto.RegisterType((*Params)(nil), "evermint.cpc.v1.Params")
+	proto.RegisterType((*Erc20AutoDeploy)(nil), "evermint.cpc.v1.Erc20AutoDeploy")
+	proto.RegisterType((*CpcMethodGas)(nil), "evermint.cpc.v1.CpcMethodGas")
+	proto.RegisterType((*Erc20CpcPermitNonce)(nil), "evermint.cpc.v1.Erc20CpcPermitNonce")
 }
 
 func init() { proto.RegisterFile("evermint/cpc/v1/genesis.proto", fileDescriptor_bd3704f3b12e5567) }
 
 var fileDescriptor_bd3704f3b12e5567 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xd1, 0x41, 0x4f, 0xc2, 0x30,
-	0x14, 0x07, 0xf0, 0x15, 0x0c, 0xd1, 0xaa, 0x41, 0x27, 0x84, 0xc5, 0xc4, 0x49, 0xb8, 0x88, 0x97,
-	0x4e, 0x20, 0x7a, 0x17, 0x25, 0xde, 0x8c, 0x19, 0x89, 0x07, 0x2f, 0x4b, 0x29, 0x2f, 0xa3, 0x11,
-	0xda, 0xa5, 0xad, 0x53, 0xbe, 0x85, 0x5f, 0xc4, 0xef, 0xc1, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0x8b,
-	0x18, 0x5a, 0x30, 0xc6, 0xdb, 0xf2, 0x7e, 0xff, 0xf7, 0xf6, 0x9a, 0x87, 0x4f, 0x20, 0x07, 0x35,
-	0xe1, 0xc2, 0x44, 0x2c, 0x63, 0x51, 0xde, 0x8a, 0x52, 0x10, 0xa0, 0xb9, 0x26, 0x99, 0x92, 0x46,
-	0xfa, 0xe5, 0x0d, 0x13, 0x96, 0x31, 0x92, 0xb7, 0x8e, 0x2b, 0xa9, 0x4c, 0xa5, 0xb5, 0x68, 0xf5,
-	0xe5, 0x62, 0x8d, 0x0f, 0x84, 0xf7, 0xee, 0x5c, 0x63, 0xdf, 0x50, 0x03, 0xfe, 0x25, 0x2e, 0x65,
-	0x54, 0xd1, 0x89, 0x0e, 0x50, 0x1d, 0x35, 0x77, 0xdb, 0x35, 0xf2, 0x6f, 0x10, 0x79, 0xb0, 0xdc,
-	0xdd, 0x9a, 0x7d, 0x9d, 0x7a, 0xf1, 0x3a, 0xec, 0x13, 0x7c, 0x34, 0x84, 0x6c, 0x2c, 0xa7, 0x09,
-	0x28, 0xd6, 0xbe, 0x48, 0x04, 0x35, 0x3c, 0x87, 0xa0, 0x50, 0x47, 0xcd, 0xed, 0xf8, 0xd0, 0x51,
-	0x6f, 0x25, 0xf7, 0x16, 0xfc, 0x2b, 0x5c, 0x5b, 0xe7, 0xb5, 0xa1, 0xcf, 0x5c, 0xa4, 0x09, 0x93,
-	0xc2, 0x28, 0xca, 0x4c, 0x50, 0xb4, 0x3d, 0x55, 0xc7, 0x7d, 0xa7, 0x37, 0x6b, 0x6c, 0x8c, 0x70,
-	0xc9, 0xfd, 0xdf, 0x3f, 0xc7, 0x07, 0xf6, 0x09, 0x4c, 0x8e, 0x93, 0x1c, 0x94, 0xe6, 0x52, 0xd8,
-	0x95, 0xf7, 0xe3, 0xf2, 0xa6, 0xfe, 0xe8, 0xca, 0x7e, 0x07, 0x57, 0x5f, 0x47, 0xdc, 0xc0, 0x98,
-	0x6b, 0x03, 0xc3, 0xc4, 0x4d, 0x06, 0xa5, 0x83, 0x42, 0xbd, 0xd8, 0xdc, 0x89, 0x2b, 0x7f, 0xf0,
-	0x76, 0x63, 0xdd, 0xeb, 0xd9, 0x22, 0x44, 0xf3, 0x45, 0x88, 0xbe, 0x17, 0x21, 0x7a, 0x5f, 0x86,
-	0xde, 0x7c, 0x19, 0x7a, 0x9f, 0xcb, 0xd0, 0x7b, 0x3a, 0x4b, 0xb9, 0x19, 0xbd, 0x0c, 0x08, 0x93,
-	0x93, 0xa8, 0xa7, 0x19, 0x15, 0xdd, 0x5e, 0xf4, 0x7b, 0x8c, 0x37, 0x7b, 0x0e, 0x33, 0xcd, 0x40,
-	0x0f, 0x4a, 0x76, 0x91, 0xce, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xbe, 0xd8, 0x93, 0xab,
-	0x01, 0x00, 0x00,
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xe3, 0x36,
+	0x14, 0xb5, 0xe2, 0xc7, 0x8c, 0x39, 0x4e, 0x6c, 0x33, 0x36, 0x46, 0x08, 0x10, 0x8f, 0xeb, 0x4e,
+	0x31, 0xee, 0x46, 0x9e, 0x64, 0xd0, 0xee, 0xed, 0x8c, 0x11, 0xcc, 0xc2, 0x41, 0x20, 0x07, 0x5d,
+	0x04, 0x28, 0x04, 0x9a, 0xba, 0xb0, 0x85, 0x48, 0xa4, 0x20, 0xd2, 0x4e, 0xfd, 0x17, 0x5d, 0xf5,
+	0x53, 0xba, 0xe9, 0x0f, 0x64, 0x99, 0x65, 0xd1, 0x45, 0x50, 0x24, 0xdf, 0xd0, 0x7d, 0xc1, 0x87,
+	0x9c, 0x34, 0x4e, 0x37, 0xb3, 0x13, 0xcf, 0x39, 0xbc, 0x3c, 0xe4, 0x3d, 0x57, 0xe8, 0x10, 0x56,
+	0x90, 0x25, 0x11, 0x93, 0x03, 0x9a, 0xd2, 0xc1, 0xea, 0x68, 0x30, 0x07, 0x06, 0x22, 0x12, 0x5e,
+	0x9a, 0x71, 0xc9, 0x71, 0x3d, 0xa7, 0x3d, 0x9a, 0x52, 0x6f, 0x75, 0x74, 0xd0, 0x9a, 0xf3, 0x39,
+	0xd7, 0xdc, 0x40, 0x7d, 0x19, 0xd9, 0xc1, 0x37, 0xcf, 0xab, 0xa4, 0x19, 0x50, 0x9e, 0xa4, 0x51,
+	0x0c, 0xb6, 0x52, 0xef, 0x9f, 0x22, 0xaa, 0x9d, 0x9a, 0xda, 0x53, 0x49, 0x24, 0xe0, 0x1f, 0x50,
+	0x25, 0x25, 0x19, 0x49, 0x84, 0xeb, 0x74, 0x9d, 0xfe, 0x9b, 0xe3, 0xb7, 0xde, 0xb3, 0xb3, 0xbc,
+	0x73, 0x4d, 0x8f, 0x4a, 0x37, 0x77, 0xef, 0x0a, 0xbe, 0x15, 0x63, 0x0f, 0xed, 0x87, 0x90, 0xc6,
+	0x7c, 0x1d, 0x40, 0x46, 0x8f, 0x3f, 0x06, 0x8c, 0xc8, 0x68, 0x05, 0xee, 0x4e, 0xd7, 0xe9, 0xbf,
+	0xf6, 0x9b, 0x86, 0x1a, 0x2b, 0xe6, 0x4c, 0x13, 0xf8, 0x47, 0xf4, 0xd6, 0xea, 0x85, 0x24, 0x57,
+	0x11, 0x9b, 0x07, 0x94, 0x33, 0x99, 0x11, 0x2a, 0xdd, 0xa2, 0xde, 0xd3, 0x36, 0xf4, 0xd4, 0xb0,
+	0x27, 0x96, 0xc4, 0x14, 0x61, 0x43, 0x40, 0xb8, 0xd9, 0x21, 0xdc, 0x52, 0xb7, 0xd8, 0x7f, 0x73,
+	0xec, 0x6d, 0x59, 0x3d, 0x59, 0x0a, 0xc9, 0x93, 0xf3, 0xcd, 0xad, 0xc3, 0xbc, 0xce, 0x04, 0x24,
+	0xb1, 0x37, 0x68, 0xe6, 0xf5, 0x72, 0x4e, 0xe0, 0x29, 0x6a, 0x98, 0x5b, 0x90, 0x38, 0xe6, 0xd7,
+	0x84, 0x51, 0x10, 0x6e, 0x59, 0x1f, 0xd1, 0xdb, 0x3a, 0x42, 0x5f, 0xea, 0x24, 0xa5, 0xc3, 0x5c,
+	0x6a, 0xcb, 0xd6, 0x75, 0x85, 0x0d, 0x2a, 0xf0, 0x47, 0xd4, 0x4a, 0x78, 0xb8, 0x8c, 0x21, 0x20,
+	0x94, 0xf2, 0x25, 0x93, 0x01, 0xe3, 0x8c, 0x82, 0x5b, 0xe9, 0x3a, 0xfd, 0x92, 0x8f, 0x0d, 0x37,
+	0x34, 0xd4, 0x99, 0x62, 0xf0, 0x25, 0xda, 0x37, 0x36, 0x52, 0x75, 0xa4, 0xd5, 0x0b, 0xf7, 0x95,
+	0x76, 0xf2, 0xfe, 0x7f, 0x9d, 0x9c, 0x6b, 0xf5, 0x19, 0x7f, 0xf4, 0xd2, 0xd4, 0x65, 0x9e, 0xe0,
+	0xa2, 0xf7, 0x9b, 0x83, 0x9a, 0x5b, 0xd6, 0xf1, 0x01, 0x7a, 0xbd, 0x69, 0x83, 0x6a, 0x7f, 0xd5,
+	0xdf, 0xac, 0x71, 0x0b, 0x95, 0xf9, 0x35, 0x83, 0x4c, 0xf7, 0xb4, 0xea, 0x9b, 0x05, 0x76, 0xd1,
+	0x2b, 0x91, 0x02, 0x0b, 0x21, 0xd3, 0x7d, 0xab, 0xfa, 0xf9, 0x52, 0x05, 0x89, 0x24, 0xea, 0x32,
+	0x6e, 0x49, 0x11, 0xa3, 0x43, 0x65, 0xe5, 0xaf, 0xbb, 0x77, 0x6d, 0xca, 0x45, 0xc2, 0x85, 0x08,
+	0xaf, 0xbc, 0x88, 0x0f, 0x12, 0x22, 0x17, 0xde, 0x17, 0x26, 0x7d, 0x2b, 0xee, 0xfd, 0xb1, 0x83,
+	0x2a, 0x26, 0x61, 0xf8, 0x7b, 0xd4, 0xd0, 0x21, 0xa5, 0x3c, 0x0e, 0x56, 0x90, 0x89, 0x88, 0x33,
+	0xed, 0x6a, 0xd7, 0xaf, 0xe7, 0xf8, 0x4f, 0x06, 0xc6, 0x9f, 0x50, 0xfb, 0x7a, 0x11, 0x49, 0x88,
+	0x23, 0x21, 0x21, 0x0c, 0x6c, 0x4b, 0x33, 0xe1, 0xee, 0x74, 0x8b, 0xfd, 0xaa, 0xdf, 0x7a, 0x42,
+	0x7e, 0xce, 0x39, 0x3c, 0x41, 0x8d, 0x04, 0xe4, 0x82, 0x87, 0xc1, 0x9c, 0x88, 0x40, 0x92, 0x59,
+	0x0c, 0x6e, 0x51, 0x3f, 0xee, 0xe1, 0x76, 0x92, 0x52, 0x3a, 0xd1, 0xda, 0x53, 0x92, 0x47, 0x7f,
+	0x2f, 0xc9, 0x81, 0x0b, 0xb5, 0x55, 0x8f, 0xc0, 0x9a, 0x91, 0x24, 0xa2, 0xba, 0x1e, 0x30, 0x85,
+	0x86, 0x6e, 0xc9, 0x8e, 0x80, 0xa1, 0x4e, 0x89, 0x18, 0x1b, 0x02, 0xfb, 0xa8, 0x69, 0x53, 0xb6,
+	0x94, 0xdc, 0x5a, 0x76, 0xcb, 0x7a, 0xe8, 0xba, 0x2f, 0x37, 0x77, 0xb8, 0x94, 0xdc, 0xd8, 0xff,
+	0x6f, 0xc8, 0x36, 0x70, 0xef, 0x77, 0x07, 0xd5, 0x9f, 0x49, 0xf1, 0x7b, 0xb4, 0xc7, 0x59, 0x10,
+	0xcd, 0x68, 0x90, 0x01, 0x05, 0x35, 0x95, 0x8e, 0xb6, 0x54, 0xe3, 0xec, 0xcb, 0x8c, 0xfa, 0x06,
+	0xc3, 0x47, 0xa8, 0xcd, 0x59, 0x10, 0x02, 0xe3, 0x49, 0x90, 0x80, 0x24, 0x21, 0x91, 0x24, 0x10,
+	0x20, 0xed, 0x08, 0x63, 0xce, 0x3e, 0x2b, 0x6e, 0x62, 0xa9, 0x29, 0x48, 0xfc, 0x1d, 0xda, 0xd3,
+	0x03, 0xa2, 0x1f, 0x9c, 0xf1, 0x44, 0xe8, 0xd7, 0xab, 0xfa, 0xbb, 0x16, 0xd5, 0x1b, 0x04, 0xfe,
+	0x16, 0xed, 0x86, 0xc0, 0xa2, 0x47, 0x55, 0x49, 0xab, 0x6a, 0x06, 0x34, 0xa2, 0x1e, 0x43, 0xb5,
+	0xa7, 0x4f, 0xac, 0x36, 0xe5, 0xc9, 0x0b, 0xe4, 0x3a, 0x05, 0xdb, 0xf8, 0x5a, 0x0e, 0x5e, 0xac,
+	0x53, 0xc0, 0x1f, 0x50, 0xdd, 0x36, 0x50, 0x40, 0x0c, 0x54, 0xf2, 0x3c, 0x9c, 0xb6, 0x35, 0x53,
+	0x8b, 0xe2, 0x06, 0x2a, 0xce, 0x89, 0xd0, 0x09, 0x2d, 0xf9, 0xea, 0xb3, 0xf7, 0x33, 0xda, 0x7f,
+	0x61, 0x5e, 0xbe, 0x62, 0x00, 0x5a, 0xa8, 0x6c, 0xe6, 0xd8, 0x14, 0x37, 0x8b, 0xd1, 0xf0, 0xe6,
+	0xbe, 0xe3, 0xdc, 0xde, 0x77, 0x9c, 0xbf, 0xef, 0x3b, 0xce, 0xaf, 0x0f, 0x9d, 0xc2, 0xed, 0x43,
+	0xa7, 0xf0, 0xe7, 0x43, 0xa7, 0x70, 0xf9, 0x61, 0x1e, 0xc9, 0xc5, 0x72, 0xe6, 0x51, 0x9e, 0x0c,
+	0xc6, 0x82, 0x12, 0x36, 0x1a, 0x0f, 0x36, 0xbf, 0xe9, 0x5f, 0xf4, 0x8f, 0x5a, 0xdd, 0x57, 0xcc,
+	0x2a, 0x3a, 0xe3, 0x9f, 0xfe, 0x1d, 0x00, 0x8d, 0xd4, 0xeb, 0xc5, 0x0b, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +565,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Erc20PermitNonces) > 0 {
+		for iNdEx := len(m.Erc20PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ModuleAccountNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ModuleAccountNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Erc20Allowances) > 0 {
+		for iNdEx := len(m.Erc20Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DeployedContracts) > 0 {
+		for iNdEx := len(m.DeployedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeployedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.DeployStakingContract {
 		i--
 		if m.DeployStakingContract {
@@ -228,6 +645,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Erc20CpcAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Erc20CpcAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Erc20CpcAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -248,6 +719,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Erc20AutoDeploy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.DynamicGasEnabled {
+		i--
+		if m.DynamicGasEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MethodGasTable) > 0 {
+		for iNdEx := len(m.MethodGasTable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MethodGasTable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.WhitelistedDeployers) > 0 {
 		for iNdEx := len(m.WhitelistedDeployers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.WhitelistedDeployers[iNdEx])
@@ -265,13 +770,156 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Erc20AutoDeploy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Erc20AutoDeploy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Erc20AutoDeploy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OnDenomMetadataSet {
+		i--
+		if m.OnDenomMetadataSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OnIbcReceive {
+		i--
+		if m.OnIbcReceive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CpcMethodGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CpcMethodGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CpcMethodGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MethodSelector) > 0 {
+		i -= len(m.MethodSelector)
+		copy(dAtA[i:], m.MethodSelector)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MethodSelector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Erc20CpcPermitNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Erc20CpcPermitNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Erc20CpcPermitNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -290,6 +938,50 @@ func (m *GenesisState) Size() (n int) {
 	if m.DeployStakingContract {
 		n += 2
 	}
+	if len(m.DeployedContracts) > 0 {
+		for _, e := range m.DeployedContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Allowances) > 0 {
+		for _, e := range m.Erc20Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ModuleAccountNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.ModuleAccountNonce))
+	}
+	if len(m.Erc20PermitNonces) > 0 {
+		for _, e := range m.Erc20PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Erc20CpcAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -308,16 +1000,705 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.MethodGasTable) > 0 {
+		for _, e := range m.MethodGasTable {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DynamicGasEnabled {
+		n += 2
+	}
+	l = m.Erc20AutoDeploy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Erc20AutoDeploy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OnIbcReceive {
+		n += 2
+	}
+	if m.OnDenomMetadataSet {
+		n += 2
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CpcMethodGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractType != 0 {
+		n += 1 + sovGenesis(uint64(m.ContractType))
+	}
+	l = len(m.MethodSelector)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovGenesis(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *Erc20CpcPermitNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployErc20Native", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeployErc20Native = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployStakingContract", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeployStakingContract = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployedContracts = append(m.DeployedContracts, CustomPrecompiledContractMeta{})
+			if err := m.DeployedContracts[len(m.DeployedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Allowances = append(m.Erc20Allowances, Erc20CpcAllowance{})
+			if err := m.Erc20Allowances[len(m.Erc20Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountNonce", wireType)
+			}
+			m.ModuleAccountNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModuleAccountNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20PermitNonces = append(m.Erc20PermitNonces, Erc20CpcPermitNonce{})
+			if err := m.Erc20PermitNonces[len(m.Erc20PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Erc20CpcAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Erc20CpcAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Erc20CpcAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDeployers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedDeployers = append(m.WhitelistedDeployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodGasTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodGasTable = append(m.MethodGasTable, CpcMethodGas{})
+			if err := m.MethodGasTable[len(m.MethodGasTable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicGasEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicGasEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20AutoDeploy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20AutoDeploy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *Erc20AutoDeploy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -340,17 +1721,57 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Erc20AutoDeploy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Erc20AutoDeploy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnIbcReceive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnIbcReceive = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDenomMetadataSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnDenomMetadataSet = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -360,30 +1781,111 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CpcMethodGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CpcMethodGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CpcMethodGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployErc20Native", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
 			}
-			var v int
+			m.ContractType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -393,17 +1895,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ContractType |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeployErc20Native = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployStakingContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			var v int
+			m.Gas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -413,12 +1946,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Gas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeployStakingContract = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -440,7 +1972,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Erc20CpcPermitNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -463,17 +1995,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Erc20CpcPermitNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Erc20CpcPermitNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.ProtocolVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -483,14 +2015,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDeployers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -518,8 +2063,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedDeployers = append(m.WhitelistedDeployers, string(dAtA[iNdEx:postIndex]))
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,808 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/evermint/constants"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	erc20Address := common.BytesToAddress([]byte("erc20"))
+	owner := common.BytesToAddress([]byte("owner"))
+	spender := common.BytesToAddress([]byte("spender"))
+
+	erc20Contract := func(address common.Address, minDenom string) CustomPrecompiledContractMeta {
+		bz, err := json.Marshal(Erc20CustomPrecompiledContractMeta{
+			Symbol:   constants.DisplayDenom,
+			Decimals: constants.BaseDenomExponent,
+			MinDenom: minDenom,
+		})
+		require.NoError(t, err)
+
+		return CustomPrecompiledContractMeta{
+			Address:               address.Bytes(),
+			CustomPrecompiledType: CpcTypeErc20,
+			Name:                  constants.DisplayDenom,
+			TypedMeta:             string(bz),
+		}
+	}
+
+	stakingContract := func(address common.Address) CustomPrecompiledContractMeta {
+		bz, err := json.Marshal(StakingCustomPrecompiledContractMeta{
+			Symbol:   constants.DisplayDenom,
+			Decimals: constants.BaseDenomExponent,
+		})
+		require.NoError(t, err)
+
+		return CustomPrecompiledContractMeta{
+			Address:               address.Bytes(),
+			CustomPrecompiledType: CpcTypeStaking,
+			Name:                  "Staking",
+			TypedMeta:             string(bz),
+		}
+	}
+
+	bech32Contract := CustomPrecompiledContractMeta{
+		Address:               CpcBech32FixedAddress.Bytes(),
+		CustomPrecompiledType: CpcTypeBech32,
+		Name:                  "Bech32",
+		TypedMeta:             EmptyTypedMeta,
+	}
+
+	govContract := CustomPrecompiledContractMeta{
+		Address:               CpcGovFixedAddress.Bytes(),
+		CustomPrecompiledType: CpcTypeGov,
+		Name:                  "Gov",
+		TypedMeta:             EmptyTypedMeta,
+	}
+
+	ibcTransferContract := CustomPrecompiledContractMeta{
+		Address:               CpcIbcTransferFixedAddress.Bytes(),
+		CustomPrecompiledType: CpcTypeIbcTransfer,
+		Name:                  "IBC Transfer",
+		TypedMeta:             EmptyTypedMeta,
+	}
+
+	bankContract := CustomPrecompiledContractMeta{
+		Address:               CpcBankFixedAddress.Bytes(),
+		CustomPrecompiledType: CpcTypeBank,
+		Name:                  "Bank",
+		TypedMeta:             EmptyTypedMeta,
+	}
+
+	distributionContract := CustomPrecompiledContractMeta{
+		Address:               CpcDistributionFixedAddress.Bytes(),
+		CustomPrecompiledType: CpcTypeDistribution,
+		Name:                  "Distribution",
+		TypedMeta:             EmptyTypedMeta,
+	}
+
+	vestingContract := CustomPrecompiledContractMeta{
+		Address:               CpcVestingFixedAddress.Bytes(),
+		CustomPrecompiledType: CpcTypeVesting,
+		Name:                  "Vesting",
+		TypedMeta:             EmptyTypedMeta,
+	}
+
+	vauthContract := CustomPrecompiledContractMeta{
+		Address:               CpcVAuthFixedAddress.Bytes(),
+		CustomPrecompiledType: CpcTypeVAuth,
+		Name:                  "VAuth",
+		TypedMeta:             EmptyTypedMeta,
+	}
+
+	validAllowance := Erc20CpcAllowance{
+		Contract: erc20Address.Hex(),
+		Owner:    owner.Hex(),
+		Spender:  spender.Hex(),
+		Amount:   sdkmath.NewInt(1),
+	}
+
+	validPermitNonce := Erc20CpcPermitNonce{
+		Contract: erc20Address.Hex(),
+		Owner:    owner.Hex(),
+		Nonce:    1,
+	}
+
+	tests := []struct {
+		name            string
+		genesis         GenesisState
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "pass - default genesis",
+			genesis: *DefaultGenesis(),
+			wantErr: false,
+		},
+		{
+			name: "pass - full genesis",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+					stakingContract(CpcStakingFixedAddress),
+					bech32Contract,
+					govContract,
+					ibcTransferContract,
+					bankContract,
+					distributionContract,
+					vestingContract,
+					vauthContract,
+				},
+				Erc20Allowances:    []Erc20CpcAllowance{validAllowance},
+				ModuleAccountNonce: 1,
+				Erc20PermitNonces:  []Erc20CpcPermitNonce{validPermitNonce},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - invalid params",
+			genesis: GenesisState{
+				Params: Params{},
+			},
+			wantErr:         true,
+			wantErrContains: "protocol version cannot be zero",
+		},
+		{
+			name: "fail - invalid contract",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					func() CustomPrecompiledContractMeta {
+						contract := erc20Contract(erc20Address, constants.BaseDenom)
+						contract.Name = ""
+						return contract
+					}(),
+				},
+				ModuleAccountNonce: 1,
+			},
+			wantErr:         true,
+			wantErrContains: "contract name cannot be empty",
+		},
+		{
+			name: "fail - duplicate contract address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+					erc20Contract(erc20Address, "uother"),
+				},
+				ModuleAccountNonce: 2,
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate deployed contract address",
+		},
+		{
+			name: "fail - duplicate ERC20 min denom",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+					erc20Contract(common.BytesToAddress([]byte("other")), constants.BaseDenom),
+				},
+				ModuleAccountNonce: 2,
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate ERC20 contract for",
+		},
+		{
+			name: "fail - native ERC20 contract must not be deployed again",
+			genesis: GenesisState{
+				Params:            DefaultParams(),
+				DeployErc20Native: true,
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+				},
+				ModuleAccountNonce: 1,
+			},
+			wantErr:         true,
+			wantErrContains: "must not deploy the native ERC20 contract again",
+		},
+		{
+			name: "fail - native ERC20 contract must not be deployed along with ERC20 contract of other denom, it might be the bond denom",
+			genesis: GenesisState{
+				Params:            DefaultParams(),
+				DeployErc20Native: true,
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, "uatom"),
+				},
+				ModuleAccountNonce: 1,
+			},
+			wantErr:         true,
+			wantErrContains: "must not deploy the native ERC20 contract again",
+		},
+		{
+			name: "pass - native ERC20 contract can be deployed along with non-ERC20 contracts",
+			genesis: GenesisState{
+				Params:            DefaultParams(),
+				DeployErc20Native: true,
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					stakingContract(CpcStakingFixedAddress),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - staking contract must be at fixed address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					stakingContract(common.BytesToAddress([]byte("staking"))),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "staking contract must be deployed at",
+		},
+		{
+			name: "fail - staking contract must not be deployed again",
+			genesis: GenesisState{
+				Params:                DefaultParams(),
+				DeployStakingContract: true,
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					stakingContract(CpcStakingFixedAddress),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "staking contract is already deployed",
+		},
+		{
+			name: "fail - bech32 contract must be at fixed address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					func() CustomPrecompiledContractMeta {
+						contract := bech32Contract
+						contract.Address = common.BytesToAddress([]byte("bech32")).Bytes()
+						return contract
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "bech32 contract must be deployed at",
+		},
+		{
+			name: "fail - gov contract must be at fixed address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					func() CustomPrecompiledContractMeta {
+						contract := govContract
+						contract.Address = common.BytesToAddress([]byte("gov")).Bytes()
+						return contract
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "gov contract must be deployed at",
+		},
+		{
+			name: "fail - IBC transfer contract must be at fixed address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					func() CustomPrecompiledContractMeta {
+						contract := ibcTransferContract
+						contract.Address = common.BytesToAddress([]byte("ibc-transfer")).Bytes()
+						return contract
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "IBC transfer contract must be deployed at",
+		},
+		{
+			name: "fail - bank contract must be at fixed address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					func() CustomPrecompiledContractMeta {
+						contract := bankContract
+						contract.Address = common.BytesToAddress([]byte("bank")).Bytes()
+						return contract
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "bank contract must be deployed at",
+		},
+		{
+			name: "fail - distribution contract must be at fixed address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					func() CustomPrecompiledContractMeta {
+						contract := distributionContract
+						contract.Address = common.BytesToAddress([]byte("distribution")).Bytes()
+						return contract
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "distribution contract must be deployed at",
+		},
+		{
+			name: "fail - vesting contract must be at fixed address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					func() CustomPrecompiledContractMeta {
+						contract := vestingContract
+						contract.Address = common.BytesToAddress([]byte("vesting")).Bytes()
+						return contract
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "vesting contract must be deployed at",
+		},
+		{
+			name: "fail - vauth contract must be at fixed address",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					func() CustomPrecompiledContractMeta {
+						contract := vauthContract
+						contract.Address = common.BytesToAddress([]byte("vauth")).Bytes()
+						return contract
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "vauth contract must be deployed at",
+		},
+		{
+			name: "fail - module account nonce is less than number of ERC20 contracts",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+				},
+				ModuleAccountNonce: 0,
+			},
+			wantErr:         true,
+			wantErrContains: "module account nonce 0 is less than number of ERC20 contracts 1",
+		},
+		{
+			name: "fail - allowance of non-existing contract",
+			genesis: GenesisState{
+				Params:             DefaultParams(),
+				Erc20Allowances:    []Erc20CpcAllowance{validAllowance},
+				ModuleAccountNonce: 1,
+			},
+			wantErr:         true,
+			wantErrContains: "allowance of non-existing ERC20 contract",
+		},
+		{
+			name: "fail - allowance of non-ERC20 contract",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					bech32Contract,
+				},
+				Erc20Allowances: []Erc20CpcAllowance{
+					func() Erc20CpcAllowance {
+						allowance := validAllowance
+						allowance.Contract = CpcBech32FixedAddress.Hex()
+						return allowance
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "allowance of non-existing ERC20 contract",
+		},
+		{
+			name: "fail - duplicate allowance",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+				},
+				Erc20Allowances:    []Erc20CpcAllowance{validAllowance, validAllowance},
+				ModuleAccountNonce: 1,
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate allowance",
+		},
+		{
+			name: "fail - invalid allowance",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+				},
+				Erc20Allowances: []Erc20CpcAllowance{
+					func() Erc20CpcAllowance {
+						allowance := validAllowance
+						allowance.Amount = sdkmath.ZeroInt()
+						return allowance
+					}(),
+				},
+				ModuleAccountNonce: 1,
+			},
+			wantErr:         true,
+			wantErrContains: "allowance amount must be positive",
+		},
+		{
+			name: "fail - permit nonce of non-existing contract",
+			genesis: GenesisState{
+				Params:            DefaultParams(),
+				Erc20PermitNonces: []Erc20CpcPermitNonce{validPermitNonce},
+			},
+			wantErr:         true,
+			wantErrContains: "permit nonce of non-existing ERC20 contract",
+		},
+		{
+			name: "fail - duplicate permit nonce",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+				},
+				ModuleAccountNonce: 1,
+				Erc20PermitNonces:  []Erc20CpcPermitNonce{validPermitNonce, validPermitNonce},
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate permit nonce",
+		},
+		{
+			name: "fail - zero permit nonce",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				DeployedContracts: []CustomPrecompiledContractMeta{
+					erc20Contract(erc20Address, constants.BaseDenom),
+				},
+				ModuleAccountNonce: 1,
+				Erc20PermitNonces: []Erc20CpcPermitNonce{
+					func() Erc20CpcPermitNonce {
+						permitNonce := validPermitNonce
+						permitNonce.Nonce = 0
+						return permitNonce
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "permit nonce must be positive",
+		},
+		{
+			name: "pass - method gas table",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.MethodGasTable = []CpcMethodGas{
+						{ContractType: CpcTypeStaking, MethodSelector: "0x5c19a95c", Gas: 500_000},
+						{ContractType: CpcTypeErc20, MethodSelector: "0x5c19a95c", Gas: 1},
+					}
+					return params
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - method gas of unsupported contract type",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.MethodGasTable = []CpcMethodGas{
+						{ContractType: 0, MethodSelector: "0x5c19a95c", Gas: 500_000},
+					}
+					return params
+				}(),
+			},
+			wantErr:         true,
+			wantErrContains: "unsupported custom precompiled type",
+		},
+		{
+			name: "fail - method gas with invalid method selector",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.MethodGasTable = []CpcMethodGas{
+						{ContractType: CpcTypeStaking, MethodSelector: "0x5C19A95C", Gas: 500_000},
+					}
+					return params
+				}(),
+			},
+			wantErr:         true,
+			wantErrContains: "method selector must be 0x-prefixed lowercase hex of 4 bytes",
+		},
+		{
+			name: "fail - method gas with non-hex method selector",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.MethodGasTable = []CpcMethodGas{
+						{ContractType: CpcTypeStaking, MethodSelector: "0x5c19a9zz", Gas: 500_000},
+					}
+					return params
+				}(),
+			},
+			wantErr:         true,
+			wantErrContains: "method selector is not a valid hex",
+		},
+		{
+			name: "fail - method gas with zero gas",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.MethodGasTable = []CpcMethodGas{
+						{ContractType: CpcTypeStaking, MethodSelector: "0x5c19a95c", Gas: 0},
+					}
+					return params
+				}(),
+			},
+			wantErr:         true,
+			wantErrContains: "gas must be positive",
+		},
+		{
+			name: "fail - duplicated method gas",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.MethodGasTable = []CpcMethodGas{
+						{ContractType: CpcTypeStaking, MethodSelector: "0x5c19a95c", Gas: 500_000},
+						{ContractType: CpcTypeStaking, MethodSelector: "0x5c19a95c", Gas: 600_000},
+					}
+					return params
+				}(),
+			},
+			wantErr:         true,
+			wantErrContains: "duplicated method gas #1",
+		},
+		{
+			name: "pass - ERC20 auto deploy",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.Erc20AutoDeploy = Erc20AutoDeploy{
+						OnIbcReceive:       true,
+						OnDenomMetadataSet: true,
+						AllowedDenoms:      []string{"ibc/*", "uatom"},
+						DeniedDenoms:       []string{"*"},
+					}
+					return params
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - ERC20 auto deploy with wildcard not at the end",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.Erc20AutoDeploy = Erc20AutoDeploy{
+						AllowedDenoms: []string{"ibc/*/transfer"},
+					}
+					return params
+				}(),
+			},
+			wantErr:         true,
+			wantErrContains: "invalid denom pattern",
+		},
+		{
+			name: "fail - ERC20 auto deploy with invalid denom",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.Erc20AutoDeploy = Erc20AutoDeploy{
+						DeniedDenoms: []string{"1atom"},
+					}
+					return params
+				}(),
+			},
+			wantErr:         true,
+			wantErrContains: "invalid denom pattern",
+		},
+		{
+			name: "fail - ERC20 auto deploy with duplicated pattern",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.Erc20AutoDeploy = Erc20AutoDeploy{
+						AllowedDenoms: []string{"ibc/*", "ibc/*"},
+					}
+					return params
+				}(),
+			},
+			wantErr:         true,
+			wantErrContains: "duplicated denom pattern",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.genesis.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestErc20CpcAllowance_Validate(t *testing.T) {
+	contract := common.BytesToAddress([]byte("contract")).Hex()
+	owner := common.BytesToAddress([]byte("owner")).Hex()
+	spender := common.BytesToAddress([]byte("spender")).Hex()
+
+	tests := []struct {
+		name            string
+		allowance       Erc20CpcAllowance
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid",
+			allowance: Erc20CpcAllowance{
+				Contract: contract,
+				Owner:    owner,
+				Spender:  spender,
+				Amount:   sdkmath.NewInt(1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - max uint256",
+			allowance: Erc20CpcAllowance{
+				Contract: contract,
+				Owner:    owner,
+				Spender:  spender,
+				Amount:   sdkmath.NewIntFromBigInt(BigMaxUint256),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - invalid contract address",
+			allowance: Erc20CpcAllowance{
+				Contract: "0x1",
+				Owner:    owner,
+				Spender:  spender,
+				Amount:   sdkmath.NewInt(1),
+			},
+			wantErr:         true,
+			wantErrContains: "invalid contract address",
+		},
+		{
+			name: "fail - zero contract address",
+			allowance: Erc20CpcAllowance{
+				Contract: common.Address{}.Hex(),
+				Owner:    owner,
+				Spender:  spender,
+				Amount:   sdkmath.NewInt(1),
+			},
+			wantErr:         true,
+			wantErrContains: "contract address cannot be zero",
+		},
+		{
+			name: "fail - invalid owner address",
+			allowance: Erc20CpcAllowance{
+				Contract: contract,
+				Owner:    "owner",
+				Spender:  spender,
+				Amount:   sdkmath.NewInt(1),
+			},
+			wantErr:         true,
+			wantErrContains: "invalid owner address",
+		},
+		{
+			name: "fail - invalid spender address",
+			allowance: Erc20CpcAllowance{
+				Contract: contract,
+				Owner:    owner,
+				Spender:  "spender",
+				Amount:   sdkmath.NewInt(1),
+			},
+			wantErr:         true,
+			wantErrContains: "invalid spender address",
+		},
+		{
+			name: "fail - nil amount",
+			allowance: Erc20CpcAllowance{
+				Contract: contract,
+				Owner:    owner,
+				Spender:  spender,
+			},
+			wantErr:         true,
+			wantErrContains: "allowance amount must be positive",
+		},
+		{
+			name: "fail - negative amount",
+			allowance: Erc20CpcAllowance{
+				Contract: contract,
+				Owner:    owner,
+				Spender:  spender,
+				Amount:   sdkmath.NewInt(-1),
+			},
+			wantErr:         true,
+			wantErrContains: "allowance amount must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.allowance.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParams_GetMethodGas(t *testing.T) {
+	params := DefaultParams()
+	params.MethodGasTable = []CpcMethodGas{
+		{ContractType: CpcTypeStaking, MethodSelector: "0x5c19a95c", Gas: 500_000},
+	}
+
+	gas, found := params.GetMethodGas(CpcTypeStaking, []byte{0x5c, 0x19, 0xa9, 0x5c})
+	require.True(t, found)
+	require.Equal(t, uint64(500_000), gas)
+
+	_, found = params.GetMethodGas(CpcTypeErc20, []byte{0x5c, 0x19, 0xa9, 0x5c})
+	require.False(t, found)
+
+	_, found = params.GetMethodGas(CpcTypeStaking, []byte{0x5c, 0x19, 0xa9, 0x5d})
+	require.False(t, found)
+}
+
+func TestErc20AutoDeploy_IsDenomAllowed(t *testing.T) {
+	const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	tests := []struct {
+		name       string
+		autoDeploy Erc20AutoDeploy
+		denom      string
+		want       bool
+	}{
+		{
+			name:       "allowed all when empty",
+			autoDeploy: Erc20AutoDeploy{},
+			denom:      ibcDenom,
+			want:       true,
+		},
+		{
+			name:       "allowed by prefix",
+			autoDeploy: Erc20AutoDeploy{AllowedDenoms: []string{"ibc/*"}},
+			denom:      ibcDenom,
+			want:       true,
+		},
+		{
+			name:       "allowed by exact denom",
+			autoDeploy: Erc20AutoDeploy{AllowedDenoms: []string{"uatom", ibcDenom}},
+			denom:      ibcDenom,
+			want:       true,
+		},
+		{
+			name:       "not in allowed list",
+			autoDeploy: Erc20AutoDeploy{AllowedDenoms: []string{"factory/*"}},
+			denom:      ibcDenom,
+			want:       false,
+		},
+		{
+			name:       "denied takes precedence",
+			autoDeploy: Erc20AutoDeploy{AllowedDenoms: []string{"ibc/*"}, DeniedDenoms: []string{ibcDenom}},
+			denom:      ibcDenom,
+			want:       false,
+		},
+		{
+			name:       "denied all",
+			autoDeploy: Erc20AutoDeploy{DeniedDenoms: []string{"*"}},
+			denom:      "uatom",
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.autoDeploy.IsDenomAllowed(tt.denom))
+		})
+	}
+}
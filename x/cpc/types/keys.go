@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -29,6 +30,8 @@ const (
 	prefixCustomPrecompiledContractMeta
 	prefixErc20CpcDenomToAddress
 	prefixErc20CpcAllowance
+	prefixErc20CpcPermitNonce
+	prefixIbcTransferCpcPacket
 )
 
 // KVStore key prefixes
@@ -37,6 +40,8 @@ var (
 	KeyPrefixCustomPrecompiledContractMeta = []byte{prefixCustomPrecompiledContractMeta}
 	KeyPrefixErc20CpcDenomToAddress        = []byte{prefixErc20CpcDenomToAddress}
 	KeyPrefixErc20CpcAllowance             = []byte{prefixErc20CpcAllowance}
+	KeyPrefixErc20CpcPermitNonce           = []byte{prefixErc20CpcPermitNonce}
+	KeyPrefixIbcTransferCpcPacket          = []byte{prefixIbcTransferCpcPacket}
 )
 
 func CustomPrecompiledContractMetaKey(contractAddr common.Address) []byte {
@@ -47,10 +52,47 @@ func Erc20CustomPrecompiledContractMinDenomToAddressKey(minDenom string) []byte
 	return append(KeyPrefixErc20CpcDenomToAddress, []byte(minDenom)...)
 }
 
-func Erc20CustomPrecompiledContractAllowanceKey(owner, spender common.Address) []byte {
+// Erc20CustomPrecompiledContractAllowanceKey returns the key of the allowance record,
+// scoped by the ERC20 custom precompiled contract so each contract has its own allowance table.
+func Erc20CustomPrecompiledContractAllowanceKey(contract, owner, spender common.Address) []byte {
+	key := make([]byte, 0, len(KeyPrefixErc20CpcAllowance)+60)
+	key = append(key, KeyPrefixErc20CpcAllowance...)
+	key = append(key, contract.Bytes()...)
+	key = append(key, owner.Bytes()...)
+	key = append(key, spender.Bytes()...)
+	return key
+}
+
+// LegacyErc20CustomPrecompiledContractAllowanceKey returns the key of the allowance record
+// which was used before allowances were scoped by contract address (consensus version 1).
+// Only used for store migration.
+func LegacyErc20CustomPrecompiledContractAllowanceKey(owner, spender common.Address) []byte {
 	key := make([]byte, 0, len(KeyPrefixErc20CpcAllowance)+40)
 	key = append(key, KeyPrefixErc20CpcAllowance...)
 	key = append(key, owner.Bytes()...)
 	key = append(key, spender.Bytes()...)
 	return key
 }
+
+// Erc20CustomPrecompiledContractPermitNonceKey returns the key of the EIP-2612 permit nonce of the owner,
+// scoped by the ERC20 custom precompiled contract.
+func Erc20CustomPrecompiledContractPermitNonceKey(contract, owner common.Address) []byte {
+	key := make([]byte, 0, len(KeyPrefixErc20CpcPermitNonce)+40)
+	key = append(key, KeyPrefixErc20CpcPermitNonce...)
+	key = append(key, contract.Bytes()...)
+	key = append(key, owner.Bytes()...)
+	return key
+}
+
+// IbcTransferCustomPrecompiledContractPacketKey returns the key of the record marks the ICS-20 packet
+// was sent by the IBC transfer custom precompiled contract.
+func IbcTransferCustomPrecompiledContractPacketKey(sourcePort, sourceChannel string, sequence uint64) []byte {
+	key := make([]byte, 0, len(KeyPrefixIbcTransferCpcPacket)+len(sourcePort)+len(sourceChannel)+10)
+	key = append(key, KeyPrefixIbcTransferCpcPacket...)
+	key = append(key, []byte(sourcePort)...)
+	key = append(key, '/')
+	key = append(key, []byte(sourceChannel)...)
+	key = append(key, '/')
+	key = append(key, sdk.Uint64ToBigEndian(sequence)...)
+	return key
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (m MsgDeployIbcTransferContractRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidAddress, err), "invalid authority address: %s", m.Authority)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (m MsgDisableCustomPrecompiledContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidAddress, err), "invalid authority address: %s", m.Authority)
+	}
+
+	return validateContractAddressHex(m.ContractAddress)
+}
+
+// validateContractAddressHex validates the given string is a non-zero ethereum hex address.
+func validateContractAddressHex(contractAddress string) error {
+	if !common.IsHexAddress(contractAddress) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address: %s", contractAddress)
+	} else if common.HexToAddress(contractAddress) == (common.Address{}) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "contract address cannot be zero")
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -12,7 +14,9 @@ import (
 
 func DefaultParams() Params {
 	return Params{
-		ProtocolVersion: uint32(LatestProtocolCpc),
+		ProtocolVersion:   uint32(LatestProtocolCpc),
+		MethodGasTable:    []CpcMethodGas{},
+		DynamicGasEnabled: true,
 	}
 }
 
@@ -37,5 +41,125 @@ func (m Params) Validate() error {
 		uniqueDeployers[deployer] = struct{}{}
 	}
 
+	uniqueMethods := make(map[string]struct{})
+	for i, methodGas := range m.MethodGasTable {
+		if err := methodGas.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid method gas #%d", i)
+		}
+
+		key := fmt.Sprintf("%d/%s", methodGas.ContractType, methodGas.MethodSelector)
+		if _, exists := uniqueMethods[key]; exists {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated method gas #%d: %s", i, key)
+		}
+		uniqueMethods[key] = struct{}{}
+	}
+
+	if err := m.Erc20AutoDeploy.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid ERC20 auto deploy")
+	}
+
+	return nil
+}
+
+// GetMethodGas returns the gas required by the method of the custom precompiled contract type, from the gas table.
+func (m Params) GetMethodGas(contractType uint32, methodSelector []byte) (gas uint64, found bool) {
+	selector := "0x" + hex.EncodeToString(methodSelector)
+	for _, methodGas := range m.MethodGasTable {
+		if methodGas.ContractType == contractType && methodGas.MethodSelector == selector {
+			return methodGas.Gas, true
+		}
+	}
+
+	return 0, false
+}
+
+func (m CpcMethodGas) Validate() error {
+	switch m.ContractType {
+	case CpcTypeErc20, CpcTypeStaking, CpcTypeBech32, CpcTypeGov, CpcTypeIbcTransfer, CpcTypeBank, CpcTypeDistribution, CpcTypeVesting, CpcTypeVAuth:
+		// valid
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported custom precompiled type %d", m.ContractType)
+	}
+
+	if len(m.MethodSelector) != 10 || !strings.HasPrefix(m.MethodSelector, "0x") || strings.ToLower(m.MethodSelector) != m.MethodSelector {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "method selector must be 0x-prefixed lowercase hex of 4 bytes: %s", m.MethodSelector)
+	}
+	if _, err := hex.DecodeString(m.MethodSelector[2:]); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "method selector is not a valid hex: %s", m.MethodSelector)
+	}
+
+	if m.Gas == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "gas must be positive")
+	}
+
+	return nil
+}
+
+func (m Erc20AutoDeploy) Validate() error {
+	for _, patterns := range [][]string{m.AllowedDenoms, m.DeniedDenoms} {
+		uniquePatterns := make(map[string]struct{})
+		for _, pattern := range patterns {
+			if err := validateDenomPattern(pattern); err != nil {
+				return err
+			}
+			if _, exists := uniquePatterns[pattern]; exists {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated denom pattern: %s", pattern)
+			}
+			uniquePatterns[pattern] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// IsDenomAllowed returns true if the denom matches any of the allowed patterns, or the allowed patterns are empty,
+// and does not match any of the denied patterns.
+func (m Erc20AutoDeploy) IsDenomAllowed(denom string) bool {
+	for _, pattern := range m.DeniedDenoms {
+		if matchDenomPattern(pattern, denom) {
+			return false
+		}
+	}
+
+	if len(m.AllowedDenoms) < 1 {
+		return true
+	}
+
+	for _, pattern := range m.AllowedDenoms {
+		if matchDenomPattern(pattern, denom) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateDenomPattern validates the pattern, which is either an exact denom or a prefix followed by `*`.
+func validateDenomPattern(pattern string) error {
+	if pattern == "*" {
+		return nil
+	}
+
+	denom := strings.TrimSuffix(pattern, "*")
+	if denom == "" || strings.Contains(denom, "*") {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom pattern: %s", pattern)
+	}
+
+	if denom == pattern {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom pattern %s: %s", pattern, err.Error())
+		}
+	} else if strings.ContainsAny(denom, " \t\r\n") {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom pattern: %s", pattern)
+	}
+
 	return nil
 }
+
+func matchDenomPattern(pattern, denom string) bool {
+	if prefix, isPrefix := strings.CutSuffix(pattern, "*"); isPrefix {
+		return strings.HasPrefix(denom, prefix)
+	}
+
+	return pattern == denom
+}
@@ -11,23 +11,39 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// ProtocolCpc is the protocol version of the custom precompiled contracts.
+// Behavior changes are shipped as a new protocol version, which is activated by bumping the version in the module params,
+// so blocks before the activation are replayed with the behavior of the previous version.
 type ProtocolCpc uint32
 
 const (
 	ProtocolCpcV1 ProtocolCpc = 1
+	ProtocolCpcV2 ProtocolCpc = 2
 
-	LatestProtocolCpc = ProtocolCpcV1
+	LatestProtocolCpc = ProtocolCpcV2
 )
 
 const (
 	CpcTypeErc20 uint32 = iota + 1
 	CpcTypeStaking
 	CpcTypeBech32
+	CpcTypeGov
+	CpcTypeIbcTransfer
+	CpcTypeBank
+	CpcTypeDistribution
+	CpcTypeVesting
+	CpcTypeVAuth
 )
 
 const (
 	cpcAddrNonceStaking byte = iota + 1
 	cpcAddrNonceBech32
+	cpcAddrNonceGov
+	cpcAddrNonceIbcTransfer
+	cpcAddrNonceBank
+	cpcAddrNonceDistribution
+	cpcAddrNonceVesting
+	cpcAddrNonceVAuth
 )
 
 const EmptyTypedMeta = "{}"
@@ -38,6 +54,24 @@ var (
 
 	// CpcBech32FixedAddress is the address of the bech32 custom precompiled contract.
 	CpcBech32FixedAddress common.Address
+
+	// CpcGovFixedAddress is the address of the gov custom precompiled contract.
+	CpcGovFixedAddress common.Address
+
+	// CpcIbcTransferFixedAddress is the address of the IBC transfer custom precompiled contract.
+	CpcIbcTransferFixedAddress common.Address
+
+	// CpcBankFixedAddress is the address of the bank custom precompiled contract.
+	CpcBankFixedAddress common.Address
+
+	// CpcDistributionFixedAddress is the address of the distribution custom precompiled contract.
+	CpcDistributionFixedAddress common.Address
+
+	// CpcVestingFixedAddress is the address of the vesting custom precompiled contract.
+	CpcVestingFixedAddress common.Address
+
+	// CpcVAuthFixedAddress is the address of the x/vauth custom precompiled contract.
+	CpcVAuthFixedAddress common.Address
 )
 
 func (m CustomPrecompiledContractMeta) Validate(cpcV ProtocolCpc) error {
@@ -59,6 +93,18 @@ func (m CustomPrecompiledContractMeta) Validate(cpcV ProtocolCpc) error {
 			// valid
 		case CpcTypeBech32:
 			// valid
+		case CpcTypeGov:
+			// valid
+		case CpcTypeIbcTransfer:
+			// valid
+		case CpcTypeBank:
+			// valid
+		case CpcTypeDistribution:
+			// valid
+		case CpcTypeVesting:
+			// valid
+		case CpcTypeVAuth:
+			// valid
 		default:
 			panic(fmt.Sprintf("unsupported custom precompiled type %d", m.CustomPrecompiledType))
 		}
@@ -76,7 +122,7 @@ func (m CustomPrecompiledContractMeta) Validate(cpcV ProtocolCpc) error {
 		}
 
 		switch cpcV {
-		case ProtocolCpcV1:
+		case ProtocolCpcV1, ProtocolCpcV2:
 			// valid
 		default:
 			panic(fmt.Sprintf("unsupported protocol version %d", cpcV))
@@ -113,6 +159,30 @@ func (m CustomPrecompiledContractMeta) Validate(cpcV ProtocolCpc) error {
 		if m.TypedMeta != EmptyTypedMeta {
 			return getErrInvalidMetadata(fmt.Errorf("metadata must be empty json: %s", EmptyTypedMeta))
 		}
+	case CpcTypeGov:
+		if m.TypedMeta != EmptyTypedMeta {
+			return getErrInvalidMetadata(fmt.Errorf("metadata must be empty json: %s", EmptyTypedMeta))
+		}
+	case CpcTypeIbcTransfer:
+		if m.TypedMeta != EmptyTypedMeta {
+			return getErrInvalidMetadata(fmt.Errorf("metadata must be empty json: %s", EmptyTypedMeta))
+		}
+	case CpcTypeBank:
+		if m.TypedMeta != EmptyTypedMeta {
+			return getErrInvalidMetadata(fmt.Errorf("metadata must be empty json: %s", EmptyTypedMeta))
+		}
+	case CpcTypeDistribution:
+		if m.TypedMeta != EmptyTypedMeta {
+			return getErrInvalidMetadata(fmt.Errorf("metadata must be empty json: %s", EmptyTypedMeta))
+		}
+	case CpcTypeVesting:
+		if m.TypedMeta != EmptyTypedMeta {
+			return getErrInvalidMetadata(fmt.Errorf("metadata must be empty json: %s", EmptyTypedMeta))
+		}
+	case CpcTypeVAuth:
+		if m.TypedMeta != EmptyTypedMeta {
+			return getErrInvalidMetadata(fmt.Errorf("metadata must be empty json: %s", EmptyTypedMeta))
+		}
 	default:
 		panic(fmt.Sprintf("unimplemented validation for custom precompile type: %d", m.CustomPrecompiledType))
 	}
@@ -131,6 +201,18 @@ func WrapCustomPrecompiledContractMeta(meta CustomPrecompiledContractMeta) Wrapp
 				return "Staking"
 			case CpcTypeBech32:
 				return "Bech32"
+			case CpcTypeGov:
+				return "Gov"
+			case CpcTypeIbcTransfer:
+				return "IBC Transfer"
+			case CpcTypeBank:
+				return "Bank"
+			case CpcTypeDistribution:
+				return "Distribution"
+			case CpcTypeVesting:
+				return "Vesting"
+			case CpcTypeVAuth:
+				return "VAuth"
 			default:
 				return "Unknown"
 			}
@@ -163,4 +245,10 @@ func init() {
 
 	CpcStakingFixedAddress = generateCpcAddress(cpcAddrNonceStaking)
 	CpcBech32FixedAddress = generateCpcAddress(cpcAddrNonceBech32)
+	CpcGovFixedAddress = generateCpcAddress(cpcAddrNonceGov)
+	CpcIbcTransferFixedAddress = generateCpcAddress(cpcAddrNonceIbcTransfer)
+	CpcBankFixedAddress = generateCpcAddress(cpcAddrNonceBank)
+	CpcDistributionFixedAddress = generateCpcAddress(cpcAddrNonceDistribution)
+	CpcVestingFixedAddress = generateCpcAddress(cpcAddrNonceVesting)
+	CpcVAuthFixedAddress = generateCpcAddress(cpcAddrNonceVAuth)
 }
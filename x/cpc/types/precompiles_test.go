@@ -624,15 +624,471 @@ func Test_CustomPrecompiledContractMeta_Bech32_Validate(t *testing.T) {
 	})
 }
 
+func Test_CustomPrecompiledContractMeta_Gov_Validate(t *testing.T) {
+	pseudoAddress := common.BytesToAddress([]byte("precompiled")).Bytes()
+
+	tests := []struct {
+		name            string
+		meta            CustomPrecompiledContractMeta
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid meta",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeGov,
+				Name:                  "Gov",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - valid gov meta, `disabled` is allowed",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeGov,
+				Name:                  "Gov",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - meta cannot be empty",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeGov,
+				Name:                  "Gov",
+				TypedMeta:             "",
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "missing metadata",
+		},
+		{
+			name: "fail - reject invalid gov meta (logic)",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeGov,
+				Name:                  "Gov",
+				TypedMeta:             "{ }", // has something inside, not allowed
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid metadata for type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for v := uint32(ProtocolCpcV1); v <= uint32(LatestProtocolCpc); v++ {
+				t.Run(fmt.Sprintf("%d", v), func(t *testing.T) {
+					err := tt.meta.Validate(ProtocolCpc(v))
+					if tt.wantErr {
+						require.Error(t, err)
+						require.ErrorContains(t, err, tt.wantErrContains)
+						return
+					}
+
+					require.NoError(t, err)
+				})
+			}
+		})
+	}
+}
+
+func Test_CustomPrecompiledContractMeta_IbcTransfer_Validate(t *testing.T) {
+	pseudoAddress := common.BytesToAddress([]byte("precompiled")).Bytes()
+
+	tests := []struct {
+		name            string
+		meta            CustomPrecompiledContractMeta
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid meta",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeIbcTransfer,
+				Name:                  "IBC Transfer",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - valid IBC transfer meta, `disabled` is allowed",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeIbcTransfer,
+				Name:                  "IBC Transfer",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - meta cannot be empty",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeIbcTransfer,
+				Name:                  "IBC Transfer",
+				TypedMeta:             "",
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "missing metadata",
+		},
+		{
+			name: "fail - reject invalid IBC transfer meta (logic)",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeIbcTransfer,
+				Name:                  "IBC Transfer",
+				TypedMeta:             "{ }", // has something inside, not allowed
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid metadata for type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for v := uint32(ProtocolCpcV1); v <= uint32(LatestProtocolCpc); v++ {
+				t.Run(fmt.Sprintf("%d", v), func(t *testing.T) {
+					err := tt.meta.Validate(ProtocolCpc(v))
+					if tt.wantErr {
+						require.Error(t, err)
+						require.ErrorContains(t, err, tt.wantErrContains)
+						return
+					}
+
+					require.NoError(t, err)
+				})
+			}
+		})
+	}
+}
+
+func Test_CustomPrecompiledContractMeta_Bank_Validate(t *testing.T) {
+	pseudoAddress := common.BytesToAddress([]byte("precompiled")).Bytes()
+
+	tests := []struct {
+		name            string
+		meta            CustomPrecompiledContractMeta
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid meta",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeBank,
+				Name:                  "Bank",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - valid bank meta, `disabled` is allowed",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeBank,
+				Name:                  "Bank",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - meta cannot be empty",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeBank,
+				Name:                  "Bank",
+				TypedMeta:             "",
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "missing metadata",
+		},
+		{
+			name: "fail - reject invalid bank meta (logic)",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeBank,
+				Name:                  "Bank",
+				TypedMeta:             "{ }", // has something inside, not allowed
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid metadata for type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for v := uint32(ProtocolCpcV1); v <= uint32(LatestProtocolCpc); v++ {
+				t.Run(fmt.Sprintf("%d", v), func(t *testing.T) {
+					err := tt.meta.Validate(ProtocolCpc(v))
+					if tt.wantErr {
+						require.Error(t, err)
+						require.ErrorContains(t, err, tt.wantErrContains)
+						return
+					}
+
+					require.NoError(t, err)
+				})
+			}
+		})
+	}
+}
+
+func Test_CustomPrecompiledContractMeta_Distribution_Validate(t *testing.T) {
+	pseudoAddress := common.BytesToAddress([]byte("precompiled")).Bytes()
+
+	tests := []struct {
+		name            string
+		meta            CustomPrecompiledContractMeta
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid meta",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeDistribution,
+				Name:                  "Distribution",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - valid distribution meta, `disabled` is allowed",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeDistribution,
+				Name:                  "Distribution",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - meta cannot be empty",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeDistribution,
+				Name:                  "Distribution",
+				TypedMeta:             "",
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "missing metadata",
+		},
+		{
+			name: "fail - reject invalid distribution meta (logic)",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeDistribution,
+				Name:                  "Distribution",
+				TypedMeta:             "{ }", // has something inside, not allowed
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid metadata for type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for v := uint32(ProtocolCpcV1); v <= uint32(LatestProtocolCpc); v++ {
+				t.Run(fmt.Sprintf("%d", v), func(t *testing.T) {
+					err := tt.meta.Validate(ProtocolCpc(v))
+					if tt.wantErr {
+						require.Error(t, err)
+						require.ErrorContains(t, err, tt.wantErrContains)
+						return
+					}
+
+					require.NoError(t, err)
+				})
+			}
+		})
+	}
+}
+
+func Test_CustomPrecompiledContractMeta_Vesting_Validate(t *testing.T) {
+	pseudoAddress := common.BytesToAddress([]byte("precompiled")).Bytes()
+
+	tests := []struct {
+		name            string
+		meta            CustomPrecompiledContractMeta
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid meta",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeVesting,
+				Name:                  "Vesting",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - valid vesting meta, `disabled` is allowed",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeVesting,
+				Name:                  "Vesting",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - meta cannot be empty",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeVesting,
+				Name:                  "Vesting",
+				TypedMeta:             "",
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "missing metadata",
+		},
+		{
+			name: "fail - reject invalid vesting meta (logic)",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeVesting,
+				Name:                  "Vesting",
+				TypedMeta:             "{ }", // has something inside, not allowed
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid metadata for type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for v := uint32(ProtocolCpcV1); v <= uint32(LatestProtocolCpc); v++ {
+				t.Run(fmt.Sprintf("%d", v), func(t *testing.T) {
+					err := tt.meta.Validate(ProtocolCpc(v))
+					if tt.wantErr {
+						require.Error(t, err)
+						require.ErrorContains(t, err, tt.wantErrContains)
+						return
+					}
+
+					require.NoError(t, err)
+				})
+			}
+		})
+	}
+}
+
+func Test_CustomPrecompiledContractMeta_VAuth_Validate(t *testing.T) {
+	pseudoAddress := common.BytesToAddress([]byte("precompiled")).Bytes()
+
+	tests := []struct {
+		name            string
+		meta            CustomPrecompiledContractMeta
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid meta",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeVAuth,
+				Name:                  "VAuth",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - valid vauth meta, `disabled` is allowed",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeVAuth,
+				Name:                  "VAuth",
+				TypedMeta:             EmptyTypedMeta,
+				Disabled:              true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - meta cannot be empty",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeVAuth,
+				Name:                  "VAuth",
+				TypedMeta:             "",
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "missing metadata",
+		},
+		{
+			name: "fail - reject invalid vauth meta (logic)",
+			meta: CustomPrecompiledContractMeta{
+				Address:               pseudoAddress,
+				CustomPrecompiledType: CpcTypeVAuth,
+				Name:                  "VAuth",
+				TypedMeta:             "{ }", // has something inside, not allowed
+				Disabled:              false,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid metadata for type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for v := uint32(ProtocolCpcV1); v <= uint32(LatestProtocolCpc); v++ {
+				t.Run(fmt.Sprintf("%d", v), func(t *testing.T) {
+					err := tt.meta.Validate(ProtocolCpc(v))
+					if tt.wantErr {
+						require.Error(t, err)
+						require.ErrorContains(t, err, tt.wantErrContains)
+						return
+					}
+
+					require.NoError(t, err)
+				})
+			}
+		})
+	}
+}
+
 func Test_ConstantValues(t *testing.T) {
 	t.Run("CPC types", func(t *testing.T) {
 		require.Equal(t, uint32(1), CpcTypeErc20)
 		require.Equal(t, uint32(2), CpcTypeStaking)
 		require.Equal(t, uint32(3), CpcTypeBech32)
+		require.Equal(t, uint32(4), CpcTypeGov)
+		require.Equal(t, uint32(5), CpcTypeIbcTransfer)
+		require.Equal(t, uint32(6), CpcTypeBank)
+		require.Equal(t, uint32(7), CpcTypeDistribution)
+		require.Equal(t, uint32(8), CpcTypeVesting)
+		require.Equal(t, uint32(9), CpcTypeVAuth)
 	})
 
 	t.Run("fixed CPC addresses", func(t *testing.T) {
 		require.Equal(t, common.HexToAddress("0xcc01000000000000000000000000000000000001"), CpcStakingFixedAddress)
 		require.Equal(t, common.HexToAddress("0xcc02000000000000000000000000000000000002"), CpcBech32FixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc03000000000000000000000000000000000003"), CpcGovFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc04000000000000000000000000000000000004"), CpcIbcTransferFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc05000000000000000000000000000000000005"), CpcBankFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc06000000000000000000000000000000000006"), CpcDistributionFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc07000000000000000000000000000000000007"), CpcVestingFixedAddress)
+		require.Equal(t, common.HexToAddress("0xcc08000000000000000000000000000000000008"), CpcVAuthFixedAddress)
 	})
 }
@@ -0,0 +1,29 @@
+package types
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CpcCallTracer is implemented by the EVM tracers which capture the decoded calls into the custom precompiled contracts.
+// The custom precompiled contract executor reports to the active tracer of the EVM if it implements this interface.
+type CpcCallTracer interface {
+	CaptureCpcCall(frame CpcCallFrame)
+}
+
+// CpcCallFrame is a decoded call into a custom precompiled contract, with the Cosmos-side effects.
+type CpcCallFrame struct {
+	From    common.Address
+	To      common.Address
+	Input   []byte
+	Output  []byte
+	Err     error
+	GasUsed uint64
+
+	// Method is the signature of the called method, empty if the input could not be decoded.
+	Method string
+	// Args is the decoded arguments of the called method, by name.
+	Args map[string]interface{}
+	// CosmosEvents is the SDK events emitted during execution.
+	CosmosEvents []abci.Event
+}
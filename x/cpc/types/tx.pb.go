@@ -361,6 +361,290 @@ func (m *MsgDeployStakingContractResponse) GetContractAddress() string {
 	return ""
 }
 
+// MsgDeployIbcTransferContractRequest defines a Msg for deploying a new IBC transfer contract.
+type MsgDeployIbcTransferContractRequest struct {
+	// authority is the address of the deployer account, must be available as whitelisted in module params.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgDeployIbcTransferContractRequest) Reset()         { *m = MsgDeployIbcTransferContractRequest{} }
+func (m *MsgDeployIbcTransferContractRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeployIbcTransferContractRequest) ProtoMessage()    {}
+func (*MsgDeployIbcTransferContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdc86da068e4b5b7, []int{6}
+}
+func (m *MsgDeployIbcTransferContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeployIbcTransferContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeployIbcTransferContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeployIbcTransferContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeployIbcTransferContractRequest.Merge(m, src)
+}
+func (m *MsgDeployIbcTransferContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeployIbcTransferContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeployIbcTransferContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeployIbcTransferContractRequest proto.InternalMessageInfo
+
+func (m *MsgDeployIbcTransferContractRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgDeployIbcTransferContractResponse defines the Msg/DeployIbcTransferContract response type.
+type MsgDeployIbcTransferContractResponse struct {
+	// contract_address is the address of the deployed IBC transfer contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgDeployIbcTransferContractResponse) Reset()         { *m = MsgDeployIbcTransferContractResponse{} }
+func (m *MsgDeployIbcTransferContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeployIbcTransferContractResponse) ProtoMessage()    {}
+func (*MsgDeployIbcTransferContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdc86da068e4b5b7, []int{7}
+}
+func (m *MsgDeployIbcTransferContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeployIbcTransferContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeployIbcTransferContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeployIbcTransferContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeployIbcTransferContractResponse.Merge(m, src)
+}
+func (m *MsgDeployIbcTransferContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeployIbcTransferContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeployIbcTransferContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeployIbcTransferContractResponse proto.InternalMessageInfo
+
+func (m *MsgDeployIbcTransferContractResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgDisableCustomPrecompiledContract defines a Msg for disabling a custom precompiled contract.
+type MsgDisableCustomPrecompiledContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the ethereum hex address of the custom precompiled contract to disable.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgDisableCustomPrecompiledContract) Reset()         { *m = MsgDisableCustomPrecompiledContract{} }
+func (m *MsgDisableCustomPrecompiledContract) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableCustomPrecompiledContract) ProtoMessage()    {}
+func (*MsgDisableCustomPrecompiledContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdc86da068e4b5b7, []int{8}
+}
+func (m *MsgDisableCustomPrecompiledContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableCustomPrecompiledContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableCustomPrecompiledContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableCustomPrecompiledContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableCustomPrecompiledContract.Merge(m, src)
+}
+func (m *MsgDisableCustomPrecompiledContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableCustomPrecompiledContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableCustomPrecompiledContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableCustomPrecompiledContract proto.InternalMessageInfo
+
+func (m *MsgDisableCustomPrecompiledContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDisableCustomPrecompiledContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgDisableCustomPrecompiledContractResponse defines the Msg/DisableCustomPrecompiledContract response type.
+type MsgDisableCustomPrecompiledContractResponse struct {
+}
+
+func (m *MsgDisableCustomPrecompiledContractResponse) Reset() {
+	*m = MsgDisableCustomPrecompiledContractResponse{}
+}
+func (m *MsgDisableCustomPrecompiledContractResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgDisableCustomPrecompiledContractResponse) ProtoMessage() {}
+func (*MsgDisableCustomPrecompiledContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdc86da068e4b5b7, []int{9}
+}
+func (m *MsgDisableCustomPrecompiledContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableCustomPrecompiledContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableCustomPrecompiledContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableCustomPrecompiledContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableCustomPrecompiledContractResponse.Merge(m, src)
+}
+func (m *MsgDisableCustomPrecompiledContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableCustomPrecompiledContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableCustomPrecompiledContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableCustomPrecompiledContractResponse proto.InternalMessageInfo
+
+// MsgEnableCustomPrecompiledContract defines a Msg for re-enabling a disabled custom precompiled contract.
+type MsgEnableCustomPrecompiledContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the ethereum hex address of the custom precompiled contract to enable.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgEnableCustomPrecompiledContract) Reset()         { *m = MsgEnableCustomPrecompiledContract{} }
+func (m *MsgEnableCustomPrecompiledContract) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableCustomPrecompiledContract) ProtoMessage()    {}
+func (*MsgEnableCustomPrecompiledContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdc86da068e4b5b7, []int{10}
+}
+func (m *MsgEnableCustomPrecompiledContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableCustomPrecompiledContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableCustomPrecompiledContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableCustomPrecompiledContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableCustomPrecompiledContract.Merge(m, src)
+}
+func (m *MsgEnableCustomPrecompiledContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableCustomPrecompiledContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableCustomPrecompiledContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableCustomPrecompiledContract proto.InternalMessageInfo
+
+func (m *MsgEnableCustomPrecompiledContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgEnableCustomPrecompiledContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgEnableCustomPrecompiledContractResponse defines the Msg/EnableCustomPrecompiledContract response type.
+type MsgEnableCustomPrecompiledContractResponse struct {
+}
+
+func (m *MsgEnableCustomPrecompiledContractResponse) Reset() {
+	*m = MsgEnableCustomPrecompiledContractResponse{}
+}
+func (m *MsgEnableCustomPrecompiledContractResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgEnableCustomPrecompiledContractResponse) ProtoMessage() {}
+func (*MsgEnableCustomPrecompiledContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fdc86da068e4b5b7, []int{11}
+}
+func (m *MsgEnableCustomPrecompiledContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableCustomPrecompiledContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableCustomPrecompiledContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableCustomPrecompiledContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableCustomPrecompiledContractResponse.Merge(m, src)
+}
+func (m *MsgEnableCustomPrecompiledContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableCustomPrecompiledContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableCustomPrecompiledContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableCustomPrecompiledContractResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "evermint.cpc.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evermint.cpc.v1.MsgUpdateParamsResponse")
@@ -368,46 +652,61 @@ func init() {
 	proto.RegisterType((*MsgDeployErc20ContractResponse)(nil), "evermint.cpc.v1.MsgDeployErc20ContractResponse")
 	proto.RegisterType((*MsgDeployStakingContractRequest)(nil), "evermint.cpc.v1.MsgDeployStakingContractRequest")
 	proto.RegisterType((*MsgDeployStakingContractResponse)(nil), "evermint.cpc.v1.MsgDeployStakingContractResponse")
+	proto.RegisterType((*MsgDeployIbcTransferContractRequest)(nil), "evermint.cpc.v1.MsgDeployIbcTransferContractRequest")
+	proto.RegisterType((*MsgDeployIbcTransferContractResponse)(nil), "evermint.cpc.v1.MsgDeployIbcTransferContractResponse")
+	proto.RegisterType((*MsgDisableCustomPrecompiledContract)(nil), "evermint.cpc.v1.MsgDisableCustomPrecompiledContract")
+	proto.RegisterType((*MsgDisableCustomPrecompiledContractResponse)(nil), "evermint.cpc.v1.MsgDisableCustomPrecompiledContractResponse")
+	proto.RegisterType((*MsgEnableCustomPrecompiledContract)(nil), "evermint.cpc.v1.MsgEnableCustomPrecompiledContract")
+	proto.RegisterType((*MsgEnableCustomPrecompiledContractResponse)(nil), "evermint.cpc.v1.MsgEnableCustomPrecompiledContractResponse")
 }
 
 func init() { proto.RegisterFile("evermint/cpc/v1/tx.proto", fileDescriptor_fdc86da068e4b5b7) }
 
 var fileDescriptor_fdc86da068e4b5b7 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0xaf, 0xdb, 0x32, 0x2d, 0xe6, 0x4f, 0x91, 0x19, 0x34, 0x0b, 0x2c, 0x8b, 0x7a, 0xa1, 0x20,
-	0x91, 0xac, 0x45, 0xe2, 0x80, 0xb8, 0xac, 0xac, 0x27, 0x54, 0x09, 0x65, 0xe2, 0xb2, 0x4b, 0xe5,
-	0x3a, 0x96, 0x17, 0x51, 0xdb, 0x21, 0x76, 0xbb, 0x55, 0xe2, 0x04, 0x5f, 0x80, 0x1b, 0x5f, 0x03,
-	0x21, 0x3e, 0xc4, 0x8e, 0x13, 0x17, 0x38, 0x21, 0xd4, 0x1e, 0xf8, 0x1a, 0xa8, 0x49, 0xd6, 0xb2,
-	0xb6, 0x11, 0xeb, 0xcd, 0xef, 0xf7, 0xfe, 0xfd, 0x7e, 0xcf, 0x4f, 0x0f, 0x9a, 0x74, 0x48, 0x63,
-	0x1e, 0x0a, 0xed, 0x91, 0x88, 0x78, 0xc3, 0x86, 0xa7, 0x4f, 0xdd, 0x28, 0x96, 0x5a, 0xa2, 0xca,
-	0x85, 0xc7, 0x25, 0x11, 0x71, 0x87, 0x0d, 0xab, 0x4a, 0xa4, 0xe2, 0x52, 0x79, 0x5c, 0xb1, 0x69,
-	0x20, 0x57, 0x2c, 0x8d, 0xb4, 0xb6, 0x53, 0x47, 0x37, 0xb1, 0xbc, 0xd4, 0xc8, 0x5c, 0x5b, 0x4c,
-	0x32, 0x99, 0xe2, 0xd3, 0x57, 0x86, 0xee, 0x2c, 0x36, 0x65, 0x54, 0x50, 0x15, 0x66, 0x49, 0xb5,
-	0xcf, 0x00, 0x56, 0x3a, 0x8a, 0xbd, 0x89, 0x02, 0xac, 0xe9, 0x6b, 0x1c, 0x63, 0xae, 0xd0, 0x33,
-	0x68, 0xe0, 0x81, 0x3e, 0x96, 0x71, 0xa8, 0x47, 0x26, 0x70, 0x40, 0xdd, 0x68, 0x99, 0xdf, 0xbf,
-	0x3d, 0xd9, 0xca, 0xba, 0xed, 0x07, 0x41, 0x4c, 0x95, 0x3a, 0xd4, 0x71, 0x28, 0x98, 0x3f, 0x0f,
-	0x45, 0x2f, 0x20, 0x14, 0xf4, 0xa4, 0x1b, 0x25, 0x55, 0xcc, 0xa2, 0x03, 0xea, 0xd7, 0x9b, 0x55,
-	0x77, 0x41, 0x9a, 0x9b, 0x36, 0x69, 0x95, 0xcf, 0x7e, 0xed, 0x16, 0x7c, 0x43, 0xd0, 0x93, 0x14,
-	0x78, 0x7e, 0xeb, 0xc3, 0x9f, 0x2f, 0x8f, 0xe7, 0xd5, 0x6a, 0xdb, 0xb0, 0xba, 0x40, 0xcc, 0xa7,
-	0x2a, 0x92, 0x42, 0xd1, 0xda, 0x57, 0x00, 0x77, 0x3a, 0x8a, 0x1d, 0xd0, 0xa8, 0x2f, 0x47, 0xed,
-	0x98, 0x34, 0xf7, 0x5e, 0x4a, 0xa1, 0x63, 0x4c, 0xb4, 0x4f, 0xdf, 0x0d, 0xa8, 0xd2, 0xe8, 0xc1,
-	0x92, 0x84, 0x7f, 0x89, 0x22, 0x58, 0x16, 0x98, 0xd3, 0x84, 0xa2, 0xe1, 0x27, 0x6f, 0x74, 0x0f,
-	0x6e, 0xa8, 0x11, 0xef, 0xc9, 0xbe, 0x59, 0x4a, 0xd0, 0xcc, 0x42, 0x16, 0xdc, 0x0c, 0x28, 0x09,
-	0x39, 0xee, 0x2b, 0xb3, 0xec, 0x80, 0xfa, 0x4d, 0x7f, 0x66, 0xa3, 0xfb, 0xd0, 0xe0, 0xa1, 0xe8,
-	0x06, 0x54, 0x48, 0x6e, 0x5e, 0x4b, 0xd2, 0x36, 0x79, 0x28, 0x0e, 0xa6, 0xf6, 0x92, 0x9e, 0x57,
-	0xd0, 0xce, 0xe3, 0x9c, 0xca, 0x42, 0x8f, 0xe0, 0x6d, 0x92, 0x61, 0x5d, 0x9c, 0x0e, 0x39, 0xe3,
-	0x5e, 0xb9, 0xc0, 0xb3, 0xd9, 0xd7, 0x3e, 0x02, 0xb8, 0x3b, 0xab, 0x76, 0xa8, 0xf1, 0xdb, 0x50,
-	0xb0, 0xf5, 0x66, 0x30, 0xd7, 0x5b, 0xcc, 0xd5, 0x5b, 0xba, 0xac, 0x77, 0x49, 0x52, 0x07, 0x3a,
-	0xf9, 0x24, 0xd6, 0x16, 0xd5, 0xfc, 0x51, 0x84, 0xa5, 0x8e, 0x62, 0xe8, 0x08, 0xde, 0xb8, 0xb4,
-	0x8f, 0xce, 0xd2, 0x0e, 0x2d, 0x2c, 0x86, 0x55, 0xff, 0x5f, 0xc4, 0x8c, 0xce, 0x10, 0xde, 0x59,
-	0xf1, 0x05, 0xc8, 0x5d, 0x55, 0x20, 0x7f, 0xbf, 0x2c, 0xef, 0xca, 0xf1, 0x59, 0xdf, 0xf7, 0xf0,
-	0xee, 0xca, 0x39, 0xa1, 0xbd, 0xfc, 0x4a, 0xab, 0xff, 0xd5, 0x6a, 0xac, 0x91, 0x91, 0x76, 0x6f,
-	0xed, 0x9f, 0x8d, 0x6d, 0x70, 0x3e, 0xb6, 0xc1, 0xef, 0xb1, 0x0d, 0x3e, 0x4d, 0xec, 0xc2, 0xf9,
-	0xc4, 0x2e, 0xfc, 0x9c, 0xd8, 0x85, 0xa3, 0x87, 0x2c, 0xd4, 0xc7, 0x83, 0x9e, 0x4b, 0x24, 0xf7,
-	0xda, 0x8a, 0x60, 0xd1, 0x6a, 0x7b, 0xb3, 0x8b, 0x71, 0x9a, 0xdc, 0x0c, 0x3d, 0x8a, 0xa8, 0xea,
-	0x6d, 0x24, 0xf7, 0xe2, 0xe9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68, 0x47, 0xc1, 0x92, 0xc5,
-	0x04, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xc0, 0x8f, 0xd0, 0xe7, 0xa7, 0x62, 0x46, 0x94, 0x65, 0x95, 0xa5, 0xa9, 0x26,
+	0x56, 0x94, 0x5d, 0xfe, 0xa8, 0x07, 0xe5, 0x42, 0xa1, 0x07, 0x63, 0x9a, 0x60, 0xd1, 0x0b, 0x97,
+	0x66, 0x3b, 0x1d, 0x97, 0x8d, 0x9d, 0x99, 0x75, 0x67, 0x5a, 0x68, 0xe2, 0x49, 0x2f, 0x1e, 0x35,
+	0x26, 0xe2, 0xcb, 0x30, 0xc6, 0x17, 0xc1, 0x91, 0x78, 0xf2, 0x64, 0x0c, 0x1c, 0x7c, 0x1b, 0x86,
+	0xdd, 0xed, 0x22, 0xed, 0xae, 0xa5, 0x9c, 0xbc, 0xed, 0xf3, 0xcc, 0xf3, 0xe7, 0xf3, 0x9d, 0xec,
+	0xf3, 0x64, 0x40, 0xa3, 0x2d, 0xea, 0x33, 0x97, 0x2b, 0x8b, 0x78, 0xc4, 0x6a, 0x2d, 0x58, 0x6a,
+	0xc7, 0xf4, 0x7c, 0xa1, 0x04, 0x1e, 0xef, 0x9c, 0x98, 0xc4, 0x23, 0x66, 0x6b, 0x41, 0x9f, 0x24,
+	0x42, 0x32, 0x21, 0x2d, 0x26, 0x9d, 0xa3, 0x40, 0x26, 0x9d, 0x30, 0x52, 0x9f, 0x0a, 0x0f, 0xaa,
+	0x81, 0x65, 0x85, 0x46, 0x74, 0x34, 0xe1, 0x08, 0x47, 0x84, 0xfe, 0xa3, 0xaf, 0xc8, 0x3b, 0xdd,
+	0xdd, 0xd4, 0xa1, 0x9c, 0x4a, 0x37, 0x4a, 0xca, 0xef, 0x22, 0x18, 0x2f, 0x4b, 0xe7, 0x99, 0x57,
+	0xb7, 0x15, 0x5d, 0xb7, 0x7d, 0x9b, 0x49, 0x7c, 0x1f, 0xb2, 0x76, 0x53, 0x6d, 0x09, 0xdf, 0x55,
+	0x6d, 0x0d, 0xe5, 0x50, 0x21, 0x5b, 0xd4, 0xbe, 0x7d, 0x9d, 0x9b, 0x88, 0xba, 0xad, 0xd4, 0xeb,
+	0x3e, 0x95, 0x72, 0x43, 0xf9, 0x2e, 0x77, 0x2a, 0xc7, 0xa1, 0x78, 0x19, 0x80, 0xd3, 0xed, 0xaa,
+	0x17, 0x54, 0xd1, 0x86, 0x72, 0xa8, 0xf0, 0xff, 0xe2, 0xa4, 0xd9, 0x25, 0xcd, 0x0c, 0x9b, 0x14,
+	0x47, 0xf6, 0x7e, 0xcc, 0x64, 0x2a, 0x59, 0x4e, 0xb7, 0x43, 0xc7, 0x83, 0x0b, 0xaf, 0x7f, 0x7d,
+	0x9e, 0x3d, 0xae, 0x96, 0x9f, 0x82, 0xc9, 0x2e, 0xb0, 0x0a, 0x95, 0x9e, 0xe0, 0x92, 0xe6, 0xbf,
+	0x20, 0x98, 0x2e, 0x4b, 0x67, 0x8d, 0x7a, 0x0d, 0xd1, 0x2e, 0xf9, 0x64, 0x71, 0x7e, 0x55, 0x70,
+	0xe5, 0xdb, 0x44, 0x55, 0xe8, 0xcb, 0x26, 0x95, 0x0a, 0x5f, 0xeb, 0x91, 0xf0, 0x27, 0x28, 0x86,
+	0x11, 0x6e, 0x33, 0x1a, 0x20, 0x66, 0x2b, 0xc1, 0x37, 0xbe, 0x02, 0xa3, 0xb2, 0xcd, 0x6a, 0xa2,
+	0xa1, 0x0d, 0x07, 0xde, 0xc8, 0xc2, 0x3a, 0x8c, 0xd5, 0x29, 0x71, 0x99, 0xdd, 0x90, 0xda, 0x48,
+	0x0e, 0x15, 0xce, 0x57, 0x62, 0x1b, 0x5f, 0x85, 0x2c, 0x73, 0x79, 0xb5, 0x4e, 0xb9, 0x60, 0xda,
+	0x7f, 0x41, 0xda, 0x18, 0x73, 0xf9, 0xda, 0x91, 0xdd, 0xa3, 0xe7, 0x31, 0x18, 0x69, 0xcc, 0xa1,
+	0x2c, 0x7c, 0x0b, 0x2e, 0x92, 0xc8, 0x57, 0xb5, 0xc3, 0x4b, 0x8e, 0xd8, 0xc7, 0x3b, 0xfe, 0xe8,
+	0xee, 0xf3, 0x6f, 0x10, 0xcc, 0xc4, 0xd5, 0x36, 0x94, 0xfd, 0xc2, 0xe5, 0xce, 0x60, 0x77, 0x70,
+	0xac, 0x77, 0x28, 0x55, 0xef, 0xf0, 0x49, 0xbd, 0x3d, 0x92, 0xca, 0x90, 0x4b, 0x87, 0x18, 0x5c,
+	0xd4, 0x06, 0x5c, 0x8f, 0xcb, 0x3d, 0xaa, 0x91, 0xa7, 0xbe, 0xcd, 0xe5, 0x73, 0xea, 0x0f, 0xa4,
+	0xab, 0x87, 0xf1, 0x09, 0xdc, 0xf8, 0x7b, 0xd1, 0xc1, 0x39, 0x3f, 0xa1, 0x10, 0xd4, 0x95, 0x76,
+	0xad, 0x41, 0x57, 0x9b, 0x52, 0x09, 0xb6, 0xee, 0x53, 0x22, 0x98, 0xe7, 0x36, 0x68, 0xbd, 0x53,
+	0xfa, 0xcc, 0x73, 0x94, 0x84, 0x32, 0x94, 0x88, 0xd2, 0xa3, 0x76, 0x0e, 0x6e, 0x9f, 0x82, 0x2c,
+	0x1e, 0xa4, 0x5d, 0x04, 0xf9, 0xb2, 0x74, 0x4a, 0xfc, 0x9f, 0x13, 0x72, 0x07, 0x66, 0xfb, 0x83,
+	0x75, 0x74, 0x2c, 0xbe, 0x1f, 0x85, 0xe1, 0xb2, 0x74, 0xf0, 0x26, 0x9c, 0x3b, 0xb1, 0xc9, 0x72,
+	0x3d, 0xdb, 0xa7, 0x6b, 0xa5, 0xe8, 0x85, 0x7e, 0x11, 0xf1, 0x0f, 0xd2, 0x82, 0x4b, 0x09, 0xc3,
+	0x8b, 0xcd, 0xa4, 0x02, 0xe9, 0x9b, 0x49, 0xb7, 0x4e, 0x1d, 0x1f, 0xf5, 0x7d, 0x05, 0x97, 0x13,
+	0x27, 0x0c, 0xcf, 0xa7, 0x57, 0x4a, 0xde, 0x08, 0xfa, 0xc2, 0x00, 0x19, 0x51, 0xf7, 0xb7, 0x08,
+	0xa6, 0x52, 0x87, 0x07, 0xdf, 0x4d, 0x2f, 0x98, 0x3e, 0xc0, 0xfa, 0xbd, 0x01, 0xb3, 0x22, 0x94,
+	0x8f, 0x08, 0x72, 0x7d, 0x67, 0x2e, 0x99, 0xa8, 0x4f, 0x96, 0xbe, 0x7c, 0x96, 0xac, 0x18, 0xec,
+	0x03, 0x82, 0x99, 0x7e, 0x23, 0xb4, 0x94, 0xd4, 0xa1, 0x4f, 0x92, 0xfe, 0xf0, 0x0c, 0x49, 0x1d,
+	0xaa, 0xe2, 0xca, 0xde, 0x81, 0x81, 0xf6, 0x0f, 0x0c, 0xf4, 0xf3, 0xc0, 0x40, 0xef, 0x0e, 0x8d,
+	0xcc, 0xfe, 0xa1, 0x91, 0xf9, 0x7e, 0x68, 0x64, 0x36, 0x6f, 0x3a, 0xae, 0xda, 0x6a, 0xd6, 0x4c,
+	0x22, 0x98, 0x55, 0x92, 0xc4, 0xe6, 0xc5, 0x92, 0x15, 0xbf, 0x12, 0x76, 0x82, 0x77, 0x82, 0x6a,
+	0x7b, 0x54, 0xd6, 0x46, 0x83, 0x37, 0xc2, 0xd2, 0xef, 0x01, 0x00, 0x96, 0x38, 0xaa, 0xb0, 0xb9,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -429,6 +728,14 @@ type MsgClient interface {
 	DeployErc20Contract(ctx context.Context, in *MsgDeployErc20ContractRequest, opts ...grpc.CallOption) (*MsgDeployErc20ContractResponse, error)
 	// DeployStakingContract defines a method deploying a new staking contract.
 	DeployStakingContract(ctx context.Context, in *MsgDeployStakingContractRequest, opts ...grpc.CallOption) (*MsgDeployStakingContractResponse, error)
+	// DeployIbcTransferContract defines a method deploying a new IBC transfer contract.
+	DeployIbcTransferContract(ctx context.Context, in *MsgDeployIbcTransferContractRequest, opts ...grpc.CallOption) (*MsgDeployIbcTransferContractResponse, error)
+	// DisableCustomPrecompiledContract defines a governance operation for disabling a custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DisableCustomPrecompiledContract(ctx context.Context, in *MsgDisableCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgDisableCustomPrecompiledContractResponse, error)
+	// EnableCustomPrecompiledContract defines a governance operation for re-enabling a disabled custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	EnableCustomPrecompiledContract(ctx context.Context, in *MsgEnableCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgEnableCustomPrecompiledContractResponse, error)
 }
 
 type msgClient struct {
@@ -466,6 +773,33 @@ func (c *msgClient) DeployStakingContract(ctx context.Context, in *MsgDeployStak
 	return out, nil
 }
 
+func (c *msgClient) DeployIbcTransferContract(ctx context.Context, in *MsgDeployIbcTransferContractRequest, opts ...grpc.CallOption) (*MsgDeployIbcTransferContractResponse, error) {
+	out := new(MsgDeployIbcTransferContractResponse)
+	err := c.cc.Invoke(ctx, "/evermint.cpc.v1.Msg/DeployIbcTransferContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableCustomPrecompiledContract(ctx context.Context, in *MsgDisableCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgDisableCustomPrecompiledContractResponse, error) {
+	out := new(MsgDisableCustomPrecompiledContractResponse)
+	err := c.cc.Invoke(ctx, "/evermint.cpc.v1.Msg/DisableCustomPrecompiledContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableCustomPrecompiledContract(ctx context.Context, in *MsgEnableCustomPrecompiledContract, opts ...grpc.CallOption) (*MsgEnableCustomPrecompiledContractResponse, error) {
+	out := new(MsgEnableCustomPrecompiledContractResponse)
+	err := c.cc.Invoke(ctx, "/evermint.cpc.v1.Msg/EnableCustomPrecompiledContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/cpc module parameters.
@@ -475,6 +809,14 @@ type MsgServer interface {
 	DeployErc20Contract(context.Context, *MsgDeployErc20ContractRequest) (*MsgDeployErc20ContractResponse, error)
 	// DeployStakingContract defines a method deploying a new staking contract.
 	DeployStakingContract(context.Context, *MsgDeployStakingContractRequest) (*MsgDeployStakingContractResponse, error)
+	// DeployIbcTransferContract defines a method deploying a new IBC transfer contract.
+	DeployIbcTransferContract(context.Context, *MsgDeployIbcTransferContractRequest) (*MsgDeployIbcTransferContractResponse, error)
+	// DisableCustomPrecompiledContract defines a governance operation for disabling a custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	DisableCustomPrecompiledContract(context.Context, *MsgDisableCustomPrecompiledContract) (*MsgDisableCustomPrecompiledContractResponse, error)
+	// EnableCustomPrecompiledContract defines a governance operation for re-enabling a disabled custom precompiled contract.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	EnableCustomPrecompiledContract(context.Context, *MsgEnableCustomPrecompiledContract) (*MsgEnableCustomPrecompiledContractResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -490,6 +832,15 @@ func (*UnimplementedMsgServer) DeployErc20Contract(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) DeployStakingContract(ctx context.Context, req *MsgDeployStakingContractRequest) (*MsgDeployStakingContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployStakingContract not implemented")
 }
+func (*UnimplementedMsgServer) DeployIbcTransferContract(ctx context.Context, req *MsgDeployIbcTransferContractRequest) (*MsgDeployIbcTransferContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployIbcTransferContract not implemented")
+}
+func (*UnimplementedMsgServer) DisableCustomPrecompiledContract(ctx context.Context, req *MsgDisableCustomPrecompiledContract) (*MsgDisableCustomPrecompiledContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableCustomPrecompiledContract not implemented")
+}
+func (*UnimplementedMsgServer) EnableCustomPrecompiledContract(ctx context.Context, req *MsgEnableCustomPrecompiledContract) (*MsgEnableCustomPrecompiledContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableCustomPrecompiledContract not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -549,6 +900,61 @@ func _Msg_DeployStakingContract_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeployIbcTransferContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeployIbcTransferContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeployIbcTransferContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evermint.cpc.v1.Msg/DeployIbcTransferContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeployIbcTransferContract(ctx, req.(*MsgDeployIbcTransferContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableCustomPrecompiledContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableCustomPrecompiledContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableCustomPrecompiledContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evermint.cpc.v1.Msg/DisableCustomPrecompiledContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableCustomPrecompiledContract(ctx, req.(*MsgDisableCustomPrecompiledContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableCustomPrecompiledContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableCustomPrecompiledContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableCustomPrecompiledContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evermint.cpc.v1.Msg/EnableCustomPrecompiledContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableCustomPrecompiledContract(ctx, req.(*MsgEnableCustomPrecompiledContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evermint.cpc.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -565,6 +971,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeployStakingContract",
 			Handler:    _Msg_DeployStakingContract_Handler,
 		},
+		{
+			MethodName: "DeployIbcTransferContract",
+			Handler:    _Msg_DeployIbcTransferContract_Handler,
+		},
+		{
+			MethodName: "DisableCustomPrecompiledContract",
+			Handler:    _Msg_DisableCustomPrecompiledContract_Handler,
+		},
+		{
+			MethodName: "EnableCustomPrecompiledContract",
+			Handler:    _Msg_EnableCustomPrecompiledContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evermint/cpc/v1/tx.proto",
@@ -791,15 +1209,195 @@ func (m *MsgDeployStakingContractResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDeployIbcTransferContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeployIbcTransferContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeployIbcTransferContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeployIbcTransferContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeployIbcTransferContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeployIbcTransferContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableCustomPrecompiledContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableCustomPrecompiledContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableCustomPrecompiledContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableCustomPrecompiledContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableCustomPrecompiledContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableCustomPrecompiledContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableCustomPrecompiledContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableCustomPrecompiledContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableCustomPrecompiledContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableCustomPrecompiledContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableCustomPrecompiledContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableCustomPrecompiledContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgUpdateParams) Size() (n int) {
@@ -900,6 +1498,84 @@ func (m *MsgDeployStakingContractResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDeployIbcTransferContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeployIbcTransferContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableCustomPrecompiledContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableCustomPrecompiledContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnableCustomPrecompiledContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableCustomPrecompiledContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -929,10 +1605,669 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployErc20ContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeployErc20ContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployErc20ContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployErc20ContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeployErc20ContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployStakingContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeployStakingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployStakingContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployStakingContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeployStakingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeployIbcTransferContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeployIbcTransferContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeployIbcTransferContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -967,39 +2302,6 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1021,7 +2323,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeployIbcTransferContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,12 +2346,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeployIbcTransferContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeployIbcTransferContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1071,7 +2405,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCustomPrecompiledContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1094,10 +2428,10 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployErc20ContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCustomPrecompiledContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployErc20ContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCustomPrecompiledContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1134,90 +2468,7 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1245,7 +2496,7 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDenom = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1268,7 +2519,7 @@ func (m *MsgDeployErc20ContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployErc20ContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCustomPrecompiledContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,44 +2542,12 @@ func (m *MsgDeployErc20ContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployErc20ContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCustomPrecompiledContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployErc20ContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCustomPrecompiledContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1350,7 +2569,7 @@ func (m *MsgDeployErc20ContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCustomPrecompiledContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1373,10 +2592,10 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployStakingContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCustomPrecompiledContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployStakingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCustomPrecompiledContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1413,7 +2632,7 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1441,27 +2660,8 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1483,7 +2683,7 @@ func (m *MsgDeployStakingContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeployStakingContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCustomPrecompiledContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1506,44 +2706,12 @@ func (m *MsgDeployStakingContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeployStakingContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCustomPrecompiledContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeployStakingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCustomPrecompiledContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
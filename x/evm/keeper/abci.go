@@ -24,5 +24,12 @@ func (k *Keeper) EndBlock(ctx sdk.Context) {
 
 	receipts := k.GetTxReceiptsTransient(zeroGasCtx)
 	bloom := ethtypes.CreateBloom(receipts)
+
+	// include logs emitted by Cosmos txs
+	cosmosTxLogsBloom := k.GetCosmosTxLogsBloomTransient(zeroGasCtx)
+	for i := range bloom {
+		bloom[i] |= cosmosTxLogsBloom[i]
+	}
+
 	k.EmitBlockBloomEvent(zeroGasCtx, bloom)
 }
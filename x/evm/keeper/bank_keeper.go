@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+)
+
+var _ bankkeeper.Keeper = &BankKeeperWithTransferLogs{}
+
+// BankKeeperWithTransferLogs wraps the bank keeper, every bank movement of the denoms
+// which are backed by an ERC-20 custom precompiled contract will be recorded as ERC-20 `Transfer` logs,
+// then those logs will be emitted at the end of the Cosmos tx, so EVM indexers and wallets can keep track of the balances.
+//
+// Transfers are recorded via a send restriction registered into the original bank keeper,
+// so those made by the x/bank module itself are also recorded.
+// Delegation, undelegation, minting and burning do not go through the send restriction,
+// they are recorded by this wrapper.
+//
+// NOTE: the send restriction is shared by every copy of the original bank keeper,
+// so transfers made via the original bank keeper are recorded too, except those of Ethereum txs
+// because the EVM and the custom precompiled contracts emit logs by themselves.
+type BankKeeperWithTransferLogs struct {
+	bankkeeper.BaseKeeper
+	evmKeeper *Keeper
+}
+
+// NewBankKeeperWithTransferLogs returns a new bank keeper which records ERC-20 `Transfer` logs.
+// The EVM keeper must be provided later via WithEvmKeeper.
+func NewBankKeeperWithTransferLogs(bk bankkeeper.BaseKeeper) *BankKeeperWithTransferLogs {
+	k := &BankKeeperWithTransferLogs{
+		BaseKeeper: bk,
+	}
+
+	bk.AppendSendRestriction(k.sendRestriction)
+
+	return k
+}
+
+// WithEvmKeeper sets the EVM keeper which is used to record the logs.
+func (k *BankKeeperWithTransferLogs) WithEvmKeeper(ek *Keeper) *BankKeeperWithTransferLogs {
+	k.evmKeeper = ek
+	return k
+}
+
+// DelegateCoins implements bankkeeper.Keeper.
+func (k BankKeeperWithTransferLogs) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
+	k.record(ctx, delegatorAddr, moduleAccAddr, amt)
+	return nil
+}
+
+// UndelegateCoins implements bankkeeper.Keeper.
+func (k BankKeeperWithTransferLogs) UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+
+	k.record(ctx, moduleAccAddr, delegatorAddr, amt)
+	return nil
+}
+
+// DelegateCoinsFromAccountToModule implements bankkeeper.Keeper.
+func (k BankKeeperWithTransferLogs) DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.BaseKeeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+
+	k.record(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+	return nil
+}
+
+// UndelegateCoinsFromModuleToAccount implements bankkeeper.Keeper.
+func (k BankKeeperWithTransferLogs) UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+
+	k.record(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+	return nil
+}
+
+// MintCoins implements bankkeeper.Keeper.
+// Minting is recorded as a transfer from the zero address.
+func (k BankKeeperWithTransferLogs) MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	if err := k.BaseKeeper.MintCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
+	k.record(ctx, nil, authtypes.NewModuleAddress(moduleName), amounts)
+	return nil
+}
+
+// BurnCoins implements bankkeeper.Keeper.
+// Burning is recorded as a transfer to the zero address.
+func (k BankKeeperWithTransferLogs) BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	if err := k.BaseKeeper.BurnCoins(ctx, moduleName, amounts); err != nil {
+		return err
+	}
+
+	k.record(ctx, authtypes.NewModuleAddress(moduleName), nil, amounts)
+	return nil
+}
+
+// sendRestriction records the transfer, it does not restrict anything.
+func (k *BankKeeperWithTransferLogs) sendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	k.record(ctx, fromAddr, toAddr, amt)
+	return toAddr, nil
+}
+
+func (k BankKeeperWithTransferLogs) record(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) {
+	if k.evmKeeper == nil {
+		return
+	}
+
+	k.evmKeeper.AddCosmosTxTransferLogs(sdk.UnwrapSDKContext(ctx), from, to, amt)
+}
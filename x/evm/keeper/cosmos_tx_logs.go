@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/EscanBE/evermint/utils"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+)
+
+// erc20TransferEventTopic is the topic of ERC-20 event `Transfer(address,address,uint256)`.
+var erc20TransferEventTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// AddCosmosTxTransferLogs records the ERC-20 `Transfer` logs for a bank movement of the current Cosmos tx,
+// only the denoms those are backed by an ERC-20 custom precompiled contract are recorded.
+// Nil address means the zero address, used for minting and burning.
+// Bank movements of Ethereum txs are not recorded.
+// The pending logs will be emitted by EmitCosmosTxLogs when the tx is finished.
+//
+// This does not consume gas.
+func (k Keeper) AddCosmosTxTransferLogs(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) {
+	if !k.isRecordingCosmosTxLogs(ctx) {
+		return
+	}
+
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	var logs []*ethtypes.Log
+	for _, coin := range coins {
+		if !coin.Amount.IsPositive() {
+			continue
+		}
+
+		contractAddr := k.cpcKeeper.GetErc20CustomPrecompiledContractAddressByMinDenom(ctx, coin.Denom)
+		if contractAddr == nil {
+			continue
+		}
+
+		logs = append(logs, &ethtypes.Log{
+			Address: *contractAddr,
+			Topics: []common.Hash{
+				erc20TransferEventTopic,
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BytesToHash(coin.Amount.BigInt().Bytes()).Bytes(),
+		})
+	}
+
+	k.AddCosmosTxLogs(ctx, logs...)
+}
+
+// AddCosmosTxLogs records the given logs for the current Cosmos tx,
+// used by the modules which want to notify EVM indexers and wallets about the changes made by Cosmos txs.
+// Logs of Ethereum txs are not recorded, those must be added to the EVM state.
+// The pending logs will be emitted by EmitCosmosTxLogs when the tx is finished.
+//
+// This does not consume gas.
+func (k Keeper) AddCosmosTxLogs(ctx sdk.Context, logs ...*ethtypes.Log) {
+	if len(logs) == 0 || !k.isRecordingCosmosTxLogs(ctx) {
+		return
+	}
+
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	store := ctx.TransientStore(k.transientKey)
+	key := evmtypes.CosmosTxPendingLogsTransientKey(cmttypes.Tx(ctx.TxBytes()).Hash())
+
+	pendingLogs := k.decodeLogs(store.Get(key))
+	pendingLogs = append(pendingLogs, logs...)
+
+	bz, err := rlp.EncodeToBytes(pendingLogs)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "failed to encode logs"))
+	}
+	store.Set(key, bz)
+}
+
+// isRecordingCosmosTxLogs returns true if the logs of the current execution should be recorded.
+func (k Keeper) isRecordingCosmosTxLogs(ctx sdk.Context) bool {
+	if len(ctx.TxBytes()) == 0 {
+		// not a tx execution, like BeginBlock/EndBlock
+		return false
+	}
+
+	if k.IsEthereumTx(utils.UseZeroGasConfig(ctx)) {
+		// the EVM emits logs by itself
+		return false
+	}
+
+	return true
+}
+
+// EmitCosmosTxLogs emits the pending logs of the current Cosmos tx, recorded by AddCosmosTxTransferLogs and AddCosmosTxLogs.
+// The logs are assigned the log index, continue after the logs of the previous transactions in the same block,
+// the tx index computed by GetCosmosTxIndexTransient, and are included into the block bloom.
+//
+// This should be called at the end of the AnteHandler, for the logs of the fee deduction which are kept even if the messages failed,
+// and at the end of the Cosmos tx execution, for the successfully executed txs.
+// This does not consume gas.
+func (k Keeper) EmitCosmosTxLogs(ctx sdk.Context) error {
+	if len(ctx.TxBytes()) == 0 {
+		return nil
+	}
+
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	store := ctx.TransientStore(k.transientKey)
+	cometTxHash := cmttypes.Tx(ctx.TxBytes()).Hash()
+	key := evmtypes.CosmosTxPendingLogsTransientKey(cometTxHash)
+
+	logs := k.decodeLogs(store.Get(key))
+	if len(logs) == 0 {
+		return nil
+	}
+	store.Delete(key)
+
+	startLogIndex := k.GetCumulativeLogCountTransient(ctx, false)
+	txIndex := k.GetCosmosTxIndexTransient(ctx, cometTxHash)
+	for i, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight())
+		log.TxHash = common.BytesToHash(cometTxHash)
+		log.TxIndex = uint(txIndex)
+		log.Index = uint(startLogIndex) + uint(i)
+	}
+
+	store.Set(evmtypes.KeyTransientCosmosTxLogCount, sdk.Uint64ToBigEndian(k.GetCosmosTxLogCountTransient(ctx)+uint64(len(logs))))
+
+	bloom := k.GetCosmosTxLogsBloomTransient(ctx)
+	logsBloom := ethtypes.CreateBloom(ethtypes.Receipts{{Logs: logs}})
+	for i := range bloom {
+		bloom[i] |= logsBloom[i]
+	}
+	store.Set(evmtypes.KeyTransientCosmosTxLogsBloom, bloom.Bytes())
+
+	event, err := evmtypes.GetSdkEventForCosmosTxLogs(logs)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	return nil
+}
+
+// SetBlockTxsTransient records the number of txs in the current block and the position of each tx,
+// to be used to compute the tx index of the logs emitted by Cosmos txs.
+// This should be called before executing the txs of the block.
+func (k Keeper) SetBlockTxsTransient(ctx sdk.Context, txs [][]byte) {
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(evmtypes.KeyTransientBlockTxCount, sdk.Uint64ToBigEndian(uint64(len(txs))))
+
+	for i, tx := range txs {
+		key := evmtypes.BlockTxPositionTransientKey(cmttypes.Tx(tx).Hash())
+		if store.Has(key) {
+			// duplicated tx, keep the first position
+			continue
+		}
+		store.Set(key, sdk.Uint64ToBigEndian(uint64(i)))
+	}
+}
+
+// GetCosmosTxIndexTransient returns the tx index to be used for the logs emitted by the Cosmos tx with the given CometBFT tx hash.
+//
+// The index of Ethereum txs is counted among the Ethereum txs only, so the position of a Cosmos tx in the block
+// can be the same as the index of an Ethereum tx. To prevent collision, Cosmos txs are placed after all txs of the block:
+// tx index = number of txs in the block + position of the tx in the block.
+//
+// When the position is unknown, like simulation, the tx is considered as the next tx after the block.
+func (k Keeper) GetCosmosTxIndexTransient(ctx sdk.Context, cometTxHash []byte) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+
+	blockTxCount := sdk.BigEndianToUint64(store.Get(evmtypes.KeyTransientBlockTxCount))
+
+	position := blockTxCount
+	if bz := store.Get(evmtypes.BlockTxPositionTransientKey(cometTxHash)); len(bz) > 0 {
+		position = sdk.BigEndianToUint64(bz)
+	}
+
+	return blockTxCount + position
+}
+
+// GetCosmosTxLogCountTransient returns the total number of logs emitted by Cosmos txs in the current block.
+func (k Keeper) GetCosmosTxLogCountTransient(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(evmtypes.KeyTransientCosmosTxLogCount))
+}
+
+// GetCosmosTxLogsBloomTransient returns the bloom of the logs emitted by Cosmos txs in the current block.
+func (k Keeper) GetCosmosTxLogsBloomTransient(ctx sdk.Context) ethtypes.Bloom {
+	store := ctx.TransientStore(k.transientKey)
+	return ethtypes.BytesToBloom(store.Get(evmtypes.KeyTransientCosmosTxLogsBloom))
+}
+
+func (k Keeper) decodeLogs(bz []byte) []*ethtypes.Log {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var logs []*ethtypes.Log
+	if err := rlp.DecodeBytes(bz, &logs); err != nil {
+		panic(errorsmod.Wrap(err, "failed to decode logs"))
+	}
+	return logs
+}
@@ -0,0 +1,401 @@
+package keeper_test
+
+//goland:noinspection SpellCheckingInspection
+import (
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/EscanBE/evermint/constants"
+	"github.com/EscanBE/evermint/integration_test_util"
+	itutiltypes "github.com/EscanBE/evermint/integration_test_util/types"
+	rpcbackend "github.com/EscanBE/evermint/rpc/backend"
+	rpctypes "github.com/EscanBE/evermint/rpc/types"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+)
+
+var erc20TransferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+type CosmosTxLogsIntegrationTestSuite struct {
+	suite.Suite
+	CITS *integration_test_util.ChainIntegrationTestSuite
+	// contractAddr is the address of the ERC-20 custom precompiled contract of the base denom
+	contractAddr common.Address
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) App() itutiltypes.ChainApp {
+	return suite.CITS.ChainApp
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) Ctx() sdk.Context {
+	return suite.CITS.CurrentContext
+}
+
+func TestCosmosTxLogsIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(CosmosTxLogsIntegrationTestSuite))
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) SetupTest() {
+	suite.CITS = integration_test_util.CreateChainIntegrationTestSuiteFromChainConfig(suite.T(), suite.Require(), integration_test_util.IntegrationTestChain1, true)
+
+	contractAddr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(suite.Ctx(), constants.DisplayDenom, cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   constants.DisplayDenom,
+		Decimals: constants.BaseDenomExponent,
+		MinDenom: constants.BaseDenom,
+	})
+	suite.Require().NoError(err)
+	suite.contractAddr = contractAddr
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) TearDownTest() {
+	suite.CITS.Cleanup()
+}
+
+// getCosmosTxLogs returns the logs of Cosmos tx, parsed from the given events.
+func (suite *CosmosTxLogsIntegrationTestSuite) getCosmosTxLogs(events []abci.Event) []*ethtypes.Log {
+	var logs []*ethtypes.Log
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeCosmosTxLogs {
+			continue
+		}
+
+		eventLogs, err := rpcbackend.ParseCosmosTxLogsFromEvent(event)
+		suite.Require().NoError(err)
+		logs = append(logs, eventLogs...)
+	}
+	return logs
+}
+
+func sdkEventsToAbciEvents(events sdk.Events) []abci.Event {
+	abciEvents := make([]abci.Event, 0, len(events))
+	for _, event := range events {
+		abciEvents = append(abciEvents, abci.Event(event))
+	}
+	return abciEvents
+}
+
+// findTransferLog returns the first ERC-20 `Transfer` log of the given sender and receiver.
+func (suite *CosmosTxLogsIntegrationTestSuite) findTransferLog(logs []*ethtypes.Log, from, to common.Address) *ethtypes.Log {
+	for _, log := range logs {
+		suite.Require().Len(log.Topics, 3)
+		suite.Require().Equal(erc20TransferEventTopic, log.Topics[0])
+
+		if common.BytesToAddress(log.Topics[1].Bytes()) == from && common.BytesToAddress(log.Topics[2].Bytes()) == to {
+			return log
+		}
+	}
+	return nil
+}
+
+// finalizeBlock executes the given txs in a single block, without committing.
+func (suite *CosmosTxLogsIntegrationTestSuite) finalizeBlock(txs ...sdk.Tx) *abci.ResponseFinalizeBlock {
+	var bzTxs [][]byte
+	for _, tx := range txs {
+		bzTx, err := suite.CITS.EncodingConfig.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		bzTxs = append(bzTxs, bzTx)
+	}
+
+	suite.CITS.ReflectChangesToCommitMultiStore()
+
+	header := suite.Ctx().BlockHeader()
+	res, err := suite.CITS.BaseApp().FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             header.Height,
+		Txs:                bzTxs,
+		Hash:               header.AppHash,
+		Time:               header.Time,
+		ProposerAddress:    header.ProposerAddress,
+		NextValidatorsHash: header.NextValidatorsHash,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.TxResults, len(txs))
+
+	return res
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) TestMsgSend() {
+	sender := suite.CITS.WalletAccounts.Number(1)
+	receiver := suite.CITS.WalletAccounts.Number(2)
+
+	const otherDenom = "uother"
+	otherCoins := sdk.NewCoins(sdk.NewInt64Coin(otherDenom, 1000))
+	suite.Require().NoError(suite.App().BankKeeper().MintCoins(suite.Ctx(), minttypes.ModuleName, otherCoins))
+	suite.Require().NoError(suite.App().BankKeeper().SendCoinsFromModuleToAccount(suite.Ctx(), minttypes.ModuleName, sender.GetCosmosAddress(), otherCoins))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(constants.BaseDenom, 1000), sdk.NewInt64Coin(otherDenom, 500))
+	tx, res, err := suite.CITS.DeliverTx(suite.Ctx(), sender, nil, &banktypes.MsgSend{
+		FromAddress: sender.GetCosmosAddress().String(),
+		ToAddress:   receiver.GetCosmosAddress().String(),
+		Amount:      amount,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.Code, res.Log)
+
+	bzTx, err := suite.CITS.EncodingConfig.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	wantTxHash := common.BytesToHash(cmttypes.Tx(bzTx).Hash())
+
+	logs := suite.getCosmosTxLogs(res.Events)
+	suite.Require().Len(logs, 2, "want logs of the fee deduction and the MsgSend")
+
+	for i, log := range logs {
+		suite.Equal(suite.contractAddr, log.Address, "only denom backed by ERC-20 CPC should have logs")
+		suite.Equal(wantTxHash, log.TxHash)
+		suite.Equal(logs[0].Index+uint(i), log.Index, "log index must be continuous")
+		suite.Equal(logs[0].TxIndex, log.TxIndex)
+	}
+
+	feeLog := suite.findTransferLog(logs, sender.GetEthAddress(), common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+	if suite.NotNil(feeLog, "missing Transfer log of the fee deduction") {
+		suite.Equal(suite.CITS.TestConfig.DefaultFeeAmount.BigInt(), new(big.Int).SetBytes(feeLog.Data))
+	}
+
+	sendLog := suite.findTransferLog(logs, sender.GetEthAddress(), receiver.GetEthAddress())
+	if suite.NotNil(sendLog, "missing Transfer log of the MsgSend") {
+		suite.Equal(big.NewInt(1000), new(big.Int).SetBytes(sendLog.Data))
+	}
+
+	suite.Run("no log for tx that does not move CPC-backed denom, except fee", func() {
+		_, res, err := suite.CITS.DeliverTx(suite.CITS.CurrentContext, sender, nil, &banktypes.MsgSend{
+			FromAddress: sender.GetCosmosAddress().String(),
+			ToAddress:   receiver.GetCosmosAddress().String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(otherDenom, 1)),
+		})
+		suite.Require().NoError(err)
+		suite.Require().Zero(res.Code, res.Log)
+
+		logs := suite.getCosmosTxLogs(res.Events)
+		suite.Len(logs, 1)
+		suite.Nil(suite.findTransferLog(logs, sender.GetEthAddress(), receiver.GetEthAddress()))
+	})
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) TestMsgDelegate() {
+	delegator := suite.CITS.WalletAccounts.Number(1)
+
+	validators, err := suite.App().StakingKeeper().GetBondedValidatorsByPower(suite.Ctx())
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(validators)
+
+	_, res, err := suite.CITS.DeliverTx(suite.Ctx(), delegator, nil, &stakingtypes.MsgDelegate{
+		DelegatorAddress: delegator.GetCosmosAddress().String(),
+		ValidatorAddress: validators[0].OperatorAddress,
+		Amount:           sdk.NewInt64Coin(constants.BaseDenom, 1000),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.Code, res.Log)
+
+	bondedPool := common.BytesToAddress(authtypes.NewModuleAddress(stakingtypes.BondedPoolName))
+	delegateLog := suite.findTransferLog(suite.getCosmosTxLogs(res.Events), delegator.GetEthAddress(), bondedPool)
+	if suite.NotNil(delegateLog, "missing Transfer log of the delegation") {
+		suite.Equal(big.NewInt(1000), new(big.Int).SetBytes(delegateLog.Data))
+	}
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) TestBankKeeperMethods() {
+	account := suite.CITS.WalletAccounts.Number(1)
+
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(constants.BaseDenom, 1000))
+
+	tests := []struct {
+		name     string
+		execute  func(ctx sdk.Context) error
+		wantFrom common.Address
+		wantTo   common.Address
+	}{
+		{
+			name: "DelegateCoins",
+			execute: func(ctx sdk.Context) error {
+				return suite.App().BankKeeper().DelegateCoins(ctx, account.GetCosmosAddress(), bondedPool, amount)
+			},
+			wantFrom: account.GetEthAddress(),
+			wantTo:   common.BytesToAddress(bondedPool),
+		},
+		{
+			name: "UndelegateCoins",
+			execute: func(ctx sdk.Context) error {
+				return suite.App().BankKeeper().UndelegateCoins(ctx, bondedPool, account.GetCosmosAddress(), amount)
+			},
+			wantFrom: common.BytesToAddress(bondedPool),
+			wantTo:   account.GetEthAddress(),
+		},
+		{
+			name: "MintCoins",
+			execute: func(ctx sdk.Context) error {
+				return suite.App().BankKeeper().MintCoins(ctx, minttypes.ModuleName, amount)
+			},
+			wantFrom: common.Address{},
+			wantTo:   common.BytesToAddress(authtypes.NewModuleAddress(minttypes.ModuleName)),
+		},
+		{
+			name: "BurnCoins",
+			execute: func(ctx sdk.Context) error {
+				return suite.App().BankKeeper().BurnCoins(ctx, stakingtypes.BondedPoolName, amount)
+			},
+			wantFrom: common.BytesToAddress(bondedPool),
+			wantTo:   common.Address{},
+		},
+	}
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			ctx, _ := suite.Ctx().CacheContext()
+			ctx = ctx.WithTxBytes([]byte("cosmos-tx")).WithEventManager(sdk.NewEventManager())
+
+			suite.Require().NoError(tt.execute(ctx))
+			suite.Require().NoError(suite.App().EvmKeeper().EmitCosmosTxLogs(ctx))
+
+			logs := suite.getCosmosTxLogs(sdkEventsToAbciEvents(ctx.EventManager().Events()))
+			suite.Require().Len(logs, 1)
+
+			log := suite.findTransferLog(logs, tt.wantFrom, tt.wantTo)
+			if suite.NotNil(log) {
+				suite.Equal(suite.contractAddr, log.Address)
+				suite.Equal(big.NewInt(1000), new(big.Int).SetBytes(log.Data))
+			}
+		})
+	}
+
+	suite.Run("not recorded for Ethereum tx", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		ctx = ctx.WithTxBytes([]byte("ethereum-tx")).WithEventManager(sdk.NewEventManager())
+		suite.App().EvmKeeper().SetFlagEthereumTx(ctx, true)
+
+		suite.Require().NoError(suite.App().BankKeeper().MintCoins(ctx, minttypes.ModuleName, amount))
+		suite.Require().NoError(suite.App().EvmKeeper().EmitCosmosTxLogs(ctx))
+
+		suite.Empty(suite.getCosmosTxLogs(sdkEventsToAbciEvents(ctx.EventManager().Events())))
+	})
+
+	suite.Run("not recorded outside of tx execution", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		suite.Require().NoError(suite.App().BankKeeper().MintCoins(ctx, minttypes.ModuleName, amount))
+		suite.Require().NoError(suite.App().EvmKeeper().EmitCosmosTxLogs(ctx.WithTxBytes([]byte("cosmos-tx"))))
+
+		suite.Empty(suite.getCosmosTxLogs(sdkEventsToAbciEvents(ctx.EventManager().Events())))
+	})
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) TestSameBlockWithEthereumTx() {
+	cosmosSender := suite.CITS.WalletAccounts.Number(1)
+	cosmosReceiver := suite.CITS.WalletAccounts.Number(2)
+	ethSender := suite.CITS.WalletAccounts.Number(3)
+	ethReceiver := suite.CITS.WalletAccounts.Number(4)
+
+	cosmosTx, err := suite.CITS.PrepareCosmosTx(suite.Ctx(), cosmosSender, integration_test_util.CosmosTxArgs{
+		Gas: 10_000_000,
+		Msgs: []sdk.Msg{&banktypes.MsgSend{
+			FromAddress: cosmosSender.GetCosmosAddress().String(),
+			ToAddress:   cosmosReceiver.GetCosmosAddress().String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(constants.BaseDenom, 1000)),
+		}},
+	})
+	suite.Require().NoError(err)
+
+	// transfer via the ERC-20 custom precompiled contract, to emit log
+	input := append([]byte{}, crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]...)
+	input = append(input, common.LeftPadBytes(ethReceiver.GetEthAddress().Bytes(), 32)...)
+	input = append(input, common.LeftPadBytes(big.NewInt(2000).Bytes(), 32)...)
+
+	ethTx, err := suite.CITS.PrepareEthTx(ethSender, evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		From:      ethSender.GetEthAddress(),
+		ChainID:   suite.App().EvmKeeper().GetEip155ChainId(suite.Ctx()).BigInt(),
+		Nonce:     suite.App().EvmKeeper().GetNonce(suite.Ctx(), ethSender.GetEthAddress()),
+		GasLimit:  300_000,
+		GasFeeCap: new(big.Int).Mul(suite.App().FeeMarketKeeper().GetBaseFee(suite.Ctx()).BigInt(), big.NewInt(2)),
+		GasTipCap: big.NewInt(1),
+		To:        &suite.contractAddr,
+		Input:     input,
+	}))
+	suite.Require().NoError(err)
+
+	res := suite.finalizeBlock(cosmosTx, ethTx)
+	suite.Require().Zero(res.TxResults[0].Code, res.TxResults[0].Log)
+	suite.Require().Zero(res.TxResults[1].Code, res.TxResults[1].Log)
+
+	cosmosTxLogs := suite.getCosmosTxLogs(res.TxResults[0].Events)
+	suite.Require().Len(cosmosTxLogs, 2, "want logs of the fee deduction and the MsgSend")
+	suite.Empty(suite.getCosmosTxLogs(res.TxResults[1].Events), "Ethereum tx must not emit Cosmos tx logs")
+
+	var ethReceipt *rpcbackend.InCompletedEthReceipt
+	for _, event := range res.TxResults[1].Events {
+		if event.Type != evmtypes.EventTypeTxReceipt {
+			continue
+		}
+		ethReceipt, err = rpcbackend.ParseTxReceiptFromEvent(event)
+		suite.Require().NoError(err)
+	}
+	suite.Require().NotNil(ethReceipt)
+	suite.Require().Equal(ethtypes.ReceiptStatusSuccessful, ethReceipt.Status)
+	suite.Require().Len(ethReceipt.Logs, 1)
+	ethLog := ethReceipt.Logs[0]
+
+	suite.Run("tx index must not collide", func() {
+		suite.Zero(ethLog.TxIndex, "the Ethereum tx is the first Ethereum tx of the block")
+		for _, log := range cosmosTxLogs {
+			suite.NotEqual(ethLog.TxIndex, log.TxIndex)
+			suite.Equal(uint(2 /*number of txs in block*/ +0 /*position*/), log.TxIndex)
+		}
+	})
+
+	suite.Run("log index must be continuous", func() {
+		for i, log := range cosmosTxLogs {
+			suite.Equal(uint(i), log.Index)
+		}
+		suite.Equal(uint(len(cosmosTxLogs)), ethLog.Index)
+	})
+
+	suite.Run("block bloom includes logs of Cosmos tx", func() {
+		bloom := rpctypes.BloomFromEvents(res.Events)
+		suite.Require().NotNil(bloom)
+
+		suite.True(bloom.Test(suite.contractAddr.Bytes()))
+		suite.True(bloom.Test(common.BytesToHash(cosmosReceiver.GetEthAddress().Bytes()).Bytes()), "missing topic of Cosmos tx log")
+		suite.True(bloom.Test(common.BytesToHash(ethReceiver.GetEthAddress().Bytes()).Bytes()), "missing topic of Ethereum tx log")
+	})
+}
+
+func (suite *CosmosTxLogsIntegrationTestSuite) TestFailedTx() {
+	sender := suite.CITS.WalletAccounts.Number(1)
+	receiver := suite.CITS.WalletAccounts.Number(2)
+
+	balance := suite.App().BankKeeper().GetBalance(suite.Ctx(), sender.GetCosmosAddress(), constants.BaseDenom)
+
+	tx, err := suite.CITS.PrepareCosmosTx(suite.Ctx(), sender, integration_test_util.CosmosTxArgs{
+		Gas: 10_000_000,
+		Msgs: []sdk.Msg{&banktypes.MsgSend{
+			FromAddress: sender.GetCosmosAddress().String(),
+			ToAddress:   receiver.GetCosmosAddress().String(),
+			Amount:      sdk.NewCoins(balance), // insufficient funds after fee deduction
+		}},
+	})
+	suite.Require().NoError(err)
+
+	res := suite.finalizeBlock(tx)
+	suite.Require().NotZero(res.TxResults[0].Code, "tx must fail")
+
+	logs := suite.getCosmosTxLogs(res.TxResults[0].Events)
+	suite.Require().Len(logs, 1, "only log of the fee deduction is kept")
+
+	feeLog := suite.findTransferLog(logs, sender.GetEthAddress(), common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+	suite.Require().NotNil(feeLog, "missing Transfer log of the fee deduction")
+	suite.Equal(suite.CITS.TestConfig.DefaultFeeAmount.BigInt(), new(big.Int).SetBytes(feeLog.Data))
+
+	bloom := rpctypes.BloomFromEvents(res.Events)
+	suite.Require().NotNil(bloom)
+	suite.True(bloom.Test(suite.contractAddr.Bytes()))
+}
@@ -13,6 +13,7 @@ import (
 	evmvm "github.com/EscanBE/evermint/x/evm/vm"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/EscanBE/evermint/utils"
 	"github.com/ethereum/go-ethereum/eth/tracers"
@@ -28,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	corevm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	evertypes "github.com/EscanBE/evermint/types"
@@ -201,7 +203,12 @@ func (k Keeper) Code(c context.Context, req *evmtypes.QueryCodeRequest) (*evmtyp
 	address := common.HexToAddress(req.Address)
 
 	{ // check if precompiled, returns a pseudocode to bypass logic check when importing token like Metamask
-		if k.cpcKeeper.HasCustomPrecompiledContract(ctx, address) {
+		if cpcMeta := k.cpcKeeper.GetCustomPrecompiledContractMeta(ctx, address); cpcMeta != nil {
+			if cpcMeta.Disabled {
+				// disabled contracts are presented as having no code
+				return &evmtypes.QueryCodeResponse{}, nil
+			}
+
 			return &evmtypes.QueryCodeResponse{
 				Code: cpctypes.PseudoCodePrecompiled,
 			}, nil
@@ -244,11 +251,11 @@ func (k Keeper) EthCall(c context.Context, req *evmtypes.EthCallRequest) (*evmty
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	cfg, err := k.EVMConfig(ctx, nil)
+
+	ctx, cfg, err := k.prepareEthCall(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	cfg.NoBaseFee = true
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -289,6 +296,11 @@ func (k Keeper) EstimateGas(c context.Context, req *evmtypes.EthCallRequest) (*e
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	ctx, cfg, err := k.prepareEthCall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -317,11 +329,6 @@ func (k Keeper) EstimateGas(c context.Context, req *evmtypes.EthCallRequest) (*e
 	}
 
 	gasCap = hi
-	cfg, err := k.EVMConfig(ctx, nil)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to load evm config")
-	}
-	cfg.NoBaseFee = true
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -393,6 +400,191 @@ func (k Keeper) EstimateGas(c context.Context, req *evmtypes.EthCallRequest) (*e
 	return &evmtypes.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements the `eth_createAccessList` rpc api.
+// The call is executed repeatedly with the access list tracer, each time with the access list
+// produced by the previous execution, until the access list converges.
+func (k Keeper) CreateAccessList(c context.Context, req *evmtypes.EthCallRequest) (*evmtypes.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	var args evmtypes.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, cfg, err := k.prepareEthCall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the sender, the recipient and the precompiles are always warm, so they are excluded from the access list
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+	const mergeNetsplit = true
+	precompiles := corevm.ActivePrecompiles(cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), mergeNetsplit))
+	// like NewEVM, all the custom precompiled contracts are registered, including the disabled ones
+	for _, meta := range k.cpcKeeper.GetAllCustomPrecompiledContractsMeta(ctx) {
+		precompiles = append(precompiles, common.BytesToAddress(meta.Address))
+	}
+
+	var initialAccessList ethtypes.AccessList
+	if args.AccessList != nil {
+		initialAccessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(initialAccessList, from, to, precompiles)
+
+	for {
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		txConfig := k.NewTxConfigFromMessage(ctx, msg)
+
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &evmtypes.CreateAccessListResponse{
+				AccessList: evmtypes.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+
+		prevTracer = tracer
+	}
+}
+
+// SimulateV1 implements the `eth_simulateV1` rpc api.
+// The calls are executed sequentially on top of the state of the queried block,
+// state changes are carried over the calls and the simulated blocks, but never persisted.
+func (k Keeper) SimulateV1(c context.Context, req *evmtypes.SimulateV1Request) (*evmtypes.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var opts evmtypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	// branch the context so nothing is persisted
+	ctx, _ = ctx.CacheContext()
+
+	var (
+		prevNumber = ctx.BlockHeight()
+		prevTime   = ctx.BlockTime().Unix()
+		// like go-ethereum, the gas cap is the budget shared by all the calls of the simulation
+		gasBudget     = req.GasCap
+		blockGasLimit uint64
+	)
+
+	if params := ctx.ConsensusParams(); params.Block != nil && params.Block.MaxGas > 0 {
+		blockGasLimit = uint64(params.Block.MaxGas)
+	}
+
+	blocks := make([]evmtypes.SimulatedBlock, 0, len(opts.BlockStateCalls))
+	for i, simBlock := range opts.BlockStateCalls {
+		var blockOverrides evmtypes.BlockOverrides
+		if simBlock.BlockOverrides != nil {
+			blockOverrides = *simBlock.BlockOverrides
+		}
+
+		if blockOverrides.Number == nil {
+			blockOverrides.Number = (*hexutil.Big)(big.NewInt(prevNumber + 1))
+		} else if blockOverrides.Number.ToInt().Int64() <= prevNumber {
+			return nil, status.Errorf(codes.InvalidArgument, "block #%d: block numbers must be in order: %d <= %d", i, blockOverrides.Number.ToInt().Int64(), prevNumber)
+		}
+
+		if blockOverrides.Time == nil {
+			blockTime := hexutil.Uint64(prevTime + evmtypes.SimulateTimestampIncrement)
+			blockOverrides.Time = &blockTime
+		} else if int64(*blockOverrides.Time) <= prevTime {
+			return nil, status.Errorf(codes.InvalidArgument, "block #%d: block timestamps must be in order: %d <= %d", i, uint64(*blockOverrides.Time), prevTime)
+		}
+
+		blockCtx := applyBlockOverridesToContext(ctx, blockOverrides)
+
+		cfg, err := k.EVMConfig(blockCtx, nil)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		cfg.NoBaseFee = !opts.Validation
+		applyBlockOverridesToConfig(cfg, blockOverrides)
+
+		if simBlock.StateOverrides != nil {
+			if err := k.applyStateOverride(blockCtx, cfg, *simBlock.StateOverrides); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "block #%d: %s", i, err.Error())
+			}
+		}
+
+		block := evmtypes.SimulatedBlock{
+			Number:   blockCtx.BlockHeight(),
+			Time:     blockCtx.BlockTime().Unix(),
+			Coinbase: cfg.CoinBase.Hex(),
+			BaseFee:  sdkmath.NewIntFromBigInt(cfg.BaseFee),
+		}
+
+		for j, args := range simBlock.Calls {
+			gasCap, err := simulateCallGasCap(args, req.GasCap, gasBudget, blockGasLimit, block.GasUsed)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "block #%d, call #%d: %s", i, j, err.Error())
+			}
+
+			rsp, err := k.simulateCall(blockCtx, cfg, args, gasCap, opts)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "block #%d, call #%d: %s", i, j, err.Error())
+			}
+
+			if gasBudget > rsp.GasUsed {
+				gasBudget -= rsp.GasUsed
+			} else {
+				gasBudget = 0
+			}
+
+			block.GasUsed += rsp.GasUsed
+			block.Calls = append(block.Calls, rsp)
+		}
+
+		blocks = append(blocks, block)
+
+		prevNumber = block.Number
+		prevTime = block.Time
+	}
+
+	return &evmtypes.SimulateV1Response{
+		Blocks: blocks,
+	}, nil
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -422,6 +614,41 @@ func (k Keeper) TraceTx(c context.Context, req *evmtypes.QueryTraceTxRequest) (*
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
 	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	if len(req.BlockTxs) > 0 && k.canReplayTxs() {
+		// replay the block up to the requested tx, including the Cosmos txs and the failed txs
+		replayCtx, cancel, err := withReplayTimeout(ctx, req.TraceConfig)
+		if err != nil {
+			return nil, err
+		}
+		defer cancel()
+
+		replayCtx, err = k.newReplayContext(replayCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := k.traceBlockTx(replayCtx, cfg.ChainConfig, req.Msg.AsTransaction().Hash(), req.BlockTxs, req.TraceConfig, tracerConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &evmtypes.QueryTraceTxResponse{
+			Data: resultData,
+		}, nil
+	}
+
 	cfg.NoBaseFee = true
 
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
@@ -456,18 +683,16 @@ func (k Keeper) TraceTx(c context.Context, req *evmtypes.QueryTraceTxRequest) (*
 	}
 
 	ethTx := req.Msg.AsTransaction()
+	msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errorsmod.Wrapf(err, "failed to convert tx %s to message", ethTx.Hash().Hex()).Error())
+	}
 
 	txConfig := k.NewTxConfig(ctx, ethTx)
 	txConfig.TxIndex = uint(len(req.Predecessors))
 	txConfig.LogIndex = prevLogIndex
 
-	var tracerConfig json.RawMessage
-	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
-		// ignore error. default to no traceConfig
-		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
-	}
-
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, false, tracerConfig)
+	result, _, err := k.traceTx(ctx, cfg, txConfig, ethTx, msg, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -512,6 +737,35 @@ func (k Keeper) TraceBlock(c context.Context, req *evmtypes.QueryTraceBlockReque
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+
+	if len(req.BlockTxs) > 0 && k.canReplayTxs() {
+		// replay the whole block, including the Cosmos txs and the failed txs
+		replayCtx, cancel, err := withReplayTimeout(ctx, req.TraceConfig)
+		if err != nil {
+			return nil, err
+		}
+		defer cancel()
+
+		replayCtx, err = k.newReplayContext(replayCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		results, err := k.traceBlockTxs(replayCtx, cfg.ChainConfig, req.BlockTxs, req.TraceConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		resultData, err := json.Marshal(results)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &evmtypes.QueryTraceBlockResponse{
+			Data: resultData,
+		}, nil
+	}
+
 	cfg.NoBaseFee = true
 
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
@@ -525,10 +779,17 @@ func (k Keeper) TraceBlock(c context.Context, req *evmtypes.QueryTraceBlockReque
 	for i, tx := range req.Txs {
 		result := evmtypes.TxTraceResult{}
 		ethTx := tx.AsTransaction()
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			result.Error = status.Error(codes.Internal, errorsmod.Wrapf(err, "failed to convert tx %s to message", ethTx.Hash().Hex()).Error()).Error()
+			results = append(results, &result)
+			continue
+		}
+
 		txConfig := k.NewTxConfig(ctx, ethTx)
 		txConfig.TxIndex = uint(i)
 		txConfig.LogIndex = prevLogIndex
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, ethTx, msg, req.TraceConfig, true, nil)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -548,36 +809,140 @@ func (k Keeper) TraceBlock(c context.Context, req *evmtypes.QueryTraceBlockReque
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the queried block, with the optional overrides applied.
+// The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *evmtypes.QueryTraceCallRequest) (*evmtypes.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = utils.UseZeroGasConfig(ctx)
+
+	var args evmtypes.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, cfg, err := k.prepareEthCall(ctx, &evmtypes.EthCallRequest{
+		Args:           req.Args,
+		GasCap:         req.GasCap,
+		StateOverrides: req.StateOverrides,
+		BlockOverrides: req.BlockOverrides,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the unsigned transaction is used to set up the execution context and to identify the trace
+	gas := hexutil.Uint64(msg.Gas())
+	args.Gas = &gas
+	ethTx := args.ToTransaction().AsTransaction()
+
+	txConfig := k.NewTxConfig(ctx, ethTx)
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	result, _, err := k.traceTx(ctx, cfg, txConfig, ethTx, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceTx
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evmtypes.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
+// The transaction is used to set up the execution context, the message is the one to be executed.
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
 	cfg *evmvm.EVMConfig,
 	txConfig evmvm.TxConfig,
-	signer ethtypes.Signer,
 	ethTx *ethtypes.Transaction,
+	msg core.Message,
 	traceConfig *evmtypes.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
+	tCtx := &tracers.Context{
+		BlockHash: txConfig.BlockHash,
+		TxIndex:   int(txConfig.TxIndex),
+		TxHash:    txConfig.TxHash,
+	}
+
+	var nextLogIndex uint
+	result, err := k.runTracer(ctx, cfg.ChainConfig, tCtx, traceConfig, tracerJSONConfig, func(tracer corevm.EVMLogger) error {
+		ctx = k.SetupExecutionContext(ctx, ethTx)
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, commitMessage, cfg, txConfig)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		k.ResetGasMeterAndConsumeGas(ctx, res.GasUsed)
+
+		receipt := &ethtypes.Receipt{}
+		if err := receipt.UnmarshalBinary(res.MarshalledReceipt); err != nil {
+			return status.Errorf(codes.Internal, "failed to unmarshal receipt: %v", err)
+		}
+		nextLogIndex = txConfig.LogIndex + uint(len(receipt.Logs))
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return result, nextLogIndex, nil
+}
+
+// runTracer assembles the tracer according to the provided configuration, then runs the given execution
+// with it, within the trace timeout. It returns the result of the tracer.
+func (k *Keeper) runTracer(
+	ctx sdk.Context,
+	chainConfig *ethparams.ChainConfig,
+	tCtx *tracers.Context,
+	traceConfig *evmtypes.TraceConfig,
+	tracerJSONConfig json.RawMessage,
+	execute func(tracer corevm.EVMLogger) error,
+) (*interface{}, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
 		tracer    tracers.Tracer
 		overrides *ethparams.ChainConfig
 		err       error
-		timeout   = defaultTraceTimeout
 	)
 
-	msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, errorsmod.Wrapf(err, "failed to convert tx %s to message", ethTx.Hash().Hex()).Error())
-	}
-
 	if traceConfig == nil {
 		traceConfig = &evmtypes.TraceConfig{}
 	}
 
 	if traceConfig.Overrides != nil {
-		overrides = traceConfig.Overrides.EthereumConfig(cfg.ChainConfig.ChainID)
+		overrides = traceConfig.Overrides.EthereumConfig(chainConfig.ChainID)
 	}
 
 	logConfig := logger.Config{
@@ -592,23 +957,21 @@ func (k *Keeper) traceTx(
 
 	tracer = logger.NewStructLogger(&logConfig)
 
-	tCtx := &tracers.Context{
-		BlockHash: txConfig.BlockHash,
-		TxIndex:   int(txConfig.TxIndex),
-		TxHash:    txConfig.TxHash,
-	}
-
 	if traceConfig.Tracer != "" {
 		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
-			return nil, 0, status.Error(codes.Internal, err.Error())
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if traceConfig.Tracer == evmtypes.TracerCall {
+			// nests the decoded custom precompiled contract calls into the call frames
+			tracer = evmtypes.NewCpcCallTracer(tracer)
 		}
 	}
 
 	// Define a meaningful timeout of a single transaction trace
-	if traceConfig.Timeout != "" {
-		if timeout, err = time.ParseDuration(traceConfig.Timeout); err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
-		}
+	timeout, err := getTraceTimeout(traceConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	// Handle timeouts and RPC cancellations
@@ -622,25 +985,31 @@ func (k *Keeper) traceTx(
 		}
 	}()
 
-	ctx = k.SetupExecutionContext(ctx, ethTx)
-	res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, commitMessage, cfg, txConfig)
+	if err := execute(tracer); err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	result, err = tracer.GetResult()
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-	k.ResetGasMeterAndConsumeGas(ctx, res.GasUsed)
 
-	receipt := &ethtypes.Receipt{}
-	if err := receipt.UnmarshalBinary(res.MarshalledReceipt); err != nil {
-		return nil, 0, status.Errorf(codes.Internal, "failed to unmarshal receipt: %v", err)
+	return &result, nil
+}
+
+// getTraceTimeout returns the timeout of the trace, following the trace config.
+func getTraceTimeout(traceConfig *evmtypes.TraceConfig) (time.Duration, error) {
+	if traceConfig == nil || traceConfig.Timeout == "" {
+		return defaultTraceTimeout, nil
 	}
 
-	var result interface{}
-	result, err = tracer.GetResult()
+	timeout, err := time.ParseDuration(traceConfig.Timeout)
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
+		return 0, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
 	}
 
-	return &result, txConfig.LogIndex + uint(len(receipt.Logs)), nil
+	return timeout, nil
 }
 
 // BaseFee implements the Query/BaseFee gRPC method
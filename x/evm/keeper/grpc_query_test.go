@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	evmvm "github.com/EscanBE/evermint/x/evm/vm"
 
@@ -23,6 +24,7 @@ import (
 
 	"github.com/EscanBE/evermint/server/config"
 	utiltx "github.com/EscanBE/evermint/testutil/tx"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
 	evmtypes "github.com/EscanBE/evermint/x/evm/types"
 )
 
@@ -1207,6 +1209,149 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	sender := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+
+	// NUMBER PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	code := []byte{0x43, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	stateOverride, err := json.Marshal(evmtypes.StateOverride{
+		contract: evmtypes.OverrideAccount{
+			Code: (*hexutil.Bytes)(&code),
+		},
+	})
+	suite.Require().NoError(err)
+
+	blockOverrides, err := json.Marshal(evmtypes.BlockOverrides{
+		Number: (*hexutil.Big)(big.NewInt(1_000_000)),
+	})
+	suite.Require().NoError(err)
+
+	args, err := json.Marshal(&evmtypes.TransactionArgs{
+		From: &sender,
+		To:   &contract,
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		req      func() *evmtypes.QueryTraceCallRequest
+		wantErr  bool
+		validate func(data []byte)
+	}{
+		{
+			name: "pass - default tracer",
+			req: func() *evmtypes.QueryTraceCallRequest {
+				return &evmtypes.QueryTraceCallRequest{
+					Args:           args,
+					GasCap:         config.DefaultGasCap,
+					StateOverrides: stateOverride,
+				}
+			},
+			validate: func(data []byte) {
+				var result ethlogger.ExecutionResult
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.False(result.Failed)
+				suite.Len(result.StructLogs, 6)
+				suite.Equal("NUMBER", result.StructLogs[0].Op)
+				suite.Equal(common.BigToHash(big.NewInt(suite.ctx.BlockHeight())).Hex()[2:], result.ReturnValue)
+			},
+		},
+		{
+			name: "pass - call tracer with overrides",
+			req: func() *evmtypes.QueryTraceCallRequest {
+				return &evmtypes.QueryTraceCallRequest{
+					Args:   args,
+					GasCap: config.DefaultGasCap,
+					TraceConfig: &evmtypes.TraceConfig{
+						Tracer: evmtypes.TracerCall,
+					},
+					StateOverrides: stateOverride,
+					BlockOverrides: blockOverrides,
+				}
+			},
+			validate: func(data []byte) {
+				var result map[string]interface{}
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Equal("CALL", result["type"])
+				suite.Equal(strings.ToLower(sender.Hex()), result["from"])
+				suite.Equal(strings.ToLower(contract.Hex()), result["to"])
+				suite.Equal(hexutil.Encode(common.BigToHash(big.NewInt(1_000_000)).Bytes()), result["output"])
+			},
+		},
+		{
+			name: "pass - call tracer without code",
+			req: func() *evmtypes.QueryTraceCallRequest {
+				return &evmtypes.QueryTraceCallRequest{
+					Args:   args,
+					GasCap: config.DefaultGasCap,
+					TraceConfig: &evmtypes.TraceConfig{
+						Tracer: evmtypes.TracerCall,
+					},
+				}
+			},
+			validate: func(data []byte) {
+				var result map[string]interface{}
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Equal("CALL", result["type"])
+				suite.Equal("0x", result["output"])
+			},
+		},
+		{
+			name: "fail - invalid args",
+			req: func() *evmtypes.QueryTraceCallRequest {
+				return &evmtypes.QueryTraceCallRequest{
+					Args:   []byte("invalid args"),
+					GasCap: config.DefaultGasCap,
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail - negative limit",
+			req: func() *evmtypes.QueryTraceCallRequest {
+				return &evmtypes.QueryTraceCallRequest{
+					Args:   args,
+					GasCap: config.DefaultGasCap,
+					TraceConfig: &evmtypes.TraceConfig{
+						Limit: -1,
+					},
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail - unknown tracer",
+			req: func() *evmtypes.QueryTraceCallRequest {
+				return &evmtypes.QueryTraceCallRequest{
+					Args:   args,
+					GasCap: config.DefaultGasCap,
+					TraceConfig: &evmtypes.TraceConfig{
+						Tracer: "unknownTracer",
+					},
+				}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			res, err := suite.queryClient.TraceCall(suite.ctx, tc.req())
+			if tc.wantErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validate(res.Data)
+
+			suite.Empty(suite.app.EvmKeeper.GetCode(suite.ctx, suite.app.EvmKeeper.GetCodeHash(suite.ctx, contract.Bytes())), "overrides must not be persisted")
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	address := utiltx.GenerateAddress()
 	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
@@ -1387,6 +1532,175 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	sender := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	touched := utiltx.GenerateAddress()
+	slot := common.BigToHash(big.NewInt(1))
+
+	// PUSH1 0x01 SLOAD POP PUSH20 <touched> BALANCE POP STOP
+	code := append([]byte{0x60, 0x01, 0x54, 0x50, 0x73}, touched.Bytes()...)
+	code = append(code, 0x31, 0x50, 0x00)
+	stateOverride, err := json.Marshal(evmtypes.StateOverride{
+		contract: evmtypes.OverrideAccount{
+			Code: (*hexutil.Bytes)(&code),
+		},
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		args           evmtypes.TransactionArgs
+		wantAccessList ethtypes.AccessList
+		wantErr        bool
+	}{
+		{
+			name: "pass - empty access list for transfer",
+			args: evmtypes.TransactionArgs{
+				From: &sender,
+				To:   &touched,
+			},
+			wantAccessList: ethtypes.AccessList{},
+		},
+		{
+			name: "pass - touched accounts and slots",
+			args: evmtypes.TransactionArgs{
+				From: &sender,
+				To:   &contract,
+			},
+			wantAccessList: ethtypes.AccessList{
+				{Address: contract, StorageKeys: []common.Hash{slot}},
+				{Address: touched, StorageKeys: []common.Hash{}},
+			},
+		},
+		{
+			name: "pass - provided access list is kept",
+			args: evmtypes.TransactionArgs{
+				From: &sender,
+				To:   &contract,
+				AccessList: &ethtypes.AccessList{
+					{Address: sender, StorageKeys: []common.Hash{slot}},
+				},
+			},
+			wantAccessList: ethtypes.AccessList{
+				{Address: sender, StorageKeys: []common.Hash{slot}},
+				{Address: contract, StorageKeys: []common.Hash{slot}},
+				{Address: touched, StorageKeys: []common.Hash{}},
+			},
+		},
+		{
+			name: "fail - specified both gasPrice and maxFeePerGas",
+			args: evmtypes.TransactionArgs{
+				From:         &sender,
+				To:           &contract,
+				GasPrice:     (*hexutil.Big)(big.NewInt(1)),
+				MaxFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			args, err := json.Marshal(&tc.args)
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.CreateAccessList(suite.ctx, &evmtypes.EthCallRequest{
+				Args:           args,
+				GasCap:         config.DefaultGasCap,
+				StateOverrides: stateOverride,
+			})
+			if tc.wantErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Empty(res.VmError)
+			suite.ElementsMatch(tc.wantAccessList, *evmtypes.AccessList(res.AccessList).ToEthAccessList())
+
+			// the reported gas must be the gas used when the access list is applied
+			tc.args.AccessList = evmtypes.AccessList(res.AccessList).ToEthAccessList()
+			args, err = json.Marshal(&tc.args)
+			suite.Require().NoError(err)
+			callRes, err := suite.queryClient.EthCall(suite.ctx, &evmtypes.EthCallRequest{
+				Args:           args,
+				GasCap:         config.DefaultGasCap,
+				StateOverrides: stateOverride,
+			})
+			suite.Require().NoError(err)
+			suite.Equal(callRes.GasUsed, res.GasUsed)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessListExcludesCustomPrecompiledContracts() {
+	suite.SetupTest()
+
+	erc20Address := suite.app.CPCKeeper.GetErc20CustomPrecompiledContractAddressByMinDenom(suite.ctx, suite.denom)
+	if erc20Address == nil {
+		deployedAddress, err := suite.app.CPCKeeper.DeployErc20CustomPrecompiledContract(suite.ctx, "Native", cpctypes.Erc20CustomPrecompiledContractMeta{
+			Symbol:   "NATIVE",
+			Decimals: 18,
+			MinDenom: suite.denom,
+		})
+		suite.Require().NoError(err)
+		erc20Address = &deployedAddress
+	}
+	suite.Commit()
+
+	sender := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+
+	// PUSH4 totalSupply() PUSH1 0xe0 SHL PUSH1 0x00 MSTORE
+	code := []byte{0x63, 0x18, 0x16, 0x0d, 0xdd, 0x60, 0xe0, 0x1b, 0x60, 0x00, 0x52}
+	// PUSH1 0x20 PUSH1 0x00 PUSH1 0x04 PUSH1 0x00 PUSH20 <erc20> GAS STATICCALL
+	code = append(code, 0x60, 0x20, 0x60, 0x00, 0x60, 0x04, 0x60, 0x00, 0x73)
+	code = append(code, erc20Address.Bytes()...)
+	code = append(code, 0x5a, 0xfa)
+	// ISZERO PUSH1 <revert> JUMPI STOP JUMPDEST PUSH1 0x00 DUP1 REVERT
+	revertOffset := byte(len(code) + 5)
+	code = append(code, 0x15, 0x60, revertOffset, 0x57, 0x00, 0x5b, 0x60, 0x00, 0x80, 0xfd)
+	stateOverride, err := json.Marshal(evmtypes.StateOverride{
+		contract: evmtypes.OverrideAccount{
+			Code: (*hexutil.Bytes)(&code),
+		},
+	})
+	suite.Require().NoError(err)
+
+	callArgs := evmtypes.TransactionArgs{
+		From: &sender,
+		To:   &contract,
+	}
+	args, err := json.Marshal(&callArgs)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.CreateAccessList(suite.ctx, &evmtypes.EthCallRequest{
+		Args:           args,
+		GasCap:         config.DefaultGasCap,
+		StateOverrides: stateOverride,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError, "the call to the custom precompiled contract must succeed")
+
+	for _, tuple := range *evmtypes.AccessList(res.AccessList).ToEthAccessList() {
+		suite.NotEqual(*erc20Address, tuple.Address, "custom precompiled contract is always warm")
+	}
+
+	// the reported gas must be the gas used when the access list is applied
+	callArgs.AccessList = evmtypes.AccessList(res.AccessList).ToEthAccessList()
+	args, err = json.Marshal(&callArgs)
+	suite.Require().NoError(err)
+	callRes, err := suite.queryClient.EthCall(suite.ctx, &evmtypes.EthCallRequest{
+		Args:           args,
+		GasCap:         config.DefaultGasCap,
+		StateOverrides: stateOverride,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(callRes.GasUsed, res.GasUsed)
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1442,6 +1756,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(suite.ctx, nil)
 			},
 		},
+		{
+			name: "CreateAccessList method",
+			queryFunc: func() (interface{}, error) {
+				return k.CreateAccessList(suite.ctx, nil)
+			},
+		},
 		{
 			name: "TraceTx method",
 			queryFunc: func() (interface{}, error) {
@@ -1454,6 +1774,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			name: "TraceCall method",
+			queryFunc: func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -19,6 +19,7 @@ import (
 	evertypes "github.com/EscanBE/evermint/types"
 	"github.com/EscanBE/evermint/utils"
 	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -53,6 +54,14 @@ type Keeper struct {
 	// fetch custom precompiled contracts
 	cpcKeeper cpckeeper.Keeper
 
+	// components used by BaseApp to execute txs, used to replay the txs of a block when tracing
+	preBlocker   sdk.PreBlocker
+	beginBlocker sdk.BeginBlocker
+	txDecoder    sdk.TxDecoder
+	anteHandler  sdk.AnteHandler
+	postHandler  sdk.PostHandler
+	msgRouter    baseapp.MessageRouter
+
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
@@ -118,6 +127,25 @@ func (k *Keeper) WithCpcKeeper(ck cpckeeper.Keeper) *Keeper {
 	return k
 }
 
+// WithTxReplayer sets the components used by BaseApp to begin a block and execute txs,
+// so the txs of a block can be replayed through the full block execution flow when tracing.
+func (k *Keeper) WithTxReplayer(
+	preBlocker sdk.PreBlocker,
+	beginBlocker sdk.BeginBlocker,
+	txDecoder sdk.TxDecoder,
+	anteHandler sdk.AnteHandler,
+	postHandler sdk.PostHandler,
+	msgRouter baseapp.MessageRouter,
+) *Keeper {
+	k.preBlocker = preBlocker
+	k.beginBlocker = beginBlocker
+	k.txDecoder = txDecoder
+	k.anteHandler = anteHandler
+	k.postHandler = postHandler
+	k.msgRouter = msgRouter
+	return k
+}
+
 // ----------------------------------------------------------------------------
 // Block
 // ----------------------------------------------------------------------------
@@ -237,7 +265,8 @@ func (k Keeper) SetLogCountForCurrentTxTransient(ctx sdk.Context, count uint64)
 	store.Set(evmtypes.TxLogCountTransientKey(txIdx), sdk.Uint64ToBigEndian(count))
 }
 
-// GetCumulativeLogCountTransient returns the total log count for all transactions in the current block.
+// GetCumulativeLogCountTransient returns the total log count for all transactions in the current block,
+// including the logs emitted by Cosmos txs.
 func (k Keeper) GetCumulativeLogCountTransient(ctx sdk.Context, exceptCurrent bool) uint64 {
 	store := ctx.TransientStore(k.transientKey)
 
@@ -253,6 +282,9 @@ func (k Keeper) GetCumulativeLogCountTransient(ctx sdk.Context, exceptCurrent bo
 		total += sdk.BigEndianToUint64(bz)
 	}
 
+	// logs of the Cosmos txs
+	total += k.GetCosmosTxLogCountTransient(ctx)
+
 	return total
 }
 
@@ -324,6 +356,17 @@ func (k Keeper) IsNoBaseFeeEnabled(ctx sdk.Context) bool {
 	return k.genericGetBoolFlagTransient(ctx, evmtypes.KeyTransientFlagNoBaseFee)
 }
 
+// SetFlagEthereumTx sets the flag whether the current tx is an Ethereum tx.
+// This is set by AnteHandler for every tx, so the Cosmos-tx-only logic can skip Ethereum txs.
+func (k Keeper) SetFlagEthereumTx(ctx sdk.Context, isEthereumTx bool) {
+	k.genericSetBoolFlagTransient(ctx, evmtypes.KeyTransientFlagEthereumTx, isEthereumTx)
+}
+
+// IsEthereumTx returns the flag whether the current tx is an Ethereum tx.
+func (k Keeper) IsEthereumTx(ctx sdk.Context) bool {
+	return k.genericGetBoolFlagTransient(ctx, evmtypes.KeyTransientFlagEthereumTx)
+}
+
 func (k Keeper) genericSetBoolFlagTransient(ctx sdk.Context, key []byte, value bool) {
 	store := ctx.TransientStore(k.transientKey)
 	if value {
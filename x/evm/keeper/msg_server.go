@@ -119,6 +119,11 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *evmtypes.MsgEthereumTx)
 	receipt.GasUsed = response.GasUsed
 	receipt.BlockNumber = big.NewInt(ctx.BlockHeight())
 	receipt.TransactionIndex = uint(txIndex)
+	// the log index continues after the logs of the previous txs in the same block, including the Cosmos txs
+	startLogIndex := k.GetCumulativeLogCountTransient(ctx, true)
+	for i, log := range receipt.Logs {
+		log.Index = uint(startLogIndex) + uint(i)
+	}
 
 	receiptSdkEvent, err := evmtypes.GetSdkEventForReceipt(
 		receipt, // receipt
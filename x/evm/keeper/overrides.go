@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+	evmvm "github.com/EscanBE/evermint/x/evm/vm"
+)
+
+// prepareEthCall loads the EVM config for the `EthCall/EstimateGas` request, with the optional overrides applied.
+// Block overrides are applied to the returned context and EVM config.
+// State overrides are applied to a branch of the context, so the returned context must not be committed.
+func (k *Keeper) prepareEthCall(ctx sdk.Context, req *evmtypes.EthCallRequest) (sdk.Context, *evmvm.EVMConfig, error) {
+	var blockOverrides evmtypes.BlockOverrides
+	if len(req.BlockOverrides) > 0 {
+		if err := json.Unmarshal(req.BlockOverrides, &blockOverrides); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := blockOverrides.Validate(); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var stateOverride evmtypes.StateOverride
+	if len(req.StateOverrides) > 0 {
+		if err := json.Unmarshal(req.StateOverrides, &stateOverride); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := stateOverride.Validate(); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx = applyBlockOverridesToContext(ctx, blockOverrides)
+
+	cfg, err := k.EVMConfig(ctx, nil)
+	if err != nil {
+		return ctx, nil, status.Error(codes.Internal, err.Error())
+	}
+	cfg.NoBaseFee = true
+
+	applyBlockOverridesToConfig(cfg, blockOverrides)
+
+	if len(stateOverride) > 0 {
+		ctx, _ = ctx.CacheContext()
+		if err := k.applyStateOverride(ctx, cfg, stateOverride); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	return ctx, cfg, nil
+}
+
+// applyBlockOverridesToContext applies the block number and the block time overrides to the context.
+func applyBlockOverridesToContext(ctx sdk.Context, blockOverrides evmtypes.BlockOverrides) sdk.Context {
+	if blockOverrides.Number != nil {
+		ctx = ctx.WithBlockHeight(blockOverrides.Number.ToInt().Int64())
+	}
+	if blockOverrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*blockOverrides.Time), 0).UTC())
+	}
+	return ctx
+}
+
+// applyBlockOverridesToConfig applies the coinbase and the base fee overrides to the EVM config.
+func applyBlockOverridesToConfig(cfg *evmvm.EVMConfig, blockOverrides evmtypes.BlockOverrides) {
+	if blockOverrides.Coinbase != nil {
+		cfg.CoinBase = *blockOverrides.Coinbase
+	}
+	if blockOverrides.BaseFee != nil {
+		cfg.BaseFee = blockOverrides.BaseFee.ToInt()
+	}
+}
+
+// applyStateOverride applies the account overrides into the given context, using the Context-based StateDB.
+func (k *Keeper) applyStateOverride(ctx sdk.Context, cfg *evmvm.EVMConfig, stateOverride evmtypes.StateOverride) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to apply state override: %v", r)
+		}
+	}()
+
+	stateDB := evmvm.NewStateDB(ctx, cfg.CoinBase, k, k.accountKeeper, k.bankKeeper)
+
+	for addr, account := range stateOverride {
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+
+		if account.Balance != nil {
+			balance := new(big.Int)
+			if *account.Balance != nil {
+				balance = (*account.Balance).ToInt()
+			}
+
+			diff := new(big.Int).Sub(balance, stateDB.GetBalance(addr))
+			switch diff.Sign() {
+			case 1:
+				stateDB.AddBalance(addr, diff)
+			case -1:
+				stateDB.SubBalance(addr, diff.Neg(diff))
+			}
+		}
+
+		if account.State != nil {
+			// replace the entire storage
+			var existingKeys []common.Hash
+			_ = stateDB.ForEachStorage(addr, func(key, _ common.Hash) bool {
+				existingKeys = append(existingKeys, key)
+				return true
+			})
+			for _, key := range existingKeys {
+				stateDB.SetState(addr, key, common.Hash{})
+			}
+
+			for key, value := range *account.State {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return stateDB.CommitMultiStore(false)
+}
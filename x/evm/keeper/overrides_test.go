@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	"github.com/EscanBE/evermint/server/config"
+	utiltx "github.com/EscanBE/evermint/testutil/tx"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+)
+
+// returnOpCodeResult builds a runtime code which returns the 32 bytes result of the given op codes.
+func returnOpCodeResult(opCodes ...byte) []byte {
+	// PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	return append(opCodes, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3)
+}
+
+func (suite *KeeperTestSuite) TestEthCallWithOverrides() {
+	const (
+		opCoinbase    = 0x41
+		opTimestamp   = 0x42
+		opNumber      = 0x43
+		opSelfBalance = 0x47
+		opBaseFee     = 0x48
+	)
+	// PUSH1 0x00 SLOAD
+	sload0 := []byte{0x60, 0x00, 0x54}
+
+	sender := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	coinbase := utiltx.GenerateAddress()
+
+	codeOverride := func(code []byte) evmtypes.StateOverride {
+		return evmtypes.StateOverride{
+			contract: evmtypes.OverrideAccount{
+				Code: (*hexutil.Bytes)(&code),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		malleate       func()
+		stateOverride  evmtypes.StateOverride
+		blockOverrides *evmtypes.BlockOverrides
+		wantRet        common.Hash
+		wantErr        bool
+	}{
+		{
+			name:          "pass - override code",
+			stateOverride: codeOverride(returnOpCodeResult(opNumber)),
+			wantRet:       common.BigToHash(big.NewInt(suite.ctx.BlockHeight())),
+		},
+		{
+			name: "pass - override balance",
+			stateOverride: func() evmtypes.StateOverride {
+				stateOverride := codeOverride(returnOpCodeResult(opSelfBalance))
+				account := stateOverride[contract]
+				balance := (*hexutil.Big)(big.NewInt(1_000_000))
+				account.Balance = &balance
+				stateOverride[contract] = account
+				return stateOverride
+			}(),
+			wantRet: common.BigToHash(big.NewInt(1_000_000)),
+		},
+		{
+			name: "pass - override balance to lower",
+			malleate: func() {
+				amt := sdk.Coins{sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1_000_000)}
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, amt)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, evmtypes.ModuleName, contract.Bytes(), amt)
+				suite.Require().NoError(err)
+			},
+			stateOverride: func() evmtypes.StateOverride {
+				stateOverride := codeOverride(returnOpCodeResult(opSelfBalance))
+				account := stateOverride[contract]
+				balance := (*hexutil.Big)(big.NewInt(1))
+				account.Balance = &balance
+				stateOverride[contract] = account
+				return stateOverride
+			}(),
+			wantRet: common.BigToHash(big.NewInt(1)),
+		},
+		{
+			name: "pass - override state diff",
+			malleate: func() {
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, common.Hash{}, common.BigToHash(big.NewInt(1)).Bytes())
+			},
+			stateOverride: func() evmtypes.StateOverride {
+				stateOverride := codeOverride(returnOpCodeResult(sload0...))
+				account := stateOverride[contract]
+				account.StateDiff = &map[common.Hash]common.Hash{
+					{}: common.BigToHash(big.NewInt(2)),
+				}
+				stateOverride[contract] = account
+				return stateOverride
+			}(),
+			wantRet: common.BigToHash(big.NewInt(2)),
+		},
+		{
+			name: "pass - override state replaces the entire storage",
+			malleate: func() {
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, common.Hash{}, common.BigToHash(big.NewInt(1)).Bytes())
+			},
+			stateOverride: func() evmtypes.StateOverride {
+				stateOverride := codeOverride(returnOpCodeResult(sload0...))
+				account := stateOverride[contract]
+				account.State = &map[common.Hash]common.Hash{
+					common.BigToHash(big.NewInt(1)): common.BigToHash(big.NewInt(2)),
+				}
+				stateOverride[contract] = account
+				return stateOverride
+			}(),
+			wantRet: common.Hash{},
+		},
+		{
+			name:          "pass - override block number",
+			stateOverride: codeOverride(returnOpCodeResult(opNumber)),
+			blockOverrides: &evmtypes.BlockOverrides{
+				Number: (*hexutil.Big)(big.NewInt(1_000_000)),
+			},
+			wantRet: common.BigToHash(big.NewInt(1_000_000)),
+		},
+		{
+			name:          "pass - override block time",
+			stateOverride: codeOverride(returnOpCodeResult(opTimestamp)),
+			blockOverrides: func() *evmtypes.BlockOverrides {
+				blockTime := hexutil.Uint64(2_000_000_000)
+				return &evmtypes.BlockOverrides{
+					Time: &blockTime,
+				}
+			}(),
+			wantRet: common.BigToHash(big.NewInt(2_000_000_000)),
+		},
+		{
+			name:          "pass - override coinbase",
+			stateOverride: codeOverride(returnOpCodeResult(opCoinbase)),
+			blockOverrides: &evmtypes.BlockOverrides{
+				Coinbase: &coinbase,
+			},
+			wantRet: common.BytesToHash(coinbase.Bytes()),
+		},
+		{
+			name:          "pass - override base fee",
+			stateOverride: codeOverride(returnOpCodeResult(opBaseFee)),
+			blockOverrides: &evmtypes.BlockOverrides{
+				BaseFee: (*hexutil.Big)(big.NewInt(123)),
+			},
+			wantRet: common.BigToHash(big.NewInt(123)),
+		},
+		{
+			name: "fail - reject both state and state diff",
+			stateOverride: func() evmtypes.StateOverride {
+				stateOverride := codeOverride(returnOpCodeResult(sload0...))
+				account := stateOverride[contract]
+				account.State = &map[common.Hash]common.Hash{}
+				account.StateDiff = &map[common.Hash]common.Hash{}
+				stateOverride[contract] = account
+				return stateOverride
+			}(),
+			wantErr: true,
+		},
+		{
+			name:          "fail - reject invalid block number",
+			stateOverride: codeOverride(returnOpCodeResult(opNumber)),
+			blockOverrides: &evmtypes.BlockOverrides{
+				Number: (*hexutil.Big)(big.NewInt(-1)),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			args, err := json.Marshal(&evmtypes.TransactionArgs{
+				From: &sender,
+				To:   &contract,
+			})
+			suite.Require().NoError(err)
+
+			req := &evmtypes.EthCallRequest{
+				Args:   args,
+				GasCap: config.DefaultGasCap,
+			}
+			if tc.stateOverride != nil {
+				req.StateOverrides, err = json.Marshal(tc.stateOverride)
+				suite.Require().NoError(err)
+			}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if tc.wantErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Equal(tc.wantRet, common.BytesToHash(res.Ret))
+
+			suite.Empty(suite.app.EvmKeeper.GetCode(suite.ctx, suite.app.EvmKeeper.GetCodeHash(suite.ctx, contract.Bytes())), "overrides must not be persisted")
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasWithOverrides() {
+	sender := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+
+	args, err := json.Marshal(&evmtypes.TransactionArgs{
+		From: &sender,
+		To:   &contract,
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EstimateGas(suite.ctx, &evmtypes.EthCallRequest{
+		Args:   args,
+		GasCap: config.DefaultGasCap,
+	})
+	suite.Require().NoError(err)
+	suite.Equal(ethparams.TxGas, res.Gas)
+
+	code := returnOpCodeResult(0x43)
+	stateOverride, err := json.Marshal(evmtypes.StateOverride{
+		contract: evmtypes.OverrideAccount{
+			Code: (*hexutil.Bytes)(&code),
+		},
+	})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.EstimateGas(suite.ctx, &evmtypes.EthCallRequest{
+		Args:           args,
+		GasCap:         config.DefaultGasCap,
+		StateOverrides: stateOverride,
+	})
+	suite.Require().NoError(err)
+	suite.Greater(res.Gas, ethparams.TxGas, "code override must be applied")
+
+	// NUMBER PUSH3 1_000_000 EQ PUSH1 0x0a JUMPI INVALID JUMPDEST STOP
+	code = []byte{0x43, 0x62, 0x0f, 0x42, 0x40, 0x14, 0x60, 0x0a, 0x57, 0xfe, 0x5b, 0x00}
+	stateOverride, err = json.Marshal(evmtypes.StateOverride{
+		contract: evmtypes.OverrideAccount{
+			Code: (*hexutil.Bytes)(&code),
+		},
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.queryClient.EstimateGas(suite.ctx, &evmtypes.EthCallRequest{
+		Args:           args,
+		GasCap:         config.DefaultGasCap,
+		StateOverrides: stateOverride,
+	})
+	suite.Require().Error(err, "should fail without the block number override")
+
+	blockOverrides, err := json.Marshal(evmtypes.BlockOverrides{
+		Number: (*hexutil.Big)(big.NewInt(1_000_000)),
+	})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.EstimateGas(suite.ctx, &evmtypes.EthCallRequest{
+		Args:           args,
+		GasCap:         config.DefaultGasCap,
+		StateOverrides: stateOverride,
+		BlockOverrides: blockOverrides,
+	})
+	suite.Require().NoError(err)
+	suite.Greater(res.Gas, ethparams.TxGas, "block overrides must be applied")
+}
@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+)
+
+// tracerContextKey is the context key of the EVM logger used by ApplyTransaction.
+// It is only set when an Ethereum tx is replayed through the full tx execution flow for tracing.
+type tracerContextKey struct{}
+
+// canReplayTxs returns true if the components used by BaseApp to begin a block and execute txs were provided.
+func (k Keeper) canReplayTxs() bool {
+	return k.preBlocker != nil && k.beginBlocker != nil && k.txDecoder != nil && k.anteHandler != nil && k.msgRouter != nil
+}
+
+// withReplayTimeout bounds the whole replay of the block by the trace timeout,
+// including the block beginning and the txs replayed before the traced ones.
+func withReplayTimeout(ctx sdk.Context, traceConfig *evmtypes.TraceConfig) (sdk.Context, context.CancelFunc, error) {
+	timeout, err := getTraceTimeout(traceConfig)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
+	return ctx.WithContext(deadlineCtx), cancel, nil
+}
+
+// checkReplayTimeout returns an error if the replay was timed out or canceled.
+func checkReplayTimeout(ctx sdk.Context) error {
+	if err := ctx.Context().Err(); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return status.Error(codes.DeadlineExceeded, "execution timeout")
+		}
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return nil
+}
+
+// replayBlockRequest is the trace request providing the block to replay.
+type replayBlockRequest interface {
+	GetProposerAddress() sdk.ConsAddress
+	GetBlockTxs() [][]byte
+	GetDecidedLastCommit() []byte
+	GetMisbehavior() [][]byte
+	GetNextValidatorsHash() []byte
+}
+
+// newReplayFinalizeBlockRequest returns the FinalizeBlock request of the block to replay,
+// from the proto-encoded last commit and misbehavior provided by the trace request.
+func newReplayFinalizeBlockRequest(ctx sdk.Context, req replayBlockRequest) (*abci.RequestFinalizeBlock, error) {
+	var decidedLastCommit abci.CommitInfo
+	if err := decidedLastCommit.Unmarshal(req.GetDecidedLastCommit()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode decided last commit: %s", err.Error())
+	}
+
+	misbehavior := make([]abci.Misbehavior, len(req.GetMisbehavior()))
+	for i, bz := range req.GetMisbehavior() {
+		if err := misbehavior[i].Unmarshal(bz); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decode misbehavior: %s", err.Error())
+		}
+	}
+
+	return &abci.RequestFinalizeBlock{
+		Txs:                req.GetBlockTxs(),
+		DecidedLastCommit:  decidedLastCommit,
+		Misbehavior:        misbehavior,
+		Hash:               ctx.HeaderHash(),
+		Height:             ctx.BlockHeight(),
+		Time:               ctx.BlockTime(),
+		NextValidatorsHash: req.GetNextValidatorsHash(),
+		ProposerAddress:    req.GetProposerAddress(),
+	}, nil
+}
+
+// newReplayContext returns the context used to replay the txs of a block, from the context of the block beginning.
+// Like BaseApp when finalizing the block, the vote info and the comet info of the block are set,
+// the PreBlocker and the BeginBlocker of the app are executed, then the txs are executed in deliver mode.
+func (k *Keeper) newReplayContext(ctx sdk.Context, req replayBlockRequest) (replayCtx sdk.Context, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic recovered while beginning the block: %v", r)
+		}
+	}()
+
+	finalizeBlockReq, err := newReplayFinalizeBlockRequest(ctx, req)
+	if err != nil {
+		return ctx, err
+	}
+
+	header := ctx.BlockHeader()
+	header.ProposerAddress = finalizeBlockReq.ProposerAddress
+	header.NextValidatorsHash = finalizeBlockReq.NextValidatorsHash
+
+	ctx = ctx.
+		WithBlockHeader(header).
+		WithVoteInfos(finalizeBlockReq.DecidedLastCommit.Votes).
+		// same comet info as the one provided by BaseApp when finalizing the block
+		WithCometInfo(baseapp.NewBlockInfo(
+			finalizeBlockReq.Misbehavior,
+			finalizeBlockReq.NextValidatorsHash,
+			finalizeBlockReq.ProposerAddress,
+			finalizeBlockReq.DecidedLastCommit,
+		)).
+		WithIsCheckTx(false).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithEventManager(sdk.NewEventManager())
+
+	if _, err := k.preBlocker(ctx, finalizeBlockReq); err != nil {
+		return ctx, status.Errorf(codes.Internal, "failed to run pre-blocker: %s", err.Error())
+	}
+
+	if _, err := k.beginBlocker(ctx); err != nil {
+		return ctx, status.Errorf(codes.Internal, "failed to run begin-blocker: %s", err.Error())
+	}
+
+	return ctx, checkReplayTimeout(ctx)
+}
+
+// traceBlockTx replays the txs of the block up to the Ethereum tx with the given hash, then traces it.
+func (k *Keeper) traceBlockTx(
+	ctx sdk.Context,
+	chainConfig *ethparams.ChainConfig,
+	txHash common.Hash,
+	blockTxs [][]byte,
+	traceConfig *evmtypes.TraceConfig,
+	tracerJSONConfig json.RawMessage,
+) (*interface{}, error) {
+	for _, txBz := range blockTxs {
+		if err := checkReplayTimeout(ctx); err != nil {
+			return nil, err
+		}
+
+		tx, err := k.txDecoder(txBz)
+		if err != nil {
+			// rejected by BaseApp without any state change
+			continue
+		}
+
+		if ethTx := ethereumTxFromSdkTx(tx); ethTx != nil && ethTx.Hash() == txHash {
+			return k.traceReplayedTx(ctx, chainConfig, txBz, tx, ethTx, traceConfig, tracerJSONConfig)
+		}
+
+		// failed txs are replayed too, the state changes they made on-chain are kept the same way
+		_ = k.replayTx(ctx, txBz, tx)
+	}
+
+	return nil, status.Errorf(codes.NotFound, "tx %s not found in the block", txHash.Hex())
+}
+
+// traceBlockTxs replays all the txs of the block and traces the Ethereum txs.
+// It returns one result per Ethereum tx.
+func (k *Keeper) traceBlockTxs(
+	ctx sdk.Context,
+	chainConfig *ethparams.ChainConfig,
+	blockTxs [][]byte,
+	traceConfig *evmtypes.TraceConfig,
+) ([]*evmtypes.TxTraceResult, error) {
+	results := make([]*evmtypes.TxTraceResult, 0, len(blockTxs))
+	for _, txBz := range blockTxs {
+		if err := checkReplayTimeout(ctx); err != nil {
+			return nil, err
+		}
+
+		tx, err := k.txDecoder(txBz)
+		if err != nil {
+			// rejected by BaseApp without any state change
+			continue
+		}
+
+		ethTx := ethereumTxFromSdkTx(tx)
+		if ethTx == nil {
+			_ = k.replayTx(ctx, txBz, tx)
+			continue
+		}
+
+		result := evmtypes.TxTraceResult{}
+		traceResult, err := k.traceReplayedTx(ctx, chainConfig, txBz, tx, ethTx, traceConfig, nil)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Result = traceResult
+		}
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
+
+// traceReplayedTx traces the given Ethereum tx while replaying it through the full tx execution flow,
+// so the state changes made by the AnteHandler, like the fee deduction, are the same as on-chain.
+func (k *Keeper) traceReplayedTx(
+	ctx sdk.Context,
+	chainConfig *ethparams.ChainConfig,
+	txBz []byte,
+	tx sdk.Tx,
+	ethTx *ethtypes.Transaction,
+	traceConfig *evmtypes.TraceConfig,
+	tracerJSONConfig json.RawMessage,
+) (*interface{}, error) {
+	tCtx := &tracers.Context{
+		BlockHash: common.BytesToHash(ctx.HeaderHash()),
+		// the tx count is increased by the AnteHandler, so the current count is the index of the tx
+		TxIndex: int(k.GetRawTxCountTransient(ctx)),
+		TxHash:  ethTx.Hash(),
+	}
+
+	return k.runTracer(ctx, chainConfig, tCtx, traceConfig, tracerJSONConfig, func(tracer corevm.EVMLogger) error {
+		if err := k.replayTx(ctx.WithValue(tracerContextKey{}, tracer), txBz, tx); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
+}
+
+// replayTx executes the given tx the same way as BaseApp does when finalizing a block.
+// The state changes made by the AnteHandler are kept even if the messages fail,
+// the state changes made by the messages are kept only if all of them and the PostHandler succeed.
+func (k *Keeper) replayTx(ctx sdk.Context, txBz []byte, tx sdk.Tx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			// like BaseApp, a panic, e.g.: out of gas, aborts the tx
+			err = fmt.Errorf("panic recovered while replaying tx: %v", r)
+		}
+	}()
+
+	ctx = ctx.
+		WithTxBytes(txBz).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithKVGasConfig(storetypes.KVGasConfig()).
+		WithTransientKVGasConfig(storetypes.TransientGasConfig()).
+		WithEventManager(sdk.NewEventManager())
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "must contain at least one message")
+	}
+	for _, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+		if k.msgRouter.Handler(msg) == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
+		}
+	}
+
+	anteCtx, writeAnteCache := ctx.CacheContext()
+	newCtx, err := k.anteHandler(anteCtx, tx, false)
+	if err != nil {
+		return err
+	}
+	writeAnteCache()
+	ctx = newCtx.WithMultiStore(ctx.MultiStore())
+
+	runMsgCtx, writeMsgsCache := ctx.CacheContext()
+	for i, msg := range msgs {
+		if _, err = k.msgRouter.Handler(msg)(runMsgCtx, msg); err != nil {
+			err = errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
+			break
+		}
+	}
+
+	// the PostHandler runs regardless of the execution result
+	if k.postHandler != nil {
+		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		if _, errPostHandler := k.postHandler(postCtx, tx, false, err == nil); errPostHandler != nil {
+			return errors.Join(err, errPostHandler)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+	writeMsgsCache()
+
+	return nil
+}
+
+// ethereumTxFromSdkTx returns the Ethereum tx wrapped in the given tx, or nil if it is not an Ethereum tx.
+func ethereumTxFromSdkTx(tx sdk.Tx) *ethtypes.Transaction {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil
+	}
+
+	ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil
+	}
+
+	return ethMsg.AsTransaction()
+}
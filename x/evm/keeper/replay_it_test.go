@@ -0,0 +1,376 @@
+package keeper_test
+
+//goland:noinspection SpellCheckingInspection
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/EscanBE/evermint/constants"
+	"github.com/EscanBE/evermint/integration_test_util"
+	itutiltypes "github.com/EscanBE/evermint/integration_test_util/types"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+)
+
+type TraceReplayIntegrationTestSuite struct {
+	suite.Suite
+	CITS *integration_test_util.ChainIntegrationTestSuite
+	// contractAddr is the address of the ERC-20 custom precompiled contract of the base denom
+	contractAddr common.Address
+}
+
+func (suite *TraceReplayIntegrationTestSuite) App() itutiltypes.ChainApp {
+	return suite.CITS.ChainApp
+}
+
+func (suite *TraceReplayIntegrationTestSuite) Ctx() sdk.Context {
+	return suite.CITS.CurrentContext
+}
+
+func TestTraceReplayIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(TraceReplayIntegrationTestSuite))
+}
+
+func (suite *TraceReplayIntegrationTestSuite) SetupTest() {
+	suite.CITS = integration_test_util.CreateChainIntegrationTestSuiteFromChainConfig(suite.T(), suite.Require(), integration_test_util.IntegrationTestChain1, true)
+
+	contractAddr, err := suite.App().CpcKeeper().DeployErc20CustomPrecompiledContract(suite.Ctx(), constants.DisplayDenom, cpctypes.Erc20CustomPrecompiledContractMeta{
+		Symbol:   constants.DisplayDenom,
+		Decimals: constants.BaseDenomExponent,
+		MinDenom: constants.BaseDenom,
+	})
+	suite.Require().NoError(err)
+	suite.contractAddr = contractAddr
+}
+
+func (suite *TraceReplayIntegrationTestSuite) TearDownTest() {
+	suite.CITS.Cleanup()
+}
+
+// callTraceResult is the subset of the `callTracer` result used by the tests.
+type callTraceResult struct {
+	Output hexutil.Bytes `json:"output"`
+	Error  string        `json:"error"`
+}
+
+// blockTxs is a block of the following txs:
+//  1. a Cosmos tx sends coins to the receiver.
+//  2. an Ethereum tx of the Ethereum sender, which is reverted.
+//  3. an Ethereum tx of the Ethereum sender, which calls `balanceOf(receiver)` of the ERC-20 contract.
+//  4. an Ethereum tx of the Ethereum sender, which calls `balanceOf(distribution module)` of the ERC-20 contract,
+//     the balance is changed by the BeginBlocker of `x/distribution`.
+//  5. an Ethereum tx of the Ethereum sender, which calls `validatorOutstandingRewards(validator)`
+//     of the distribution contract, the rewards are allocated by the BeginBlocker of `x/distribution` based on the votes.
+type blockTxs struct {
+	receiver       common.Address
+	wantBalance    *big.Int
+	revertedEthMsg *evmtypes.MsgEthereumTx
+	ethMsg         *evmtypes.MsgEthereumTx
+	distrEthMsg    *evmtypes.MsgEthereumTx
+	rewardsEthMsg  *evmtypes.MsgEthereumTx
+	txs            []sdk.Tx
+}
+
+func (suite *TraceReplayIntegrationTestSuite) prepareBlockTxs() blockTxs {
+	cosmosSender := suite.CITS.WalletAccounts.Number(1)
+	receiver := suite.CITS.WalletAccounts.Number(2)
+	ethSender := suite.CITS.WalletAccounts.Number(3)
+
+	balance := suite.App().BankKeeper().GetBalance(suite.Ctx(), receiver.GetCosmosAddress(), constants.BaseDenom)
+
+	cosmosTx, err := suite.CITS.PrepareCosmosTx(suite.Ctx(), cosmosSender, integration_test_util.CosmosTxArgs{
+		Gas: 10_000_000,
+		Msgs: []sdk.Msg{&banktypes.MsgSend{
+			FromAddress: cosmosSender.GetCosmosAddress().String(),
+			ToAddress:   receiver.GetCosmosAddress().String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(constants.BaseDenom, 1000)),
+		}},
+	})
+	suite.Require().NoError(err)
+
+	newEthMsg := func(nonceIncrement uint64, to common.Address, input []byte) (*evmtypes.MsgEthereumTx, sdk.Tx) {
+		ethMsg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			From:      ethSender.GetEthAddress(),
+			ChainID:   suite.App().EvmKeeper().GetEip155ChainId(suite.Ctx()).BigInt(),
+			Nonce:     suite.App().EvmKeeper().GetNonce(suite.Ctx(), ethSender.GetEthAddress()) + nonceIncrement,
+			GasLimit:  300_000,
+			GasFeeCap: new(big.Int).Mul(suite.App().FeeMarketKeeper().GetBaseFee(suite.Ctx()).BigInt(), big.NewInt(2)),
+			GasTipCap: big.NewInt(1),
+			To:        &to,
+			Input:     input,
+		})
+		tx, err := suite.CITS.PrepareEthTx(ethSender, ethMsg)
+		suite.Require().NoError(err)
+		return ethMsg, tx
+	}
+
+	// transfer more than the balance
+	transferInput := append([]byte{}, crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]...)
+	transferInput = append(transferInput, common.LeftPadBytes(receiver.GetEthAddress().Bytes(), 32)...)
+	transferInput = append(transferInput, common.LeftPadBytes(new(big.Int).Lsh(big.NewInt(1), 128).Bytes(), 32)...)
+	revertedEthMsg, revertedEthTx := newEthMsg(0, suite.contractAddr, transferInput)
+
+	balanceOfInput := func(account common.Address) []byte {
+		input := append([]byte{}, crypto.Keccak256([]byte("balanceOf(address)"))[:4]...)
+		return append(input, common.LeftPadBytes(account.Bytes(), 32)...)
+	}
+	ethMsg, ethTx := newEthMsg(1, suite.contractAddr, balanceOfInput(receiver.GetEthAddress()))
+
+	distrModuleAddress := common.BytesToAddress(suite.App().AccountKeeper().GetModuleAddress(distrtypes.ModuleName))
+	distrEthMsg, distrEthTx := newEthMsg(2, suite.contractAddr, balanceOfInput(distrModuleAddress))
+
+	rewardsInput := append([]byte{}, crypto.Keccak256([]byte("validatorOutstandingRewards(address)"))[:4]...)
+	rewardsInput = append(rewardsInput, common.LeftPadBytes(suite.CITS.GetValidatorAddress(1), 32)...)
+	rewardsEthMsg, rewardsEthTx := newEthMsg(3, cpctypes.CpcDistributionFixedAddress, rewardsInput)
+
+	return blockTxs{
+		receiver:       receiver.GetEthAddress(),
+		wantBalance:    new(big.Int).Add(balance.Amount.BigInt(), big.NewInt(1000)),
+		revertedEthMsg: revertedEthMsg,
+		ethMsg:         ethMsg,
+		distrEthMsg:    distrEthMsg,
+		rewardsEthMsg:  rewardsEthMsg,
+		txs:            []sdk.Tx{cosmosTx, revertedEthTx, ethTx, distrEthTx, rewardsEthTx},
+	}
+}
+
+// finalizedBlock is the block executed by finalizeBlock.
+type finalizedBlock struct {
+	// txs is the raw txs of the block
+	txs [][]byte
+	// ctx is the query context at the previous height, which holds the state of the block beginning
+	ctx sdk.Context
+	// ethTxsRet is the on-chain return data of the Ethereum txs, by tx index
+	ethTxsRet map[int][]byte
+	// decidedLastCommit is the proto-encoded last commit info, voted by all the validators
+	decidedLastCommit []byte
+}
+
+// finalizeBlock executes the given txs in a single block, without committing.
+// Fees are collected before the block, so the BeginBlocker of `x/distribution` allocates rewards to the voters.
+func (suite *TraceReplayIntegrationTestSuite) finalizeBlock(txs ...sdk.Tx) finalizedBlock {
+	var bzTxs [][]byte
+	for _, tx := range txs {
+		bzTx, err := suite.CITS.EncodingConfig.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		bzTxs = append(bzTxs, bzTx)
+	}
+
+	feeCollector := suite.App().AccountKeeper().GetModuleAccount(suite.Ctx(), authtypes.FeeCollectorName)
+	suite.CITS.MintCoinToModuleAccount(feeCollector, suite.CITS.NewBaseCoin(1))
+
+	suite.CITS.Commit()
+	queryCtx, err := suite.CITS.BaseApp().CreateQueryContext(suite.Ctx().BlockHeight()-1, false)
+	suite.Require().NoError(err)
+
+	decidedLastCommit := abci.CommitInfo{}
+	for _, validator := range suite.CITS.ValidatorSet.Validators {
+		decidedLastCommit.Votes = append(decidedLastCommit.Votes, abci.VoteInfo{
+			Validator:   cmttypes.TM2PB.Validator(validator),
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		})
+	}
+	bzDecidedLastCommit, err := decidedLastCommit.Marshal()
+	suite.Require().NoError(err)
+
+	header := suite.Ctx().BlockHeader()
+	res, err := suite.CITS.BaseApp().FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             header.Height,
+		Txs:                bzTxs,
+		DecidedLastCommit:  decidedLastCommit,
+		Hash:               header.AppHash,
+		Time:               header.Time,
+		ProposerAddress:    header.ProposerAddress,
+		NextValidatorsHash: header.NextValidatorsHash,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.TxResults, len(txs))
+	ethTxsRet := make(map[int][]byte)
+	for i, txResult := range res.TxResults {
+		suite.Require().Zero(txResult.Code, txResult.Log)
+
+		var txMsgData sdk.TxMsgData
+		suite.Require().NoError(suite.CITS.EncodingConfig.Codec.Unmarshal(txResult.Data, &txMsgData))
+
+		var ethTxResponse evmtypes.MsgEthereumTxResponse
+		if len(txMsgData.MsgResponses) == 1 && suite.CITS.EncodingConfig.Codec.Unmarshal(txMsgData.MsgResponses[0].Value, &ethTxResponse) == nil {
+			ethTxsRet[i] = ethTxResponse.Ret
+		}
+	}
+
+	return finalizedBlock{
+		txs:               bzTxs,
+		ctx:               queryCtx,
+		ethTxsRet:         ethTxsRet,
+		decidedLastCommit: bzDecidedLastCommit,
+	}
+}
+
+func (suite *TraceReplayIntegrationTestSuite) TestTraceTx() {
+	block := suite.prepareBlockTxs()
+	finalized := suite.finalizeBlock(block.txs...)
+	header := suite.Ctx().BlockHeader()
+
+	newRequest := func(msg *evmtypes.MsgEthereumTx, blockTxs [][]byte) *evmtypes.QueryTraceTxRequest {
+		return &evmtypes.QueryTraceTxRequest{
+			Msg: msg,
+			TraceConfig: &evmtypes.TraceConfig{
+				Tracer: evmtypes.TracerCall,
+			},
+			BlockNumber:        header.Height,
+			BlockHash:          common.Bytes2Hex(header.AppHash),
+			BlockTime:          header.Time,
+			ProposerAddress:    header.ProposerAddress,
+			BlockTxs:           blockTxs,
+			DecidedLastCommit:  finalized.decidedLastCommit,
+			NextValidatorsHash: header.NextValidatorsHash,
+		}
+	}
+
+	suite.Run("Cosmos tx and failed Ethereum tx before the traced tx are replayed", func() {
+		cacheCtx, _ := finalized.ctx.CacheContext()
+		res, err := suite.App().EvmKeeper().TraceTx(cacheCtx, newRequest(block.ethMsg, finalized.txs))
+		suite.Require().NoError(err)
+
+		var result callTraceResult
+		suite.Require().NoError(json.Unmarshal(res.Data, &result))
+		suite.Empty(result.Error)
+		suite.Equal(common.BigToHash(block.wantBalance).Bytes(), []byte(result.Output))
+	})
+
+	suite.Run("state changes of the BeginBlocker are replayed", func() {
+		cacheCtx, _ := finalized.ctx.CacheContext()
+		res, err := suite.App().EvmKeeper().TraceTx(cacheCtx, newRequest(block.distrEthMsg, finalized.txs))
+		suite.Require().NoError(err)
+
+		var result callTraceResult
+		suite.Require().NoError(json.Unmarshal(res.Data, &result))
+		suite.Empty(result.Error)
+		suite.Require().NotEmpty(finalized.ethTxsRet[3])
+		suite.Equal(finalized.ethTxsRet[3], []byte(result.Output), "must be the same as on-chain")
+	})
+
+	suite.Run("rewards are allocated to the voters of the last commit by the BeginBlocker", func() {
+		cacheCtx, _ := finalized.ctx.CacheContext()
+		res, err := suite.App().EvmKeeper().TraceTx(cacheCtx, newRequest(block.rewardsEthMsg, finalized.txs))
+		suite.Require().NoError(err)
+
+		var result callTraceResult
+		suite.Require().NoError(json.Unmarshal(res.Data, &result))
+		suite.Empty(result.Error)
+		suite.Require().NotEmpty(finalized.ethTxsRet[4])
+		suite.Equal(finalized.ethTxsRet[4], []byte(result.Output), "must be the same as on-chain")
+
+		// the distribution state after the BeginBlocker is the same as read on-chain
+		onChainRewards := new(big.Int).SetBytes(finalized.ethTxsRet[4])
+		suite.Require().Positive(onChainRewards.Sign())
+		tracedRewards, err := suite.App().DistributionKeeper().GetValidatorOutstandingRewards(cacheCtx, suite.CITS.GetValidatorAddress(1))
+		suite.Require().NoError(err)
+		suite.Equal(onChainRewards.String(), tracedRewards.Rewards.AmountOf(constants.BaseDenom).TruncateInt().String())
+
+		// without the votes, the rewards are not allocated to the validator
+		cacheCtx, _ = finalized.ctx.CacheContext()
+		req := newRequest(block.rewardsEthMsg, finalized.txs)
+		req.DecidedLastCommit = nil
+		res, err = suite.App().EvmKeeper().TraceTx(cacheCtx, req)
+		suite.Require().NoError(err)
+		suite.Require().NoError(json.Unmarshal(res.Data, &result))
+		suite.NotEqual(finalized.ethTxsRet[4], []byte(result.Output))
+	})
+
+	suite.Run("failed Ethereum tx is traced", func() {
+		cacheCtx, _ := finalized.ctx.CacheContext()
+		res, err := suite.App().EvmKeeper().TraceTx(cacheCtx, newRequest(block.revertedEthMsg, finalized.txs))
+		suite.Require().NoError(err)
+
+		var result callTraceResult
+		suite.Require().NoError(json.Unmarshal(res.Data, &result))
+		suite.NotEmpty(result.Error)
+	})
+
+	suite.Run("fail - timeout applies to the whole replay", func() {
+		cacheCtx, _ := finalized.ctx.CacheContext()
+		req := newRequest(block.ethMsg, finalized.txs)
+		req.TraceConfig.Timeout = "1ns"
+		_, err := suite.App().EvmKeeper().TraceTx(cacheCtx, req)
+		suite.Require().ErrorContains(err, "execution timeout")
+	})
+
+	suite.Run("fail - tx is not in the block txs", func() {
+		cacheCtx, _ := finalized.ctx.CacheContext()
+		_, err := suite.App().EvmKeeper().TraceTx(cacheCtx, newRequest(block.ethMsg, finalized.txs[:1]))
+		suite.Require().ErrorContains(err, "not found in the block")
+	})
+
+	suite.Run("fail - invalid decided last commit", func() {
+		cacheCtx, _ := finalized.ctx.CacheContext()
+		req := newRequest(block.ethMsg, finalized.txs)
+		req.DecidedLastCommit = []byte{0xff}
+		_, err := suite.App().EvmKeeper().TraceTx(cacheCtx, req)
+		suite.Require().ErrorContains(err, "failed to decode decided last commit")
+	})
+}
+
+func (suite *TraceReplayIntegrationTestSuite) TestTraceBlock() {
+	block := suite.prepareBlockTxs()
+	finalized := suite.finalizeBlock(block.txs...)
+	header := suite.Ctx().BlockHeader()
+
+	newRequest := func(timeout string) *evmtypes.QueryTraceBlockRequest {
+		return &evmtypes.QueryTraceBlockRequest{
+			TraceConfig: &evmtypes.TraceConfig{
+				Tracer:  evmtypes.TracerCall,
+				Timeout: timeout,
+			},
+			BlockNumber:        header.Height,
+			BlockHash:          common.Bytes2Hex(header.AppHash),
+			BlockTime:          header.Time,
+			ProposerAddress:    header.ProposerAddress,
+			BlockTxs:           finalized.txs,
+			DecidedLastCommit:  finalized.decidedLastCommit,
+			NextValidatorsHash: header.NextValidatorsHash,
+		}
+	}
+
+	cacheCtx, _ := finalized.ctx.CacheContext()
+	_, err := suite.App().EvmKeeper().TraceBlock(cacheCtx, newRequest("1ns"))
+	suite.Require().ErrorContains(err, "execution timeout", "timeout applies to the whole replay")
+
+	res, err := suite.App().EvmKeeper().TraceBlock(finalized.ctx, newRequest(""))
+	suite.Require().NoError(err)
+
+	var results []struct {
+		Result callTraceResult `json:"result"`
+		Error  string          `json:"error"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, 4, "want one result per Ethereum tx")
+
+	suite.Empty(results[0].Error)
+	suite.NotEmpty(results[0].Result.Error, "the first Ethereum tx is reverted")
+
+	suite.Empty(results[1].Error)
+	suite.Empty(results[1].Result.Error)
+	suite.Equal(common.BigToHash(block.wantBalance).Bytes(), []byte(results[1].Result.Output))
+
+	suite.Empty(results[2].Error)
+	suite.Empty(results[2].Result.Error)
+	suite.Equal(finalized.ethTxsRet[3], []byte(results[2].Result.Output), "state changes of the BeginBlocker are replayed")
+
+	suite.Empty(results[3].Error)
+	suite.Empty(results[3].Result.Error)
+	suite.Equal(finalized.ethTxsRet[4], []byte(results[3].Result.Output), "rewards are allocated to the voters of the last commit")
+}
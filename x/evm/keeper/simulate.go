@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+	evmvm "github.com/EscanBE/evermint/x/evm/vm"
+)
+
+// simulateCall executes a call of the `eth_simulateV1` request and commits the state changes into the given context.
+// When validation is enabled, the nonce, the base fee and the sender balance are checked like a real transaction,
+// and the fee is charged from the sender.
+func (k *Keeper) simulateCall(
+	ctx sdk.Context,
+	cfg *evmvm.EVMConfig,
+	args evmtypes.TransactionArgs,
+	gasCap uint64,
+	opts evmtypes.SimOpts,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	from := args.GetFrom()
+
+	nonce := k.GetNonce(ctx, from)
+	if opts.Validation && args.Nonce != nil {
+		if msgNonce := uint64(*args.Nonce); msgNonce > nonce {
+			return nil, fmt.Errorf("%w: address %v, tx: %d state: %d", core.ErrNonceTooHigh, from.Hex(), msgNonce, nonce)
+		} else if msgNonce < nonce {
+			return nil, fmt.Errorf("%w: address %v, tx: %d state: %d", core.ErrNonceTooLow, from.Hex(), msgNonce, nonce)
+		}
+	}
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Validation {
+		if err := k.chargeSimulatedTxFee(ctx, cfg, msg); err != nil {
+			return nil, err
+		}
+
+		// the remaining gas will be refunded to the sender
+		k.SetFlagSenderPaidTxFeeInAnteHandle(ctx, true)
+		defer k.SetFlagSenderPaidTxFeeInAnteHandle(ctx, false)
+	}
+
+	var tracer corevm.EVMLogger
+	if opts.TraceTransfers {
+		tracer = evmtypes.NewTransferTracer()
+	}
+
+	txConfig := k.NewTxConfigFromMessage(ctx, msg)
+	txConfig.TxHash = args.ToTransaction().AsTransaction().Hash()
+
+	// pass true to commit the StateDB into the simulation context, to be used by the next calls
+	return k.ApplyMessageWithConfig(ctx, msg, tracer, true, cfg, txConfig)
+}
+
+// simulateCallGasCap returns the gas cap of a call of the `eth_simulateV1` request.
+// Like go-ethereum, the call can not use more than the remaining gas of the simulated block,
+// nor more than the remaining gas budget of the whole simulation, which starts from the RPC gas cap.
+// Zero gas cap or block gas limit means unlimited.
+func simulateCallGasCap(
+	args evmtypes.TransactionArgs, rpcGasCap, gasBudget, blockGasLimit, blockGasUsed uint64,
+) (uint64, error) {
+	gasCap := rpcGasCap
+	if rpcGasCap != 0 {
+		if gasBudget == 0 {
+			return 0, fmt.Errorf("gas budget of the simulation exhausted, gas cap %d", rpcGasCap)
+		}
+		gasCap = gasBudget
+	}
+
+	if blockGasLimit == 0 {
+		return gasCap, nil
+	}
+
+	if blockGasUsed >= blockGasLimit {
+		return 0, fmt.Errorf("block gas limit reached: %d >= %d", blockGasUsed, blockGasLimit)
+	}
+
+	blockGasRemaining := blockGasLimit - blockGasUsed
+	if args.Gas != nil && uint64(*args.Gas) > blockGasRemaining {
+		return 0, fmt.Errorf("block gas limit reached: %d + %d > %d", blockGasUsed, uint64(*args.Gas), blockGasLimit)
+	}
+
+	if gasCap == 0 || gasCap > blockGasRemaining {
+		gasCap = blockGasRemaining
+	}
+
+	return gasCap, nil
+}
+
+// chargeSimulatedTxFee checks the sender balance can cover the gas limit at the fee cap plus the value,
+// then deducts the fee, based on the effective gas price, from the sender like the AnteHandler does.
+func (k *Keeper) chargeSimulatedTxFee(ctx sdk.Context, cfg *evmvm.EVMConfig, msg core.Message) error {
+	gasLimit := new(big.Int).SetUint64(msg.Gas())
+
+	feeCap := msg.GasPrice()
+	if msg.GasFeeCap() != nil {
+		feeCap = msg.GasFeeCap()
+	}
+
+	balanceCheck := new(big.Int).Mul(gasLimit, feeCap)
+	balanceCheck.Add(balanceCheck, msg.Value())
+
+	balance := k.bankKeeper.GetBalance(ctx, msg.From().Bytes(), cfg.Params.EvmDenom).Amount.BigInt()
+	if balance.Cmp(balanceCheck) < 0 {
+		return fmt.Errorf("%w: address %v have %v want %v", core.ErrInsufficientFunds, msg.From().Hex(), balance, balanceCheck)
+	}
+
+	fee := new(big.Int).Mul(gasLimit, msg.GasPrice())
+	if fee.Sign() < 1 {
+		return nil
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(cfg.Params.EvmDenom, sdkmath.NewIntFromBigInt(fee)))
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, msg.From().Bytes(), authtypes.FeeCollectorName, fees)
+}
@@ -0,0 +1,389 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	"github.com/EscanBE/evermint/server/config"
+	utiltx "github.com/EscanBE/evermint/testutil/tx"
+	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestSimulateV1() {
+	const (
+		opTimestamp = 0x42
+		opNumber    = 0x43
+	)
+	// PUSH1 0x00 SLOAD PUSH1 0x01 ADD DUP1 PUSH1 0x00 SSTORE
+	increaseSlot0 := []byte{0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x80, 0x60, 0x00, 0x55}
+	// PUSH1 0x00 PUSH1 0x00 REVERT
+	revertCode := []byte{0x60, 0x00, 0x60, 0x00, 0xfd}
+
+	sender := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	recipient := utiltx.GenerateAddress()
+
+	codeOverride := func(code []byte) *evmtypes.StateOverride {
+		return &evmtypes.StateOverride{
+			contract: evmtypes.OverrideAccount{
+				Code: (*hexutil.Bytes)(&code),
+			},
+		}
+	}
+	callContract := evmtypes.TransactionArgs{
+		From: &sender,
+		To:   &contract,
+	}
+	blockNumber := func(number int64) *evmtypes.BlockOverrides {
+		return &evmtypes.BlockOverrides{
+			Number: (*hexutil.Big)(big.NewInt(number)),
+		}
+	}
+
+	overriddenBlockTime := hexutil.Uint64(suite.ctx.BlockTime().Unix() + 1_000)
+	transfer := evmtypes.TransactionArgs{
+		From: &sender,
+		To:   &recipient,
+	}
+	testCases := []struct {
+		name    string
+		opts    evmtypes.SimOpts
+		gasCap  uint64
+		wantErr string
+		check   func(blocks []evmtypes.SimulatedBlock)
+	}{
+		{
+			name: "pass - state is carried between calls and blocks",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						StateOverrides: codeOverride(returnOpCodeResult(increaseSlot0...)),
+						Calls:          []evmtypes.TransactionArgs{callContract, callContract},
+					},
+					{
+						Calls: []evmtypes.TransactionArgs{callContract},
+					},
+				},
+			},
+			check: func(blocks []evmtypes.SimulatedBlock) {
+				suite.Require().Len(blocks, 2)
+				suite.Require().Len(blocks[0].Calls, 2)
+				suite.Require().Len(blocks[1].Calls, 1)
+
+				suite.Equal(common.BigToHash(big.NewInt(1)), common.BytesToHash(blocks[0].Calls[0].Ret))
+				suite.Equal(common.BigToHash(big.NewInt(2)), common.BytesToHash(blocks[0].Calls[1].Ret))
+				suite.Equal(common.BigToHash(big.NewInt(3)), common.BytesToHash(blocks[1].Calls[0].Ret))
+				suite.Equal(blocks[0].Calls[0].GasUsed+blocks[0].Calls[1].GasUsed, blocks[0].GasUsed)
+			},
+		},
+		{
+			name: "pass - block number and time are increased by default",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						StateOverrides: codeOverride(returnOpCodeResult(opNumber)),
+						Calls:          []evmtypes.TransactionArgs{callContract},
+					},
+					{
+						Calls: []evmtypes.TransactionArgs{callContract},
+					},
+				},
+			},
+			check: func(blocks []evmtypes.SimulatedBlock) {
+				suite.Require().Len(blocks, 2)
+
+				suite.Equal(suite.ctx.BlockHeight()+1, blocks[0].Number)
+				suite.Equal(suite.ctx.BlockHeight()+2, blocks[1].Number)
+				suite.Equal(suite.ctx.BlockTime().Unix()+evmtypes.SimulateTimestampIncrement, blocks[0].Time)
+				suite.Equal(suite.ctx.BlockTime().Unix()+2*evmtypes.SimulateTimestampIncrement, blocks[1].Time)
+
+				suite.Equal(common.BigToHash(big.NewInt(blocks[0].Number)), common.BytesToHash(blocks[0].Calls[0].Ret))
+				suite.Equal(common.BigToHash(big.NewInt(blocks[1].Number)), common.BytesToHash(blocks[1].Calls[0].Ret))
+			},
+		},
+		{
+			name: "pass - block overrides are applied",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						BlockOverrides: &evmtypes.BlockOverrides{
+							Time: &overriddenBlockTime,
+						},
+						StateOverrides: codeOverride(returnOpCodeResult(opTimestamp)),
+						Calls:          []evmtypes.TransactionArgs{callContract},
+					},
+				},
+			},
+			check: func(blocks []evmtypes.SimulatedBlock) {
+				suite.Require().Len(blocks, 1)
+
+				suite.Equal(int64(overriddenBlockTime), blocks[0].Time)
+				suite.Equal(common.BigToHash(big.NewInt(blocks[0].Time)), common.BytesToHash(blocks[0].Calls[0].Ret))
+			},
+		},
+		{
+			name: "pass - reverted call is reported",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						StateOverrides: codeOverride(revertCode),
+						Calls:          []evmtypes.TransactionArgs{callContract},
+					},
+				},
+			},
+			check: func(blocks []evmtypes.SimulatedBlock) {
+				suite.Require().Len(blocks, 1)
+				suite.Require().Len(blocks[0].Calls, 1)
+
+				suite.True(blocks[0].Calls[0].Failed())
+				suite.Equal(corevm.ErrExecutionReverted.Error(), blocks[0].Calls[0].VmError)
+			},
+		},
+		{
+			name: "pass - trace transfers",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						StateOverrides: func() *evmtypes.StateOverride {
+							balance := (*hexutil.Big)(big.NewInt(1_000_000))
+							return &evmtypes.StateOverride{
+								sender: evmtypes.OverrideAccount{
+									Balance: &balance,
+								},
+							}
+						}(),
+						Calls: []evmtypes.TransactionArgs{
+							{
+								From:  &sender,
+								To:    &recipient,
+								Value: (*hexutil.Big)(big.NewInt(1_000)),
+							},
+						},
+					},
+				},
+				TraceTransfers: true,
+			},
+			check: func(blocks []evmtypes.SimulatedBlock) {
+				suite.Require().Len(blocks, 1)
+				suite.Require().Len(blocks[0].Calls, 1)
+				suite.Require().False(blocks[0].Calls[0].Failed())
+
+				var receipt ethtypes.Receipt
+				suite.Require().NoError(receipt.UnmarshalBinary(blocks[0].Calls[0].MarshalledReceipt))
+				suite.Require().Len(receipt.Logs, 1)
+				log := receipt.Logs[0]
+				suite.Equal(evmtypes.TransferLogAddress, log.Address)
+				suite.Equal(evmtypes.TransferLogTopic, log.Topics[0])
+				suite.Equal(common.BytesToHash(sender.Bytes()), log.Topics[1])
+				suite.Equal(common.BytesToHash(recipient.Bytes()), log.Topics[2])
+				suite.Equal(common.BigToHash(big.NewInt(1_000)).Bytes(), log.Data)
+			},
+		},
+		{
+			name: "pass - gas cap is the budget of all the calls",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						Calls: []evmtypes.TransactionArgs{transfer},
+					},
+					{
+						Calls: []evmtypes.TransactionArgs{transfer},
+					},
+				},
+			},
+			gasCap: 2 * ethparams.TxGas,
+			check: func(blocks []evmtypes.SimulatedBlock) {
+				suite.Require().Len(blocks, 2)
+				suite.Equal(ethparams.TxGas, blocks[0].GasUsed)
+				suite.Equal(ethparams.TxGas, blocks[1].GasUsed)
+			},
+		},
+		{
+			name: "fail - reject when the gas budget is exhausted",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						Calls: []evmtypes.TransactionArgs{transfer, transfer},
+					},
+					{
+						Calls: []evmtypes.TransactionArgs{transfer},
+					},
+				},
+			},
+			gasCap:  2 * ethparams.TxGas,
+			wantErr: "block #1, call #0: gas budget of the simulation exhausted",
+		},
+		{
+			name: "fail - gas limit of the call is capped by the remaining gas budget",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						Calls: []evmtypes.TransactionArgs{transfer, transfer},
+					},
+				},
+			},
+			gasCap:  ethparams.TxGas + ethparams.TxGas/2,
+			wantErr: "block #0, call #1: intrinsic gas too low",
+		},
+		{
+			name: "fail - reject too many calls in a block",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						Calls: make([]evmtypes.TransactionArgs, evmtypes.MaxSimulateCallsPerBlock+1),
+					},
+				},
+			},
+			wantErr: "too many calls",
+		},
+		{
+			name:    "fail - reject empty input",
+			opts:    evmtypes.SimOpts{},
+			wantErr: "empty input",
+		},
+		{
+			name: "fail - reject block numbers not in order",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						BlockOverrides: blockNumber(suite.ctx.BlockHeight() + 10),
+					},
+					{
+						BlockOverrides: blockNumber(suite.ctx.BlockHeight() + 5),
+					},
+				},
+			},
+			wantErr: "block numbers must be in order",
+		},
+		{
+			name: "fail - validation rejects nonce too high",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						Calls: []evmtypes.TransactionArgs{
+							{
+								From:  &sender,
+								To:    &recipient,
+								Nonce: func() *hexutil.Uint64 { nonce := hexutil.Uint64(10); return &nonce }(),
+							},
+						},
+					},
+				},
+				Validation: true,
+			},
+			wantErr: "nonce too high",
+		},
+		{
+			name: "fail - validation rejects insufficient funds",
+			opts: evmtypes.SimOpts{
+				BlockStateCalls: []evmtypes.SimBlock{
+					{
+						Calls: []evmtypes.TransactionArgs{
+							{
+								From:     &sender,
+								To:       &recipient,
+								Gas:      func() *hexutil.Uint64 { gas := hexutil.Uint64(21_000); return &gas }(),
+								GasPrice: (*hexutil.Big)(big.NewInt(1_000_000_000_000)),
+							},
+						},
+					},
+				},
+				Validation: true,
+			},
+			wantErr: "insufficient funds",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			opts, err := json.Marshal(tc.opts)
+			suite.Require().NoError(err)
+
+			gasCap := tc.gasCap
+			if gasCap == 0 {
+				gasCap = config.DefaultGasCap
+			}
+
+			res, err := suite.queryClient.SimulateV1(suite.ctx, &evmtypes.SimulateV1Request{
+				Opts:   opts,
+				GasCap: gasCap,
+			})
+			if tc.wantErr != "" {
+				suite.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.check(res.Blocks)
+
+			suite.Empty(suite.app.EvmKeeper.GetCode(suite.ctx, suite.app.EvmKeeper.GetCodeHash(suite.ctx, contract.Bytes())), "simulation must not be persisted")
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1BlockGasLimit() {
+	suite.SetupTest()
+
+	sender := utiltx.GenerateAddress()
+	recipient := utiltx.GenerateAddress()
+
+	const blockGasLimit = 3 * ethparams.TxGas
+	consensusParams := suite.ctx.ConsensusParams()
+	consensusParams.Block = &tmproto.BlockParams{
+		MaxBytes: 22020096,
+		MaxGas:   int64(blockGasLimit),
+	}
+	ctx := suite.ctx.WithConsensusParams(consensusParams)
+
+	simulate := func(calls ...evmtypes.TransactionArgs) (*evmtypes.SimulateV1Response, error) {
+		opts, err := json.Marshal(evmtypes.SimOpts{
+			BlockStateCalls: []evmtypes.SimBlock{
+				{
+					Calls: calls,
+				},
+			},
+		})
+		suite.Require().NoError(err)
+
+		return suite.app.EvmKeeper.SimulateV1(ctx, &evmtypes.SimulateV1Request{
+			Opts:   opts,
+			GasCap: config.DefaultGasCap,
+		})
+	}
+
+	transfer := func(gas *hexutil.Uint64) evmtypes.TransactionArgs {
+		return evmtypes.TransactionArgs{
+			From: &sender,
+			To:   &recipient,
+			Gas:  gas,
+		}
+	}
+	gas := func(gas uint64) *hexutil.Uint64 {
+		return (*hexutil.Uint64)(&gas)
+	}
+
+	suite.Run("pass - calls within the block gas limit", func() {
+		res, err := simulate(transfer(nil), transfer(nil), transfer(gas(ethparams.TxGas)))
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Blocks, 1)
+		suite.Equal(blockGasLimit, res.Blocks[0].GasUsed)
+	})
+
+	suite.Run("fail - reject call when the block gas limit is reached", func() {
+		_, err := simulate(transfer(nil), transfer(nil), transfer(nil), transfer(nil))
+		suite.Require().ErrorContains(err, "block #0, call #3: block gas limit reached")
+	})
+
+	suite.Run("fail - reject call exceeds the remaining gas of the block", func() {
+		_, err := simulate(transfer(nil), transfer(gas(blockGasLimit)))
+		suite.Require().ErrorContains(err, "block #0, call #1: block gas limit reached")
+	})
+}
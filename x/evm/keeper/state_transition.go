@@ -6,6 +6,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	cpckeeper "github.com/EscanBE/evermint/x/cpc/keeper"
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -69,25 +70,26 @@ func (k *Keeper) NewEVM(
 	evm := corevm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, coreVmConfig)
 	{
 		// init the custom precompiled contracts
-		protocolVersion := k.cpcKeeper.GetProtocolCpcVersion(ctx)
+		cpcParams := k.cpcKeeper.GetParams(ctx)
+		protocolVersion := cpctypes.ProtocolCpc(cpcParams.ProtocolVersion)
 
 		var contracts []corevm.PrecompiledContract
 		for _, contract := range k.cpcKeeper.GetAllCustomPrecompiledContracts(ctx) {
-			executors := contract.GetMethodExecutors()
+			executors := k.cpcKeeper.GetMethodExecutors(contract, protocolVersion)
 			if len(executors) == 0 {
 				panic(fmt.Sprintf("no executors found for custom precompiled contract %s", contract.GetMetadata().Name))
 			}
 
+			metadata := contract.GetMetadata()
+
 			var methods []corevm.CustomPrecompiledContractMethod
 			for _, executor := range executors {
-				methods = append(methods, cpckeeper.NewCustomPrecompiledContractMethod(
-					executor,
-					protocolVersion,
-				))
+				methods = append(methods, cpckeeper.NewCustomPrecompiledContractMethod(executor, metadata, cpcParams))
 			}
 
-			metadata := contract.GetMetadata()
-			contracts = append(contracts, corevm.NewCustomPrecompiledContract(common.BytesToAddress(metadata.Address), methods, metadata.Name))
+			// disabled contracts are still registered so calls to them are reverted instead of being treated as EOA
+			cpc := corevm.NewCustomPrecompiledContract(common.BytesToAddress(metadata.Address), methods, metadata.Name)
+			contracts = append(contracts, cpc.(*corevm.CustomPrecompiledContract).WithDisabled(metadata.Disabled))
 		}
 		evm = evm.WithCustomPrecompiledContracts(contracts...)
 	}
@@ -139,8 +141,11 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*e
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
+	// the tracer is only provided when the tx is replayed for tracing, otherwise the default one is used
+	tracer, _ := ctx.Value(tracerContextKey{}).(corevm.EVMLogger)
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, true, cfg, txConfig)
 	if err != nil {
 		// Refer to EIP-140 https://eips.ethereum.org/EIPS/eip-140
 		// Opcode REVERT provides a way to stop execution and revert state changes, without consuming all provided gas.
@@ -288,3 +293,41 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		MarshalledReceipt: bzReceipt,
 	}, nil
 }
+
+// StaticCall executes a read-only call to the contract at the given address, on top of the current state.
+// It is meant to be used by other native modules to query contracts, e.g. EIP-1271 `isValidSignature`.
+//
+// Unlike ApplyMessage, it does not touch the transient store of the current transaction
+// and state changes are always discarded.
+// The returned error is non-nil if the call was reverted or failed.
+// The EVM gas used by the call is returned, so the caller can charge it.
+func (k *Keeper) StaticCall(ctx sdk.Context, from, to common.Address, input []byte, gasLimit uint64) (ret []byte, gasUsed uint64, err error) {
+	cfg, err := k.EVMConfig(ctx, nil)
+	if err != nil {
+		return nil, 0, errorsmod.Wrap(err, "failed to load evm config")
+	}
+	cfg.NoBaseFee = true
+
+	// discard all changes, if any
+	cacheCtx, _ := ctx.CacheContext()
+
+	msg := ethtypes.NewMessage(
+		from,
+		&to,
+		k.GetNonce(cacheCtx, from),
+		big.NewInt(0), // amount
+		gasLimit,
+		big.NewInt(0), // gas price
+		big.NewInt(0), // gas fee cap
+		big.NewInt(0), // gas tip cap
+		input,
+		nil,  // access list
+		true, // is fake
+	)
+
+	stateDB := evmvm.NewStateDB(cacheCtx, cfg.CoinBase, k, k.accountKeeper, k.bankKeeper)
+	evm := k.NewEVM(cacheCtx, msg, cfg, evmtypes.NewNoOpTracer(), stateDB)
+
+	ret, leftOverGas, err := evm.StaticCall(corevm.AccountRef(from), to, input, gasLimit)
+	return ret, gasLimit - leftOverGas, err
+}
@@ -23,6 +23,7 @@ import (
 	"math/big"
 
 	evmtypes "github.com/EscanBE/evermint/x/evm/types"
+	evmvm "github.com/EscanBE/evermint/x/evm/vm"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	corevm "github.com/ethereum/go-ethereum/core/vm"
@@ -253,12 +254,22 @@ func (st *StateTransition) TransitionDb() (*core.ExecutionResult, error) {
 		ret   []byte
 		vmerr error // vm errors do not effect consensus and are therefore not assigned to err
 	)
+	// The custom precompiled contracts can not consume more extra gas than the gas remaining for execution,
+	// so the SDK work done by them is bounded by the gas limit of the tx.
+	if cStateDB, ok := st.state.(evmvm.CStateDB); ok {
+		cStateDB.SetCpcDynamicGasLimit(st.gas)
+	}
+
 	if contractCreation {
+		snapshot := st.state.Snapshot()
 		ret, _, st.gas, vmerr = st.evm.Create(sender, st.data, st.gas, st.value)
+		ret, vmerr = st.chargeCpcDynamicGas(snapshot, ret, vmerr)
 	} else {
 		// Increment the nonce for the next transaction
 		st.state.SetNonce(msg.From(), st.state.GetNonce(sender.Address())+1)
+		snapshot := st.state.Snapshot()
 		ret, st.gas, vmerr = st.evm.Call(sender, st.to(), st.data, st.gas, st.value)
+		ret, vmerr = st.chargeCpcDynamicGas(snapshot, ret, vmerr)
 	}
 
 	if !rules.IsLondon {
@@ -295,6 +306,30 @@ func (st *StateTransition) TransitionDb() (*core.ExecutionResult, error) {
 	}, nil
 }
 
+// chargeCpcDynamicGas charges the extra gas consumed by the custom precompiled contracts during execution.
+// If the remaining gas is not enough, the state changes of the execution are reverted
+// and the execution fails with out of gas.
+func (st *StateTransition) chargeCpcDynamicGas(snapshot int, ret []byte, vmerr error) ([]byte, error) {
+	cStateDB, ok := st.state.(evmvm.CStateDB)
+	if !ok {
+		return ret, vmerr
+	}
+
+	dynamicGas := cStateDB.GetCpcDynamicGas()
+	if dynamicGas == 0 {
+		return ret, vmerr
+	}
+
+	if dynamicGas > st.gas {
+		st.state.RevertToSnapshot(snapshot)
+		st.gas = 0
+		return nil, corevm.ErrOutOfGas
+	}
+
+	st.gas -= dynamicGas
+	return ret, vmerr
+}
+
 func (st *StateTransition) refundGas(refundQuotient uint64) {
 	// Apply refund counter, capped to a refund quotient
 	refund := st.gasUsed() / refundQuotient
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessList(t *testing.T) {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	slot := common.BigToHash(common.Big1)
+
+	testCases := []struct {
+		name          string
+		ethAccessList *ethtypes.AccessList
+		wantLen       int
+	}{
+		{
+			name:          "nil access list",
+			ethAccessList: nil,
+			wantLen:       0,
+		},
+		{
+			name:          "empty access list",
+			ethAccessList: &ethtypes.AccessList{},
+			wantLen:       0,
+		},
+		{
+			name: "access list with storage keys",
+			ethAccessList: &ethtypes.AccessList{
+				{Address: addr, StorageKeys: []common.Hash{slot}},
+				{Address: common.Address{}, StorageKeys: []common.Hash{}},
+			},
+			wantLen: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			al := NewAccessList(tc.ethAccessList)
+			require.Len(t, al, tc.wantLen)
+
+			if tc.ethAccessList == nil {
+				require.Nil(t, al)
+				return
+			}
+
+			require.Equal(t, len(*tc.ethAccessList), len(*al.ToEthAccessList()))
+			for i, tuple := range *al.ToEthAccessList() {
+				require.Equal(t, (*tc.ethAccessList)[i].Address, tuple.Address)
+				require.Equal(t, (*tc.ethAccessList)[i].StorageKeys, tuple.StorageKeys)
+			}
+		})
+	}
+}
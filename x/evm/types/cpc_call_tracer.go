@@ -0,0 +1,248 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+)
+
+// TracerCall is the name of the native call tracer.
+const TracerCall = "callTracer"
+
+// CpcCallFrameType is the type of the call frame of the decoded custom precompiled contract call.
+const CpcCallFrameType = "CPC"
+
+var (
+	_ tracers.Tracer         = &CpcCallTracer{}
+	_ cpctypes.CpcCallTracer = &CpcCallTracer{}
+)
+
+// CpcCallTracer wraps the native `callTracer` and nests the decoded custom precompiled contract calls,
+// with the SDK events emitted during execution, as frames into the call frames of the result.
+type CpcCallTracer struct {
+	tracers.Tracer
+
+	childrenCount []int // number of children of each open frame, from the root frame
+	path          []int // index path of the current frame, from the root frame
+	cpcCalls      []capturedCpcCall
+}
+
+type capturedCpcCall struct {
+	path  []int
+	frame cpctypes.CpcCallFrame
+}
+
+// cpcCallFrame is the call frame of the decoded custom precompiled contract call,
+// with the fields of the call frame of the native `callTracer` and the extra fields of the custom precompiled contract calls.
+type cpcCallFrame struct {
+	Type         string                 `json:"type"`
+	From         string                 `json:"from"`
+	To           string                 `json:"to,omitempty"`
+	Value        string                 `json:"value,omitempty"`
+	Gas          string                 `json:"gas"`
+	GasUsed      string                 `json:"gasUsed"`
+	Input        string                 `json:"input"`
+	Output       string                 `json:"output,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+	Method       string                 `json:"method,omitempty"`
+	Args         map[string]interface{} `json:"args,omitempty"`
+	CosmosEvents []cosmosEvent          `json:"cosmosEvents,omitempty"`
+}
+
+type cosmosEvent struct {
+	Type       string                 `json:"type"`
+	Attributes []cosmosEventAttribute `json:"attributes"`
+}
+
+type cosmosEventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewCpcCallTracer returns a new CpcCallTracer which wraps the given native `callTracer`.
+func NewCpcCallTracer(callTracer tracers.Tracer) *CpcCallTracer {
+	return &CpcCallTracer{
+		Tracer: callTracer,
+	}
+}
+
+// CaptureStart implements vm.EVMLogger interface
+func (t *CpcCallTracer) CaptureStart(env *corevm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.childrenCount = []int{0}
+	t.path = nil
+	t.Tracer.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+func (t *CpcCallTracer) CaptureEnter(typ corevm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if top := len(t.childrenCount) - 1; top >= 0 {
+		t.path = append(t.path, t.childrenCount[top])
+		t.childrenCount[top]++
+	}
+	t.childrenCount = append(t.childrenCount, 0)
+	t.Tracer.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit implements vm.EVMLogger interface
+func (t *CpcCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.childrenCount) > 1 {
+		t.childrenCount = t.childrenCount[:len(t.childrenCount)-1]
+	}
+	if len(t.path) > 0 {
+		t.path = t.path[:len(t.path)-1]
+	}
+	t.Tracer.CaptureExit(output, gasUsed, err)
+}
+
+// CaptureCpcCall implements cpctypes.CpcCallTracer interface.
+// The call is attached to the current frame, which is the call into the custom precompiled contract.
+func (t *CpcCallTracer) CaptureCpcCall(frame cpctypes.CpcCallFrame) {
+	t.cpcCalls = append(t.cpcCalls, capturedCpcCall{
+		path:  append([]int{}, t.path...),
+		frame: frame,
+	})
+}
+
+// GetResult returns the result of the wrapped `callTracer`, with the decoded custom precompiled contract calls nested.
+// The frames are edited as raw JSON, so fields of the `callTracer` result are kept as is.
+func (t *CpcCallTracer) GetResult() (json.RawMessage, error) {
+	res, err := t.Tracer.GetResult()
+	if err != nil || len(t.cpcCalls) < 1 {
+		return res, err
+	}
+
+	for _, cpcCall := range t.cpcCalls {
+		res, err = appendCpcCallFrame(res, cpcCall.path, cpcCall.frame)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// appendCpcCallFrame appends the decoded custom precompiled contract call into the sub-calls of the frame at the path.
+// The frame is returned as is if the path does not exist, like when `onlyTopCall` is enabled.
+func appendCpcCallFrame(frame json.RawMessage, path []int, cpcCall cpctypes.CpcCallFrame) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(frame, &fields); err != nil {
+		return nil, err
+	}
+
+	var calls []json.RawMessage
+	if rawCalls, found := fields["calls"]; found {
+		if err := json.Unmarshal(rawCalls, &calls); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(path) > 0 {
+		idx := path[0]
+		if idx >= len(calls) {
+			// sub-calls are not collected
+			return frame, nil
+		}
+
+		call, err := appendCpcCallFrame(calls[idx], path[1:], cpcCall)
+		if err != nil {
+			return nil, err
+		}
+		calls[idx] = call
+	} else {
+		// the frame is the call into the custom precompiled contract, so the gas provided is the same
+		var gas string
+		if rawGas, found := fields["gas"]; found {
+			if err := json.Unmarshal(rawGas, &gas); err != nil {
+				return nil, err
+			}
+		}
+
+		call, err := json.Marshal(newCpcCallFrame(cpcCall, gas))
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+
+	rawCalls, err := json.Marshal(calls)
+	if err != nil {
+		return nil, err
+	}
+	fields["calls"] = rawCalls
+
+	return json.Marshal(fields)
+}
+
+func newCpcCallFrame(frame cpctypes.CpcCallFrame, gas string) cpcCallFrame {
+	if gas == "" {
+		gas = hexutil.EncodeUint64(frame.GasUsed)
+	}
+
+	cf := cpcCallFrame{
+		Type:    CpcCallFrameType,
+		From:    strings.ToLower(frame.From.Hex()),
+		To:      strings.ToLower(frame.To.Hex()),
+		Gas:     gas,
+		GasUsed: hexutil.EncodeUint64(frame.GasUsed),
+		Input:   hexutil.Encode(frame.Input),
+		Method:  frame.Method,
+	}
+
+	if len(frame.Output) > 0 {
+		cf.Output = hexutil.Encode(frame.Output)
+	}
+
+	if frame.Err != nil {
+		cf.Error = frame.Err.Error()
+	}
+
+	if len(frame.Args) > 0 {
+		cf.Args = make(map[string]interface{}, len(frame.Args))
+		for name, arg := range frame.Args {
+			cf.Args[name] = normalizeCpcCallArg(arg)
+		}
+	}
+
+	for _, event := range frame.CosmosEvents {
+		ce := cosmosEvent{
+			Type:       event.Type,
+			Attributes: make([]cosmosEventAttribute, len(event.Attributes)),
+		}
+		for i, attr := range event.Attributes {
+			ce.Attributes[i] = cosmosEventAttribute{
+				Key:   attr.Key,
+				Value: attr.Value,
+			}
+		}
+		cf.CosmosEvents = append(cf.CosmosEvents, ce)
+	}
+
+	return cf
+}
+
+// normalizeCpcCallArg converts the decoded argument into the JSON friendly form,
+// numbers are encoded as decimal strings and bytes are encoded as hex.
+func normalizeCpcCallArg(arg interface{}) interface{} {
+	switch v := arg.(type) {
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	if rv := reflect.ValueOf(arg); rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		bz := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(bz), rv)
+		return hexutil.Encode(bz)
+	}
+
+	return arg
+}
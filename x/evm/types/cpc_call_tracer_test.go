@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	cpctypes "github.com/EscanBE/evermint/x/cpc/types"
+)
+
+type fixedResultTracer struct {
+	NoOpTracer
+	result string
+}
+
+func (t fixedResultTracer) GetResult() (json.RawMessage, error) {
+	return json.RawMessage(t.result), nil
+}
+
+func (t fixedResultTracer) Stop(_ error) {}
+
+// decodedCallFrame is used to decode the call frames of the result.
+type decodedCallFrame struct {
+	cpcCallFrame
+	Calls []decodedCallFrame `json:"calls,omitempty"`
+}
+
+func TestCpcCallTracer(t *testing.T) {
+	const twoSubCallsResult = `{"type":"CALL","from":"0x0000000000000000000000000000000000000001","gas":"0x5208","gasUsed":"0x5208","input":"0x","calls":[{"type":"CALL","from":"0x0000000000000000000000000000000000000002","gas":"0x1","gasUsed":"0x1","input":"0x"},{"type":"STATICCALL","from":"0x0000000000000000000000000000000000000002","gas":"0x2710","gasUsed":"0x1","input":"0x"}]}`
+
+	cpcCallFrame := cpctypes.CpcCallFrame{
+		From:    common.HexToAddress("0x2"),
+		To:      common.HexToAddress("0x3"),
+		Input:   []byte{0x01, 0x02, 0x03, 0x04},
+		Output:  []byte{0x05},
+		Err:     errors.New("failed"),
+		GasUsed: 1000,
+		Method:  "method(bytes32,uint256)",
+		Args: map[string]interface{}{
+			"hash":   [32]byte{0x01},
+			"amount": big.NewInt(1),
+		},
+		CosmosEvents: []abci.Event{
+			{
+				Type: "transfer",
+				Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: "1wei"},
+				},
+			},
+		},
+	}
+
+	traceSecondSubCall := func(tracer *CpcCallTracer) {
+		tracer.CaptureStart(nil, common.HexToAddress("0x1"), common.HexToAddress("0x2"), false, nil, 21000, nil)
+		tracer.CaptureEnter(corevm.CALL, common.HexToAddress("0x2"), common.HexToAddress("0x3"), nil, 1, nil)
+		tracer.CaptureExit(nil, 1, nil)
+		tracer.CaptureEnter(corevm.STATICCALL, common.HexToAddress("0x2"), common.HexToAddress("0x3"), nil, 1, nil)
+		tracer.CaptureCpcCall(cpcCallFrame)
+		tracer.CaptureExit(nil, 1, nil)
+	}
+
+	t.Run("pass - nested into the current frame", func(t *testing.T) {
+		tracer := NewCpcCallTracer(fixedResultTracer{result: twoSubCallsResult})
+		traceSecondSubCall(tracer)
+
+		result, err := tracer.GetResult()
+		require.NoError(t, err)
+
+		var root decodedCallFrame
+		require.NoError(t, json.Unmarshal(result, &root))
+		require.Len(t, root.Calls, 2)
+		require.Empty(t, root.Calls[0].Calls)
+		require.Len(t, root.Calls[1].Calls, 1)
+
+		got := root.Calls[1].Calls[0]
+		require.Equal(t, CpcCallFrameType, got.Type)
+		require.Equal(t, "0x0000000000000000000000000000000000000002", got.From)
+		require.Equal(t, "0x0000000000000000000000000000000000000003", got.To)
+		require.Equal(t, "0x2710", got.Gas, "gas must be the gas provided to the call into the contract")
+		require.Equal(t, "0x3e8", got.GasUsed)
+		require.Equal(t, "0x01020304", got.Input)
+		require.Equal(t, "0x05", got.Output)
+		require.Equal(t, "failed", got.Error)
+		require.Equal(t, "method(bytes32,uint256)", got.Method)
+		require.Equal(t, map[string]interface{}{
+			"hash":   "0x0100000000000000000000000000000000000000000000000000000000000000",
+			"amount": "1",
+		}, got.Args)
+		require.Equal(t, []cosmosEvent{
+			{
+				Type:       "transfer",
+				Attributes: []cosmosEventAttribute{{Key: "amount", Value: "1wei"}},
+			},
+		}, got.CosmosEvents)
+	})
+
+	t.Run("pass - nested into the root frame", func(t *testing.T) {
+		tracer := NewCpcCallTracer(fixedResultTracer{result: `{"type":"CALL","from":"0x0000000000000000000000000000000000000001","gas":"0x5208","gasUsed":"0x5208","input":"0x"}`})
+		tracer.CaptureStart(nil, common.HexToAddress("0x1"), common.HexToAddress("0x2"), false, nil, 21000, nil)
+		tracer.CaptureCpcCall(cpcCallFrame)
+
+		result, err := tracer.GetResult()
+		require.NoError(t, err)
+
+		var root decodedCallFrame
+		require.NoError(t, json.Unmarshal(result, &root))
+		require.Len(t, root.Calls, 1)
+		require.Equal(t, CpcCallFrameType, root.Calls[0].Type)
+	})
+
+	t.Run("pass - fields of the call frames are kept", func(t *testing.T) {
+		const withExtraFieldsResult = `{"type":"CALL","from":"0x0000000000000000000000000000000000000001","gas":"0x5208","gasUsed":"0x5208","input":"0x","value":"0x1","revertReason":"reason","logs":[{"address":"0x0000000000000000000000000000000000000003","topics":[],"data":"0x"}],"extra":123456789012345678901234567890}`
+
+		tracer := NewCpcCallTracer(fixedResultTracer{result: withExtraFieldsResult})
+		tracer.CaptureStart(nil, common.HexToAddress("0x1"), common.HexToAddress("0x2"), false, nil, 21000, nil)
+		tracer.CaptureCpcCall(cpcCallFrame)
+
+		result, err := tracer.GetResult()
+		require.NoError(t, err)
+
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(result, &fields))
+		require.JSONEq(t, `"0x1"`, string(fields["value"]))
+		require.JSONEq(t, `"reason"`, string(fields["revertReason"]))
+		require.JSONEq(t, `[{"address":"0x0000000000000000000000000000000000000003","topics":[],"data":"0x"}]`, string(fields["logs"]))
+		require.Equal(t, "123456789012345678901234567890", string(fields["extra"]))
+		require.Contains(t, fields, "calls")
+	})
+
+	t.Run("pass - skip when sub-calls are not collected", func(t *testing.T) {
+		const onlyTopCallResult = `{"type":"CALL","from":"0x0000000000000000000000000000000000000001","gas":"0x5208","gasUsed":"0x5208","input":"0x"}`
+
+		tracer := NewCpcCallTracer(fixedResultTracer{result: onlyTopCallResult})
+		traceSecondSubCall(tracer)
+
+		result, err := tracer.GetResult()
+		require.NoError(t, err)
+		require.JSONEq(t, onlyTopCallResult, string(result))
+	})
+
+	t.Run("pass - result is kept as-is without custom precompiled contract call", func(t *testing.T) {
+		tracer := NewCpcCallTracer(fixedResultTracer{result: twoSubCallsResult})
+		tracer.CaptureStart(nil, common.HexToAddress("0x1"), common.HexToAddress("0x2"), false, nil, 21000, nil)
+		tracer.CaptureEnter(corevm.CALL, common.HexToAddress("0x2"), common.HexToAddress("0x3"), nil, 1, nil)
+		tracer.CaptureExit(nil, 1, nil)
+
+		result, err := tracer.GetResult()
+		require.NoError(t, err)
+		require.Equal(t, twoSubCallsResult, string(result))
+	})
+}
@@ -8,16 +8,19 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxReceipt  = "tx_receipt"
+	EventTypeEthereumTx   = TypeMsgEthereumTx
+	EventTypeBlockBloom   = "block_bloom"
+	EventTypeTxReceipt    = "tx_receipt"
+	EventTypeCosmosTxLogs = "cosmos_tx_logs"
 
 	// eth tx event emitted in AnteHandler
 
@@ -89,6 +92,29 @@ func GetSdkEventForReceipt(
 	), nil
 }
 
+// GetSdkEventForCosmosTxLogs construct event for the logs emitted by a Cosmos tx.
+// Logs must be filled with the non-consensus fields: Tx Hash, Block Number, Transaction Index and Log Index.
+// The same attribute keys as the receipt event are used.
+func GetSdkEventForCosmosTxLogs(logs []*ethtypes.Log) (sdk.Event, error) {
+	if len(logs) == 0 {
+		return sdk.Event{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no logs")
+	}
+
+	bzLogs, err := rlp.EncodeToBytes(logs)
+	if err != nil {
+		return sdk.Event{}, errorsmod.Wrap(err, "failed to marshal logs")
+	}
+
+	return sdk.NewEvent(
+		EventTypeCosmosTxLogs,
+		sdk.NewAttribute(AttributeKeyReceiptMarshalled, hexutil.Encode(bzLogs)),
+		sdk.NewAttribute(AttributeKeyReceiptCometBFTTxHash, logs[0].TxHash.Hex()),
+		sdk.NewAttribute(AttributeKeyReceiptBlockNumber, strconv.FormatUint(logs[0].BlockNumber, 10)),
+		sdk.NewAttribute(AttributeKeyReceiptTxIndex, strconv.FormatUint(uint64(logs[0].TxIndex), 10)),
+		sdk.NewAttribute(AttributeKeyReceiptStartLogIndex, strconv.FormatUint(uint64(logs[0].Index), 10)),
+	), nil
+}
+
 // TxWasDroppedPreAnteHandleDueToBlockGasExcess returns true if the tx was ignored pre-ante-handler due to block gas exceed.
 // There are some state of a tx:
 //   - Case 1: was applied successfully
@@ -45,6 +45,12 @@ const (
 	prefixTransientFlagIncreasedSenderNonce
 	prefixTransientFlagNoBaseFee
 	prefixTransientFlagSenderPaidFee
+	prefixTransientCosmosTxPendingLogs
+	prefixTransientCosmosTxLogCount
+	prefixTransientCosmosTxLogsBloom
+	prefixTransientBlockTxCount
+	prefixTransientBlockTxPosition
+	prefixTransientFlagEthereumTx
 )
 
 // KVStore key prefixes
@@ -65,6 +71,9 @@ var (
 	KeyPrefixTransientTxGas      = []byte{prefixTransientTxGas}
 	KeyPrefixTransientTxLogCount = []byte{prefixTransientTxLogCount}
 	KeyPrefixTransientTxReceipt  = []byte{prefixTransientTxReceipt}
+
+	KeyPrefixTransientCosmosTxPendingLogs = []byte{prefixTransientCosmosTxPendingLogs}
+	KeyPrefixTransientBlockTxPosition     = []byte{prefixTransientBlockTxPosition}
 )
 
 // Transient Store key
@@ -73,6 +82,10 @@ var (
 	KeyTransientFlagIncreasedSenderNonce = []byte{prefixTransientFlagIncreasedSenderNonce}
 	KeyTransientFlagNoBaseFee            = []byte{prefixTransientFlagNoBaseFee}
 	KeyTransientSenderPaidFee            = []byte{prefixTransientFlagSenderPaidFee}
+	KeyTransientCosmosTxLogCount         = []byte{prefixTransientCosmosTxLogCount}
+	KeyTransientCosmosTxLogsBloom        = []byte{prefixTransientCosmosTxLogsBloom}
+	KeyTransientBlockTxCount             = []byte{prefixTransientBlockTxCount}
+	KeyTransientFlagEthereumTx           = []byte{prefixTransientFlagEthereumTx}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -102,3 +115,13 @@ func TxLogCountTransientKey(txIdx uint64) []byte {
 func TxReceiptTransientKey(txIdx uint64) []byte {
 	return append(KeyPrefixTransientTxReceipt, sdk.Uint64ToBigEndian(txIdx)...)
 }
+
+// CosmosTxPendingLogsTransientKey returns the key for the pending logs of the Cosmos tx with the given CometBFT tx hash.
+func CosmosTxPendingLogsTransientKey(cometTxHash []byte) []byte {
+	return append(KeyPrefixTransientCosmosTxPendingLogs, cometTxHash...)
+}
+
+// BlockTxPositionTransientKey returns the key for the position in the current block of the tx with the given CometBFT tx hash.
+func BlockTxPositionTransientKey(cometTxHash []byte) []byte {
+	return append(KeyPrefixTransientBlockTxPosition, cometTxHash...)
+}
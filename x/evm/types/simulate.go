@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+)
+
+// MaxSimulateBlocks is the maximum number of blocks can be simulated by a single `eth_simulateV1` request.
+const MaxSimulateBlocks = 256
+
+// MaxSimulateCallsPerBlock is the maximum number of calls can be simulated in a single block of the `eth_simulateV1` request.
+const MaxSimulateCallsPerBlock = 1000
+
+// SimulateTimestampIncrement is the default time increment, in seconds, between simulated blocks.
+const SimulateTimestampIncrement = 12
+
+// SimOpts is the options of the `eth_simulateV1` rpc api.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	TraceTransfers  bool       `json:"traceTransfers"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially in a block.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride    `json:"stateOverrides,omitempty"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// Validate performs a basic validation of the simulation options.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) < 1 {
+		return fmt.Errorf("empty input")
+	}
+
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks, maximum %d", MaxSimulateBlocks)
+	}
+
+	for i, block := range opts.BlockStateCalls {
+		if len(block.Calls) > MaxSimulateCallsPerBlock {
+			return fmt.Errorf("block #%d: too many calls, maximum %d", i, MaxSimulateCallsPerBlock)
+		}
+
+		if block.BlockOverrides != nil {
+			if err := block.BlockOverrides.Validate(); err != nil {
+				return fmt.Errorf("block #%d: %w", i, err)
+			}
+		}
+
+		if block.StateOverrides != nil {
+			if err := block.StateOverrides.Validate(); err != nil {
+				return fmt.Errorf("block #%d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	corevm "github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// TransferLogAddress is the pseudo address of the native coin transfer logs, following ERC-7528.
+	TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+	// TransferLogTopic is the topic of the ERC-20 `Transfer(address,address,uint256)` event.
+	TransferLogTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+var _ corevm.EVMLogger = &TransferTracer{}
+
+// TransferTracer adds an ERC-20 like `Transfer` log into the StateDB for every native coin transfer,
+// so the transfers are reported along with the logs of the execution.
+// The logs are reverted together with the call frame which made the transfer.
+type TransferTracer struct {
+	NoOpTracer
+
+	stateDB corevm.StateDB
+}
+
+// NewTransferTracer returns a new TransferTracer.
+func NewTransferTracer() *TransferTracer {
+	return &TransferTracer{}
+}
+
+// CaptureStart implements vm.Tracer interface
+func (t *TransferTracer) CaptureStart(env *corevm.EVM, from common.Address, to common.Address, _ bool, _ []byte, _ uint64, value *big.Int) {
+	t.stateDB = env.StateDB
+	t.captureTransfer(from, to, value)
+}
+
+// CaptureEnter implements vm.Tracer interface
+func (t *TransferTracer) CaptureEnter(typ corevm.OpCode, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	if typ == corevm.DELEGATECALL || typ == corevm.CALLCODE {
+		// no value is transferred to another account
+		return
+	}
+	t.captureTransfer(from, to, value)
+}
+
+func (t *TransferTracer) captureTransfer(from, to common.Address, value *big.Int) {
+	if t.stateDB == nil || value == nil || value.Sign() < 1 {
+		return
+	}
+
+	t.stateDB.AddLog(&ethtypes.Log{
+		Address: TransferLogAddress,
+		Topics: []common.Hash{
+			TransferLogTopic,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.BigToHash(value).Bytes(),
+	})
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -41,6 +42,15 @@ type CStateDB interface {
 
 	DestroyAccount(acc common.Address)
 
+	// AddCpcDynamicGas adds the extra gas consumed by the custom precompiled contracts, to be charged at the end of the tx.
+	AddCpcDynamicGas(gas uint64)
+	// GetCpcDynamicGas returns the extra gas consumed by the custom precompiled contracts.
+	GetCpcDynamicGas() uint64
+	// SetCpcDynamicGasLimit sets the maximum extra gas the custom precompiled contracts can consume during the tx.
+	SetCpcDynamicGasLimit(gas uint64)
+	// GetCpcDynamicGasRemaining returns the extra gas the custom precompiled contracts can still consume during the tx.
+	GetCpcDynamicGasRemaining() uint64
+
 	// Not yet available in current version of go-ethereum
 
 	Selfdestruct6780(address common.Address)
@@ -87,6 +97,11 @@ type cStateDb struct {
 	logs           Logs
 	// Legacy TODO UPGRADE check code changes for transientStorage at https://github.com/ethereum/go-ethereum/blob/master/core/state/transient_storage.go
 	transientStorage TransientStorage
+
+	// non-revertible states
+
+	cpcDynamicGas      uint64 // extra gas consumed by the custom precompiled contracts, not reverted because the work was done
+	cpcDynamicGasLimit uint64 // maximum extra gas the custom precompiled contracts can consume, unlimited by default
 }
 
 // preventCommit is a flag to prevent committing state changes to the underlying storage.
@@ -131,6 +146,8 @@ func NewStateDB(
 		selfDestructed:   newAccountTracker(),
 		accessList:       newAccessList2(),
 		transientStorage: TransientStorage(newTransientStorage()),
+
+		cpcDynamicGasLimit: math.MaxUint64,
 	}
 
 	firstSnapshot := newStateDbSnapshotFromStateDb(sdb, ctx)
@@ -338,6 +355,35 @@ func (d *cStateDb) GetRefund() uint64 {
 	return d.refund
 }
 
+// AddCpcDynamicGas adds the extra gas consumed by the custom precompiled contracts.
+// This method will panic if the counter goes above max uint64.
+func (d *cStateDb) AddCpcDynamicGas(gas uint64) {
+	newCpcDynamicGas := d.cpcDynamicGas + gas
+	if newCpcDynamicGas < gas {
+		panic(evmtypes.ErrEngineFailure.Wrapf("custom precompiled contract dynamic gas counter overflow"))
+	}
+	d.cpcDynamicGas = newCpcDynamicGas
+}
+
+// GetCpcDynamicGas returns the extra gas consumed by the custom precompiled contracts.
+func (d *cStateDb) GetCpcDynamicGas() uint64 {
+	return d.cpcDynamicGas
+}
+
+// SetCpcDynamicGasLimit sets the maximum extra gas the custom precompiled contracts can consume during the tx.
+func (d *cStateDb) SetCpcDynamicGasLimit(gas uint64) {
+	d.cpcDynamicGasLimit = gas
+}
+
+// GetCpcDynamicGasRemaining returns the extra gas the custom precompiled contracts can still consume during the tx,
+// zero if the consumed extra gas already reached the limit.
+func (d *cStateDb) GetCpcDynamicGasRemaining() uint64 {
+	if d.cpcDynamicGas >= d.cpcDynamicGasLimit {
+		return 0
+	}
+	return d.cpcDynamicGasLimit - d.cpcDynamicGas
+}
+
 // GetCommittedState retrieves a value from the given account's committed storage trie.
 func (d *cStateDb) GetCommittedState(address common.Address, hash common.Hash) common.Hash {
 	accountAtCurrentCtx := d.accountKeeper.GetAccount(d.currentCtx, address.Bytes())
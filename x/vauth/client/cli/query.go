@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdQueryProofExternalOwnedAccountByAddress(),
+		CmdQueryProofsExternalOwnedAccount(),
+		CmdQueryParams(),
 	)
 
 	return cmd
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// CmdQueryProofsExternalOwnedAccount is the CLI command for querying all the proofs EOA
+func CmdQueryProofsExternalOwnedAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proofs-eoa",
+		Aliases: []string{"proofs"},
+		Short:   "Querying all the proofs external owned account",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := vauthtypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProofsExternalOwnedAccount(cmd.Context(), &vauthtypes.QueryProofsExternalOwnedAccountRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proofs-eoa")
+
+	return cmd
+}
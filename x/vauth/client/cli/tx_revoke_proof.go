@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+const cmdRevokeProof = "revoke-proof-eoa"
+
+// NewRevokeProofTxCmd is the CLI command for revoke proof of the sender.
+func NewRevokeProofTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     cmdRevokeProof,
+		Aliases: []string{"revoke-proof"},
+		Short:   "Revoke proof account is EOA, of the sender",
+		Example: fmt.Sprintf(
+			"$ %s tx %s %s --%s account",
+			version.AppName, vauthtypes.ModuleName, cmdRevokeProof,
+			flags.FlagFrom,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account := clientCtx.GetFromAddress().String()
+
+			if account == "" {
+				return fmt.Errorf("flag --%s is required", flags.FlagFrom)
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &vauthtypes.MsgRevokeProofExternalOwnedAccount{
+				Account: account,
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -22,6 +22,11 @@ import (
 
 const cmdSubmitProof = "submit-proof-eoa"
 
+const (
+	flagContractWallet = "contract-wallet"
+	flagExpiryHeight   = "expiry-height"
+)
+
 // NewSubmitProofTxCmd is the CLI command for submit proof.
 func NewSubmitProofTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -29,10 +34,10 @@ func NewSubmitProofTxCmd() *cobra.Command {
 		Aliases: []string{"submit-proof"},
 		Short:   "Submit proof account is EOA",
 		Example: fmt.Sprintf(
-			"$ %s tx %s %s %s1... 0x1234... --%s submitter",
+			"$ %s tx %s %s %s1... 0x1234... --%s submitter [--%s] [--%s 1000000]",
 			version.AppName, vauthtypes.ModuleName, cmdSubmitProof,
 			constants.Bech32PrefixAccAddr,
-			flags.FlagFrom,
+			flags.FlagFrom, flagContractWallet, flagExpiryHeight,
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -64,10 +69,6 @@ func NewSubmitProofTxCmd() *cobra.Command {
 			}
 			account = accAddr.String()
 
-			if account == submitter {
-				return fmt.Errorf("submitter cannot be the proof account, please use another account")
-			}
-
 			if !strings.HasPrefix(signature, "0x") {
 				signature = "0x" + signature
 			}
@@ -77,23 +78,36 @@ func NewSubmitProofTxCmd() *cobra.Command {
 				return errorsmod.Wrap(err, "failed to decode signature")
 			}
 
-			verified, err := vauthutils.VerifySignature(common.BytesToAddress(accAddr), bzSignature, vauthtypes.MessageToSign)
-			if err != nil {
-				return errorsmod.Wrap(err, "failed to verify locally")
-			}
-			if !verified {
-				return fmt.Errorf("un-expected error, signature does not match")
+			contractWallet, _ := cmd.Flags().GetBool(flagContractWallet)
+			expiryHeight, _ := cmd.Flags().GetUint64(flagExpiryHeight)
+
+			kind := vauthtypes.PROOF_KIND_SECP256K1
+			if contractWallet {
+				// EIP-1271 signature can only be verified on-chain
+				kind = vauthtypes.PROOF_KIND_EIP1271
+			} else {
+				verified, err := vauthutils.VerifySignature(common.BytesToAddress(accAddr), bzSignature, vauthtypes.MessageToSign)
+				if err != nil {
+					return errorsmod.Wrap(err, "failed to verify locally")
+				}
+				if !verified {
+					return fmt.Errorf("un-expected error, signature does not match")
+				}
 			}
 
 			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &vauthtypes.MsgSubmitProofExternalOwnedAccount{
-				Submitter: submitter,
-				Account:   account,
-				Signature: signature,
+				Submitter:    submitter,
+				Account:      account,
+				Signature:    signature,
+				ExpiryHeight: expiryHeight,
+				Kind:         kind,
 			})
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(flagContractWallet, false, "The account is a contract wallet, signature will be verified by EIP-1271 `isValidSignature`")
+	cmd.Flags().Uint64(flagExpiryHeight, 0, "Block height from which the proof is no longer valid, zero means never expire")
 
 	return cmd
 }
@@ -0,0 +1,51 @@
+package vauth
+
+import (
+	"fmt"
+
+	vauthkeeper "github.com/EscanBE/evermint/x/vauth/keeper"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k vauthkeeper.Keeper,
+	data vauthtypes.GenesisState,
+) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, proof := range data.ProofsExternalOwnedAccount {
+		// secp256k1 signature will be re-verified before persisting.
+		// Contract wallet signature can not be verified statelessly,
+		// so it is verified through the EVM, which is initialized before this module.
+		// The result depends on the state of the wallet and the chain-id, which may change after the proof was submitted,
+		// so the proof is dropped instead of aborting the import.
+		if proof.Kind == vauthtypes.PROOF_KIND_EIP1271 {
+			if err := k.VerifyEip1271Proof(ctx, proof); err != nil {
+				k.Logger(ctx).Error("dropped EIP-1271 proof which is no longer valid", "account", proof.Account, "error", err.Error())
+				continue
+			}
+		}
+
+		if err := k.SaveProofExternalOwnedAccount(ctx, proof); err != nil {
+			panic(fmt.Errorf("error importing proof of account %s: %s", proof.Account, err))
+		}
+	}
+}
+
+// ExportGenesis export genesis state for vauth
+func ExportGenesis(ctx sdk.Context, k vauthkeeper.Keeper) vauthtypes.GenesisState {
+	proofs := k.GetAllProofsExternalOwnedAccount(ctx)
+	if proofs == nil {
+		proofs = []vauthtypes.ProofExternalOwnedAccount{}
+	}
+
+	return vauthtypes.GenesisState{
+		ProofsExternalOwnedAccount: proofs,
+		Params:                     k.GetParams(ctx),
+	}
+}
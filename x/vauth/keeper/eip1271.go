@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// eip1271VerificationGasLimit is the gas limit for the `isValidSignature` call.
+	eip1271VerificationGasLimit = 200_000
+)
+
+var (
+	// eip1271IsValidSignatureSelector is the 4 bytes selector of `isValidSignature(bytes32,bytes)`
+	eip1271IsValidSignatureSelector = []byte{0x16, 0x26, 0xba, 0x7e}
+
+	// eip1271MagicValue is the value returned by `isValidSignature(bytes32,bytes)` when the signature is valid
+	eip1271MagicValue = eip1271IsValidSignatureSelector
+
+	eip1271IsValidSignatureArgs = func() abi.Arguments {
+		bytes32Type, err := abi.NewType("bytes32", "", nil)
+		if err != nil {
+			panic(err)
+		}
+		bytesType, err := abi.NewType("bytes", "", nil)
+		if err != nil {
+			panic(err)
+		}
+		return abi.Arguments{{Type: bytes32Type}, {Type: bytesType}}
+	}()
+)
+
+// verifyEip1271Signature verifies the signature of a contract wallet, by calling `isValidSignature(bytes32,bytes)`
+// on the contract code of the account, with the hash of the message to sign.
+func (k Keeper) verifyEip1271Signature(ctx sdk.Context, account common.Address, signature []byte) error {
+	codeHash := k.evmKeeper.GetCodeHash(ctx, account.Bytes())
+	if len(k.evmKeeper.GetCode(ctx, codeHash)) == 0 {
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "account is not a contract, can not verify EIP-1271 signature: %s", account)
+	}
+
+	messageHash := crypto.Keccak256Hash([]byte(vauthtypes.MessageToSign))
+	args, err := eip1271IsValidSignatureArgs.Pack(messageHash, signature)
+	if err != nil {
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "failed to pack EIP-1271 input: %s", err.Error())
+	}
+	input := append(append([]byte{}, eip1271IsValidSignatureSelector...), args...)
+
+	caller := common.BytesToAddress(authtypes.NewModuleAddress(vauthtypes.ModuleName))
+	ret, gasUsed, err := k.evmKeeper.StaticCall(ctx, caller, account, input, eip1271VerificationGasLimit)
+	// the EVM execution is not metered by the SDK gas meter, so the EVM gas used is charged, regardless of the result
+	ctx.GasMeter().ConsumeGas(gasUsed, "EIP-1271 signature verification")
+	if err != nil {
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "failed to verify EIP-1271 signature: %s", err.Error())
+	}
+
+	if len(ret) < 32 || !bytes.Equal(ret[:4], eip1271MagicValue) {
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "mis-match EIP-1271 signature with provided account: %s", account)
+	}
+
+	return nil
+}
+
+// VerifyEip1271Proof verifies the signature of the contract wallet proof through the EVM.
+// Contract wallet proofs can not be verified statelessly by ValidateBasic,
+// so it is used to verify the proofs imported from genesis, after the EVM state was loaded.
+func (k Keeper) VerifyEip1271Proof(ctx sdk.Context, proof vauthtypes.ProofExternalOwnedAccount) error {
+	if proof.Kind != vauthtypes.PROOF_KIND_EIP1271 {
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "not an EIP-1271 proof: %s", proof.Kind)
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(proof.Account)
+	if err != nil {
+		return errorsmod.Wrapf(errors.ErrInvalidAddress, "invalid account address: %s", proof.Account)
+	}
+
+	bzSignature, err := hex.DecodeString(strings.TrimPrefix(proof.Signature, "0x"))
+	if err != nil {
+		return errorsmod.Wrap(errors.ErrInvalidRequest, "bad signature")
+	}
+
+	return k.verifyEip1271Signature(ctx, common.BytesToAddress(accAddr), bzSignature)
+}
@@ -0,0 +1,252 @@
+package keeper_test
+
+//goland:noinspection SpellCheckingInspection
+import (
+	"encoding/hex"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/EscanBE/evermint/integration_test_util"
+	itutiltypes "github.com/EscanBE/evermint/integration_test_util/types"
+	"github.com/EscanBE/evermint/x/vauth"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+)
+
+var (
+	// eip1271AcceptAllWalletCode returns the EIP-1271 magic value `0x1626ba7e` for any input
+	eip1271AcceptAllWalletCode = common.FromHex("0x631626ba7e60e01b60005260206000f3")
+	// eip1271RejectAllWalletCode returns 32 zero bytes for any input
+	eip1271RejectAllWalletCode = common.FromHex("0x60206000f3")
+	// eip1271RevertWalletCode reverts for any input
+	eip1271RevertWalletCode = common.FromHex("0x60006000fd")
+)
+
+type Eip1271IntegrationTestSuite struct {
+	suite.Suite
+	CITS *integration_test_util.ChainIntegrationTestSuite
+}
+
+func (suite *Eip1271IntegrationTestSuite) App() itutiltypes.ChainApp {
+	return suite.CITS.ChainApp
+}
+
+func (suite *Eip1271IntegrationTestSuite) Ctx() sdk.Context {
+	return suite.CITS.CurrentContext
+}
+
+func TestEip1271IntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(Eip1271IntegrationTestSuite))
+}
+
+func (suite *Eip1271IntegrationTestSuite) SetupTest() {
+	suite.CITS = integration_test_util.CreateChainIntegrationTestSuite(suite.T(), suite.Require())
+}
+
+func (suite *Eip1271IntegrationTestSuite) TearDownTest() {
+	suite.CITS.Cleanup()
+}
+
+// deployWallet puts the given code at a new address, acts as a contract wallet.
+func (suite *Eip1271IntegrationTestSuite) deployWallet(ctx sdk.Context, code []byte) common.Address {
+	return suite.deployWalletAt(ctx, common.BytesToAddress(crypto.Keccak256(code)[:20]), code)
+}
+
+// deployWalletAt puts the given code at the given address, replaces the existing code if any.
+func (suite *Eip1271IntegrationTestSuite) deployWalletAt(ctx sdk.Context, wallet common.Address, code []byte) common.Address {
+	accountKeeper := suite.App().AccountKeeper()
+	if !accountKeeper.HasAccount(ctx, wallet.Bytes()) {
+		accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, wallet.Bytes()))
+	}
+
+	codeHash := crypto.Keccak256Hash(code)
+	suite.App().EvmKeeper().SetCode(ctx, codeHash.Bytes(), code)
+	suite.App().EvmKeeper().SetCodeHash(ctx, wallet, codeHash)
+
+	return wallet
+}
+
+func (suite *Eip1271IntegrationTestSuite) TestSubmitProofContractWallet() {
+	submitter := suite.CITS.WalletAccounts.Number(1)
+	signature := "0x" + hex.EncodeToString([]byte("contract wallet signature"))
+
+	tests := []struct {
+		name            string
+		code            []byte
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "pass - wallet returns magic value",
+			code:    eip1271AcceptAllWalletCode,
+			wantErr: false,
+		},
+		{
+			name:            "fail - wallet does not return magic value",
+			code:            eip1271RejectAllWalletCode,
+			wantErr:         true,
+			wantErrContains: "mis-match EIP-1271 signature",
+		},
+		{
+			name:            "fail - wallet reverts",
+			code:            eip1271RevertWalletCode,
+			wantErr:         true,
+			wantErrContains: "failed to verify EIP-1271 signature",
+		},
+	}
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			ctx, _ := suite.Ctx().CacheContext()
+
+			wallet := suite.deployWallet(ctx, tt.code)
+
+			err := suite.App().VAuthKeeper().ProcessSubmitProofExternalOwnedAccount(ctx, &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: submitter.GetCosmosAddress().String(),
+				Account:   sdk.AccAddress(wallet.Bytes()).String(),
+				Signature: signature,
+				Kind:      vauthtypes.PROOF_KIND_EIP1271,
+			})
+
+			if tt.wantErr {
+				suite.Require().ErrorContains(err, tt.wantErrContains)
+				suite.False(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, wallet.Bytes()))
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			proof := suite.App().VAuthKeeper().GetProofExternalOwnedAccount(ctx, wallet.Bytes())
+			suite.Require().NotNil(proof)
+			suite.Equal(vauthtypes.PROOF_KIND_EIP1271, proof.Kind)
+			suite.Equal(signature, proof.Signature)
+		})
+	}
+
+	suite.Run("pass - EVM gas used by the verification is charged", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		wallet := suite.deployWallet(ctx, eip1271AcceptAllWalletCode)
+
+		// only the EVM gas is counted
+		ctx = ctx.
+			WithGasMeter(storetypes.NewInfiniteGasMeter()).
+			WithKVGasConfig(storetypes.GasConfig{}).
+			WithTransientKVGasConfig(storetypes.GasConfig{})
+
+		err := suite.App().VAuthKeeper().ProcessSubmitProofExternalOwnedAccount(ctx, &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+			Submitter: submitter.GetCosmosAddress().String(),
+			Account:   sdk.AccAddress(wallet.Bytes()).String(),
+			Signature: signature,
+			Kind:      vauthtypes.PROOF_KIND_EIP1271,
+		})
+		suite.Require().NoError(err)
+
+		suite.NotZero(ctx.GasMeter().GasConsumed())
+	})
+
+	suite.Run("fail - secp256k1 proof can not be used for contract wallet", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		wallet := suite.deployWallet(ctx, eip1271AcceptAllWalletCode)
+
+		err := suite.App().VAuthKeeper().ProcessSubmitProofExternalOwnedAccount(ctx, &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+			Submitter: submitter.GetCosmosAddress().String(),
+			Account:   sdk.AccAddress(wallet.Bytes()).String(),
+			Signature: signature,
+			Kind:      vauthtypes.PROOF_KIND_SECP256K1,
+		})
+		suite.Require().Error(err)
+		suite.False(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, wallet.Bytes()))
+	})
+}
+
+func (suite *Eip1271IntegrationTestSuite) TestInitGenesisContractWalletProof() {
+	signature := "0x" + hex.EncodeToString([]byte("contract wallet signature"))
+
+	buildGenesis := func(wallet common.Address) vauthtypes.GenesisState {
+		return vauthtypes.GenesisState{
+			Params: vauthtypes.DefaultParams(),
+			ProofsExternalOwnedAccount: []vauthtypes.ProofExternalOwnedAccount{
+				{
+					Account:   sdk.AccAddress(wallet.Bytes()).String(),
+					Hash:      "0x" + hex.EncodeToString(crypto.Keccak256([]byte(vauthtypes.MessageToSign))),
+					Signature: signature,
+					Kind:      vauthtypes.PROOF_KIND_EIP1271,
+				},
+			},
+		}
+	}
+
+	suite.Run("pass - import proof verified by the wallet", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		wallet := suite.deployWallet(ctx, eip1271AcceptAllWalletCode)
+
+		vauth.InitGenesis(ctx, *suite.App().VAuthKeeper(), buildGenesis(wallet))
+
+		suite.True(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, wallet.Bytes()))
+	})
+
+	suite.Run("pass - proof rejected by the wallet is dropped", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		wallet := suite.deployWallet(ctx, eip1271RejectAllWalletCode)
+
+		suite.Require().NotPanics(func() {
+			vauth.InitGenesis(ctx, *suite.App().VAuthKeeper(), buildGenesis(wallet))
+		})
+
+		suite.False(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, wallet.Bytes()))
+	})
+
+	suite.Run("pass - proof of account which is not a contract is dropped", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		account := suite.CITS.WalletAccounts.Number(1)
+
+		suite.Require().NotPanics(func() {
+			vauth.InitGenesis(ctx, *suite.App().VAuthKeeper(), buildGenesis(account.GetEthAddress()))
+		})
+
+		suite.False(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, account.GetCosmosAddress()))
+	})
+
+	suite.Run("pass - proof is dropped when the wallet state changed between export and import", func() {
+		ctx, _ := suite.Ctx().CacheContext()
+
+		submitter := suite.CITS.WalletAccounts.Number(1)
+		validWallet := suite.deployWallet(ctx, eip1271AcceptAllWalletCode)
+		changedWallet := suite.deployWalletAt(ctx, common.BytesToAddress([]byte("changed wallet")), eip1271AcceptAllWalletCode)
+
+		for _, wallet := range []common.Address{validWallet, changedWallet} {
+			err := suite.App().VAuthKeeper().ProcessSubmitProofExternalOwnedAccount(ctx, &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: submitter.GetCosmosAddress().String(),
+				Account:   sdk.AccAddress(wallet.Bytes()).String(),
+				Signature: signature,
+				Kind:      vauthtypes.PROOF_KIND_EIP1271,
+			})
+			suite.Require().NoError(err)
+		}
+
+		exported := vauth.ExportGenesis(ctx, *suite.App().VAuthKeeper())
+		suite.Require().Len(exported.ProofsExternalOwnedAccount, 2)
+
+		// the wallet no longer accepts the signature, e.g.: the owners were rotated
+		suite.deployWalletAt(ctx, changedWallet, eip1271RejectAllWalletCode)
+		for _, wallet := range []common.Address{validWallet, changedWallet} {
+			suite.App().VAuthKeeper().DeleteProofExternalOwnedAccount(ctx, wallet.Bytes())
+		}
+
+		suite.Require().NotPanics(func() {
+			vauth.InitGenesis(ctx, *suite.App().VAuthKeeper(), exported)
+		})
+
+		suite.True(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, validWallet.Bytes()))
+		suite.False(suite.App().VAuthKeeper().HasProofExternalOwnedAccount(ctx, changedWallet.Bytes()))
+	})
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"reflect"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+)
+
+// CheckEoaProofRules evaluates the EOA proof rules defined in the module params against the given messages.
+// Each account at the address field of the messages matching a rule must have proof of EOA.
+// Nested messages, like inside `authz.MsgExec`, are not evaluated, the caller is responsible for unpacking them.
+func (k Keeper) CheckEoaProofRules(ctx sdk.Context, msgs []sdk.Msg) error {
+	rules := k.GetParams(ctx).EoaProofRules
+	if len(rules) < 1 {
+		return nil
+	}
+
+	for _, msg := range msgs {
+		msgTypeUrl := sdk.MsgTypeURL(msg)
+
+		for _, rule := range rules {
+			if rule.MsgTypeUrl != msgTypeUrl {
+				continue
+			}
+
+			accAddr, err := getAddressFieldValue(msg, rule.AddressField)
+			if err != nil {
+				return errorsmod.Wrapf(
+					sdkerrors.ErrUnauthorized,
+					"failed to evaluate EOA proof rule %s: %s", rule.Describe(), err.Error(),
+				)
+			}
+
+			if k.HasProofExternalOwnedAccount(ctx, accAddr) {
+				continue
+			}
+
+			return errorsmod.Wrapf(
+				sdkerrors.ErrUnauthorized,
+				"must prove account is external owned account (EOA) via `x/%s` module, required by rule %s: %s", vauthtypes.ModuleName, rule.Describe(), accAddr,
+			)
+		}
+	}
+
+	return nil
+}
+
+// getAddressFieldValue returns the address at the given proto field name of the message,
+// nested field is separated by dot.
+// Both account address and validator operator address are supported.
+func getAddressFieldValue(msg sdk.Msg, addressField string) (sdk.AccAddress, error) {
+	value := reflect.ValueOf(msg)
+
+	for _, fieldName := range strings.Split(addressField, ".") {
+		for value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "nil value at field: %s", fieldName)
+			}
+			value = value.Elem()
+		}
+
+		if value.Kind() != reflect.Struct {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "not a message at field: %s", fieldName)
+		}
+
+		var found bool
+		value, found = getProtoFieldByName(value, fieldName)
+		if !found {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing field: %s", fieldName)
+		}
+	}
+
+	if value.Kind() != reflect.String || value.String() == "" {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "field is not an address: %s", addressField)
+	}
+	address := value.String()
+
+	if accAddr, err := sdk.AccAddressFromBech32(address); err == nil {
+		return accAddr, nil
+	}
+
+	if valAddr, err := sdk.ValAddressFromBech32(address); err == nil {
+		return sdk.AccAddress(valAddr), nil
+	}
+
+	return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "field %s is not a valid bech32 address: %s", addressField, address)
+}
+
+// getProtoFieldByName returns the field of the generated proto struct, matching the proto field name.
+func getProtoFieldByName(value reflect.Value, protoFieldName string) (reflect.Value, bool) {
+	for i := 0; i < value.NumField(); i++ {
+		for _, part := range strings.Split(value.Type().Field(i).Tag.Get("protobuf"), ",") {
+			if part == "name="+protoFieldName {
+				return value.Field(i), true
+			}
+		}
+	}
+
+	return reflect.Value{}, false
+}
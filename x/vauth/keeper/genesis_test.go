@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"github.com/EscanBE/evermint/x/vauth"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+)
+
+func (s *KeeperTestSuite) TestExportImportGenesis() {
+	proof := vauthtypes.ProofExternalOwnedAccount{
+		Account:   s.accAddr.String(),
+		Hash:      s.HashToStr(vauthtypes.MessageToSign),
+		Signature: s.SignToStr(vauthtypes.MessageToSign),
+	}
+
+	s.Run("pass - export empty", func() {
+		s.RefreshContext()
+
+		exported := vauth.ExportGenesis(s.ctx, s.keeper)
+		s.Require().NoError(exported.Validate())
+		s.Empty(exported.ProofsExternalOwnedAccount)
+		s.Equal(vauthtypes.DefaultParams(), exported.Params)
+	})
+
+	s.Run("pass - round trip", func() {
+		s.RefreshContext()
+
+		s.Require().NoError(s.keeper.SaveProofExternalOwnedAccount(s.ctx, proof))
+
+		exported := vauth.ExportGenesis(s.ctx, s.keeper)
+		s.Require().NoError(exported.Validate())
+		s.Require().Equal([]vauthtypes.ProofExternalOwnedAccount{proof}, exported.ProofsExternalOwnedAccount)
+
+		s.RefreshContext()
+		s.Require().False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+
+		vauth.InitGenesis(s.ctx, s.keeper, exported)
+
+		s.Equal(exported, vauth.ExportGenesis(s.ctx, s.keeper))
+		s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+	})
+
+	s.Run("fail - import invalid params", func() {
+		s.RefreshContext()
+
+		s.Require().Panics(func() {
+			vauth.InitGenesis(s.ctx, s.keeper, vauthtypes.GenesisState{})
+		})
+	})
+
+	s.Run("fail - import proof with bad signature", func() {
+		s.RefreshContext()
+
+		badProof := proof
+		badProof.Account = s.submitterAccAddr.String()
+
+		s.Require().Panics(func() {
+			vauth.InitGenesis(s.ctx, s.keeper, vauthtypes.GenesisState{
+				Params:                     vauthtypes.DefaultParams(),
+				ProofsExternalOwnedAccount: []vauthtypes.ProofExternalOwnedAccount{badProof},
+			})
+		})
+	})
+}
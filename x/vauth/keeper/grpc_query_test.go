@@ -1,8 +1,13 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	vauthkeeper "github.com/EscanBE/evermint/x/vauth/keeper"
 	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -68,3 +73,80 @@ func (s *KeeperTestSuite) Test_queryServer_ProofExternalOwnedAccount() {
 		s.Require().Nil(resp)
 	})
 }
+
+func (s *KeeperTestSuite) Test_queryServer_ProofsExternalOwnedAccount() {
+	queryServer := vauthkeeper.NewQueryServerImpl(s.keeper)
+
+	s.Run("pass - empty", func() {
+		resp, err := queryServer.ProofsExternalOwnedAccount(s.ctx, &vauthtypes.QueryProofsExternalOwnedAccountRequest{})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Empty(resp.Proofs)
+	})
+
+	var proofs []vauthtypes.ProofExternalOwnedAccount
+	for i := 0; i < 3; i++ {
+		privateKey, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+
+		signature, err := crypto.Sign(s.Hash(vauthtypes.MessageToSign), privateKey)
+		s.Require().NoError(err)
+
+		proof := vauthtypes.ProofExternalOwnedAccount{
+			Account:   sdk.AccAddress(crypto.PubkeyToAddress(privateKey.PublicKey).Bytes()).String(),
+			Hash:      s.HashToStr(vauthtypes.MessageToSign),
+			Signature: "0x" + hex.EncodeToString(signature),
+		}
+		s.Require().NoError(s.keeper.SaveProofExternalOwnedAccount(s.ctx, proof))
+
+		proofs = append(proofs, proof)
+	}
+
+	s.Run("pass - returns all proofs", func() {
+		resp, err := queryServer.ProofsExternalOwnedAccount(s.ctx, &vauthtypes.QueryProofsExternalOwnedAccountRequest{})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.ElementsMatch(proofs, resp.Proofs)
+	})
+
+	s.Run("pass - paginated", func() {
+		resp, err := queryServer.ProofsExternalOwnedAccount(s.ctx, &vauthtypes.QueryProofsExternalOwnedAccountRequest{
+			Pagination: &query.PageRequest{
+				Limit:      2,
+				CountTotal: true,
+			},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Len(resp.Proofs, 2)
+		s.EqualValues(3, resp.Pagination.Total)
+		s.Require().NotEmpty(resp.Pagination.NextKey)
+
+		resp2, err := queryServer.ProofsExternalOwnedAccount(s.ctx, &vauthtypes.QueryProofsExternalOwnedAccountRequest{
+			Pagination: &query.PageRequest{
+				Key: resp.Pagination.NextKey,
+			},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp2)
+		s.Len(resp2.Proofs, 1)
+		s.ElementsMatch(proofs, append(resp.Proofs, resp2.Proofs...))
+	})
+
+	s.Run("fail - nil request", func() {
+		resp, err := queryServer.ProofsExternalOwnedAccount(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+}
+
+func (s *KeeperTestSuite) Test_queryServer_Params() {
+	queryServer := vauthkeeper.NewQueryServerImpl(s.keeper)
+
+	s.Run("pass - returns params", func() {
+		resp, err := queryServer.Params(s.ctx, &vauthtypes.QueryParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Equal(vauthtypes.DefaultParams(), resp.Params)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -17,21 +18,27 @@ import (
 type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
+	authority  sdk.AccAddress
 	bankKeeper bankkeeper.Keeper
-	evmKeeper  evmkeeper.Keeper
+	distKeeper distkeeper.Keeper
+	evmKeeper  *evmkeeper.Keeper
 }
 
 // NewKeeper returns a new instance of the VAuth keeper
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	authority sdk.AccAddress,
 	bk bankkeeper.Keeper,
-	ek evmkeeper.Keeper,
+	dk distkeeper.Keeper,
+	ek *evmkeeper.Keeper,
 ) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		storeKey:   key,
+		authority:  authority,
 		bankKeeper: bk,
+		distKeeper: dk,
 		evmKeeper:  ek,
 	}
 }
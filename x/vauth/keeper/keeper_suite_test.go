@@ -34,6 +34,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -52,6 +54,7 @@ type KeeperTestSuite struct {
 	// keepers
 	authKeeper authkeeper.AccountKeeper
 	bankKeeper bankkeeper.Keeper
+	distKeeper distkeeper.Keeper
 	evmKeeper  evmkeeper.Keeper
 	keeper     vauthkeeper.Keeper
 
@@ -76,6 +79,7 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	var ak authkeeper.AccountKeeper
 	var bk bankkeeper.Keeper
+	var dk distkeeper.Keeper
 	var ek evmkeeper.Keeper
 	var vk vauthkeeper.Keeper
 
@@ -83,6 +87,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		// initialization
 		authStoreKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
 		bankStoreKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
+		distrStoreKey := storetypes.NewKVStoreKey(distrtypes.StoreKey)
 		evmStoreKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
 		vAuthStoreKey := storetypes.NewKVStoreKey(vauthtypes.StoreKey)
 
@@ -90,6 +95,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
 		stateStore.MountStoreWithDB(authStoreKey, storetypes.StoreTypeIAVL, db)
 		stateStore.MountStoreWithDB(bankStoreKey, storetypes.StoreTypeIAVL, db)
+		stateStore.MountStoreWithDB(distrStoreKey, storetypes.StoreTypeIAVL, db)
 		stateStore.MountStoreWithDB(evmStoreKey, storetypes.StoreTypeIAVL, db)
 		stateStore.MountStoreWithDB(vAuthStoreKey, storetypes.StoreTypeIAVL, db)
 		s.Require().NoError(stateStore.LoadLatestVersion())
@@ -110,9 +116,11 @@ func (s *KeeperTestSuite) SetupTest() {
 			runtime.NewKVStoreService(authStoreKey),
 			authtypes.ProtoBaseAccount,
 			map[string][]string{
-				banktypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
-				evmtypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
-				vauthtypes.ModuleName: {authtypes.Burner},
+				authtypes.FeeCollectorName: nil,
+				banktypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+				distrtypes.ModuleName:      nil,
+				evmtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+				vauthtypes.ModuleName:      {authtypes.Burner},
 			},
 			address.NewBech32Codec(constants.Bech32PrefixAccAddr),
 			constants.Bech32PrefixAccAddr,
@@ -130,6 +138,16 @@ func (s *KeeperTestSuite) SetupTest() {
 		)
 		banktypes.RegisterInterfaces(registry)
 
+		dk = distkeeper.NewKeeper(
+			cdc,
+			runtime.NewKVStoreService(distrStoreKey),
+			ak,
+			bk,
+			nil, // staking keeper
+			authtypes.FeeCollectorName,
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		)
+
 		ek = *evmkeeper.NewKeeper(
 			cdc,
 			evmStoreKey,
@@ -146,8 +164,10 @@ func (s *KeeperTestSuite) SetupTest() {
 		vk = vauthkeeper.NewKeeper(
 			cdc,
 			vAuthStoreKey,
+			authtypes.NewModuleAddress(govtypes.ModuleName), // authority
 			bk,
-			ek,
+			dk,
+			&ek,
 		)
 
 		ctx = sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -164,6 +184,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.cdc = cdc
 	s.authKeeper = ak
 	s.bankKeeper = bk
+	s.distKeeper = dk
 	s.evmKeeper = ek
 	s.keeper = vk
 	s.privateKey = privateKey
@@ -175,6 +196,12 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.Require().NoError(
 		s.evmKeeper.SetParams(s.ctx, evmtypes.DefaultParams()),
 	)
+	s.Require().NoError(
+		s.distKeeper.FeePool.Set(s.ctx, distrtypes.InitialFeePool()),
+	)
+	s.Require().NoError(
+		s.keeper.SetParams(s.ctx, vauthtypes.DefaultParams()),
+	)
 
 	s.SaveCurrentContext()
 }
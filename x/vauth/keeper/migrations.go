@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 sets the module params, keeping the previously hard-coded behavior:
+// the submission fee is 1e18 of the EVM denom and burned.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	evmParams := m.keeper.evmKeeper.GetParams(ctx)
+
+	params := vauthtypes.DefaultParamsWithFeeDenom(evmParams.EvmDenom)
+	// the EOA proof rules are introduced in the next version
+	params.EoaProofRules = nil
+
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate2to3 sets the EOA proof rules, keeping the previously hard-coded behavior:
+// the vesting account can only be created for account which has proof of EOA.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.EoaProofRules = vauthtypes.DefaultEoaProofRules()
+
+	return m.keeper.SetParams(ctx, params)
+}
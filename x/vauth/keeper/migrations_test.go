@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	vauthkeeper "github.com/EscanBE/evermint/x/vauth/keeper"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMigrator_Migrate1to2() {
+	s.Run("pass - params keep the hard-coded behavior", func() {
+		s.RefreshContext()
+
+		evmParams := s.evmKeeper.GetParams(s.ctx)
+		evmParams.EvmDenom = "wei"
+		s.Require().NoError(s.evmKeeper.SetParams(s.ctx, evmParams))
+
+		err := vauthkeeper.NewMigrator(s.keeper).Migrate1to2(s.ctx)
+		s.Require().NoError(err)
+
+		s.Equal(vauthtypes.Params{
+			SubmitProofFee:          sdk.NewCoin("wei", vauthtypes.DefaultSubmitProofFeeAmount),
+			FeeDestination:          vauthtypes.FEE_DESTINATION_BURN,
+			ExemptFeeSelfSubmission: false,
+		}, s.keeper.GetParams(s.ctx))
+	})
+}
+
+func (s *KeeperTestSuite) TestMigrator_Migrate2to3() {
+	s.Run("pass - rules keep the hard-coded behavior, other params are kept", func() {
+		s.RefreshContext()
+
+		params := vauthtypes.Params{
+			SubmitProofFee:          sdk.NewInt64Coin("wei", 1),
+			FeeDestination:          vauthtypes.FEE_DESTINATION_COMMUNITY_POOL,
+			ExemptFeeSelfSubmission: true,
+		}
+		s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+		err := vauthkeeper.NewMigrator(s.keeper).Migrate2to3(s.ctx)
+		s.Require().NoError(err)
+
+		params.EoaProofRules = vauthtypes.DefaultEoaProofRules()
+		s.Equal(params, s.keeper.GetParams(s.ctx))
+	})
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RevokeProofExternalOwnedAccount revokes the proof of an account, signed by the account itself
+func (m msgServer) RevokeProofExternalOwnedAccount(goCtx context.Context, msg *vauthtypes.MsgRevokeProofExternalOwnedAccount) (*vauthtypes.MsgRevokeProofExternalOwnedAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.ProcessRevokeProofExternalOwnedAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &vauthtypes.MsgRevokeProofExternalOwnedAccountResponse{}, nil
+}
+
+// ProcessRevokeProofExternalOwnedAccount validates the revocation then removes the proof.
+// This is shared between the msg server and the `x/vauth` custom precompiled contract.
+func (k Keeper) ProcessRevokeProofExternalOwnedAccount(ctx sdk.Context, msg *vauthtypes.MsgRevokeProofExternalOwnedAccount) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	accAddr := sdk.MustAccAddressFromBech32(msg.Account)
+
+	// expired proof can be revoked too, so the stale record is removed from the store
+	if !k.hasProofExternalOwnedAccountRecord(ctx, accAddr) {
+		return errorsmod.Wrapf(errors.ErrNotFound, "account does not have proof: %s", msg.Account)
+	}
+
+	k.DeleteProofExternalOwnedAccount(ctx, accAddr)
+
+	return nil
+}
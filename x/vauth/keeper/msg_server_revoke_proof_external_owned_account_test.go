@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	vauthkeeper "github.com/EscanBE/evermint/x/vauth/keeper"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+//goland:noinspection SpellCheckingInspection
+func (s *KeeperTestSuite) Test_msgServer_RevokeProofExternalOwnedAccount() {
+	saveProof := func(s *KeeperTestSuite, expiryHeight uint64) {
+		err := s.keeper.SaveProofExternalOwnedAccount(s.ctx, vauthtypes.ProofExternalOwnedAccount{
+			Account:      s.accAddr.String(),
+			Hash:         s.HashToStr(vauthtypes.MessageToSign),
+			Signature:    s.SignToStr(vauthtypes.MessageToSign),
+			ExpiryHeight: expiryHeight,
+		})
+		s.Require().NoError(err)
+	}
+
+	tests := []struct {
+		name            string
+		msg             *vauthtypes.MsgRevokeProofExternalOwnedAccount
+		preRunFunc      func(s *KeeperTestSuite)
+		wantErr         bool
+		wantErrContains string
+		postRunFunc     func(s *KeeperTestSuite)
+	}{
+		{
+			name: "pass - can revoke",
+			msg: &vauthtypes.MsgRevokeProofExternalOwnedAccount{
+				Account: s.accAddr.String(),
+			},
+			preRunFunc: func(s *KeeperTestSuite) {
+				saveProof(s, 0)
+			},
+			wantErr: false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+				s.Empty(s.keeper.GetAllProofsExternalOwnedAccount(s.ctx))
+			},
+		},
+		{
+			name: "fail - account does not have proof",
+			msg: &vauthtypes.MsgRevokeProofExternalOwnedAccount{
+				Account: s.accAddr.String(),
+			},
+			wantErr:         true,
+			wantErrContains: "account does not have proof",
+		},
+		{
+			name: "pass - can revoke expired proof, the stale record is removed",
+			msg: &vauthtypes.MsgRevokeProofExternalOwnedAccount{
+				Account: s.accAddr.String(),
+			},
+			preRunFunc: func(s *KeeperTestSuite) {
+				saveProof(s, 5)
+				s.ctx = s.ctx.WithBlockHeight(10)
+			},
+			wantErr: false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.Empty(s.keeper.GetAllProofsExternalOwnedAccount(s.ctx))
+			},
+		},
+		{
+			name: "fail - revoke does not affect proof of other accounts",
+			msg: &vauthtypes.MsgRevokeProofExternalOwnedAccount{
+				Account: s.submitterAccAddr.String(),
+			},
+			preRunFunc: func(s *KeeperTestSuite) {
+				saveProof(s, 0)
+			},
+			wantErr:         true,
+			wantErrContains: "account does not have proof",
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+		},
+		{
+			name:            "fail - reject bad message",
+			msg:             &vauthtypes.MsgRevokeProofExternalOwnedAccount{},
+			wantErr:         true,
+			wantErrContains: errors.ErrInvalidRequest.Error(),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.RefreshContext()
+
+			if tt.preRunFunc != nil {
+				tt.preRunFunc(s)
+			}
+
+			resp, err := vauthkeeper.NewMsgServerImpl(s.keeper).RevokeProofExternalOwnedAccount(s.ctx, tt.msg)
+
+			defer func() {
+				if tt.postRunFunc != nil {
+					tt.postRunFunc(s)
+				}
+			}()
+
+			if tt.wantErr {
+				s.Require().ErrorContains(err, tt.wantErrContains)
+				s.Nil(resp)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(resp)
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -17,42 +19,104 @@ import (
 func (m msgServer) SubmitProofExternalOwnedAccount(goCtx context.Context, msg *vauthtypes.MsgSubmitProofExternalOwnedAccount) (*vauthtypes.MsgSubmitProofExternalOwnedAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := msg.ValidateBasic(); err != nil {
+	if err := m.ProcessSubmitProofExternalOwnedAccount(ctx, msg); err != nil {
 		return nil, err
 	}
 
-	if m.HasProofExternalOwnedAccount(ctx, sdk.MustAccAddressFromBech32(msg.Account)) {
-		return nil, errorsmod.Wrapf(errors.ErrConflict, "account already have proof: %s", msg.Account)
+	return &vauthtypes.MsgSubmitProofExternalOwnedAccountResponse{}, nil
+}
+
+// ProcessSubmitProofExternalOwnedAccount validates the submission, charges the submission fee from the submitter
+// then persists the proof.
+// This is shared between the msg server and the `x/vauth` custom precompiled contract.
+func (k Keeper) ProcessSubmitProofExternalOwnedAccount(ctx sdk.Context, msg *vauthtypes.MsgSubmitProofExternalOwnedAccount) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
 	}
 
-	// charge fee
+	if msg.ExpiryHeight > 0 && msg.ExpiryHeight <= uint64(ctx.BlockHeight()) {
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "expiry height must be greater than current block height: %d", ctx.BlockHeight())
+	}
 
-	evmParams := m.evmKeeper.GetParams(ctx)
-	fees := sdk.NewCoins(sdk.NewInt64Coin(evmParams.EvmDenom, CostSubmitProofExternalOwnedAccount))
-	err := m.bankKeeper.SendCoinsFromAccountToModule(
-		ctx,
-		sdk.MustAccAddressFromBech32(msg.Submitter), vauthtypes.ModuleName,
-		fees,
-	)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to deduct fee from submitter")
+	accAddr := sdk.MustAccAddressFromBech32(msg.Account)
+
+	if k.HasProofExternalOwnedAccount(ctx, accAddr) {
+		return errorsmod.Wrapf(errors.ErrConflict, "account already have proof: %s", msg.Account)
 	}
-	err = m.bankKeeper.BurnCoins(ctx, vauthtypes.ModuleName, fees)
-	if err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to burn coin from module account: %s", vauthtypes.ModuleName)
+
+	if msg.Kind == vauthtypes.PROOF_KIND_EIP1271 {
+		bzSignature, err := hex.DecodeString(msg.Signature[2:])
+		if err != nil {
+			return errorsmod.Wrap(errors.ErrInvalidRequest, "bad signature")
+		}
+
+		if err := k.verifyEip1271Signature(ctx, common.BytesToAddress(accAddr), bzSignature); err != nil {
+			return err
+		}
+	}
+
+	// charge fee
+
+	if err := k.chargeSubmitProofFee(ctx, msg); err != nil {
+		return err
 	}
 
 	// persist
 
 	proof := vauthtypes.ProofExternalOwnedAccount{
-		Account:   msg.Account,
-		Hash:      "0x" + hex.EncodeToString(crypto.Keccak256([]byte(vauthtypes.MessageToSign))),
-		Signature: msg.Signature,
+		Account:      msg.Account,
+		Hash:         "0x" + hex.EncodeToString(crypto.Keccak256([]byte(vauthtypes.MessageToSign))),
+		Signature:    msg.Signature,
+		ExpiryHeight: msg.ExpiryHeight,
+		Kind:         msg.Kind,
 	}
 
-	if err := m.SaveProofExternalOwnedAccount(ctx, proof); err != nil {
+	if err := k.SaveProofExternalOwnedAccount(ctx, proof); err != nil {
 		panic(err)
 	}
 
-	return &vauthtypes.MsgSubmitProofExternalOwnedAccountResponse{}, nil
+	return nil
+}
+
+// chargeSubmitProofFee deducts the submission fee from the submitter and moves it to the fee destination,
+// as configured in the module params.
+func (k Keeper) chargeSubmitProofFee(ctx sdk.Context, msg *vauthtypes.MsgSubmitProofExternalOwnedAccount) error {
+	params := k.GetParams(ctx)
+
+	if !params.SubmitProofFee.IsPositive() {
+		return nil
+	}
+
+	if params.ExemptFeeSelfSubmission && msg.Submitter == msg.Account {
+		return nil
+	}
+
+	submitterAccAddr := sdk.MustAccAddressFromBech32(msg.Submitter)
+	fees := sdk.NewCoins(params.SubmitProofFee)
+
+	switch params.FeeDestination {
+	case vauthtypes.FEE_DESTINATION_BURN:
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitterAccAddr, vauthtypes.ModuleName, fees)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to deduct fee from submitter")
+		}
+		err = k.bankKeeper.BurnCoins(ctx, vauthtypes.ModuleName, fees)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to burn coin from module account: %s", vauthtypes.ModuleName)
+		}
+	case vauthtypes.FEE_DESTINATION_COMMUNITY_POOL:
+		err := k.distKeeper.FundCommunityPool(ctx, fees, submitterAccAddr)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to deduct fee from submitter")
+		}
+	case vauthtypes.FEE_DESTINATION_FEE_COLLECTOR:
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, submitterAccAddr, authtypes.FeeCollectorName, fees)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to deduct fee from submitter")
+		}
+	default:
+		return errorsmod.Wrapf(errors.ErrLogic, "unsupported fee destination: %s", params.FeeDestination)
+	}
+
+	return nil
 }
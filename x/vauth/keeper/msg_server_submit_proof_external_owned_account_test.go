@@ -1,19 +1,32 @@
 package keeper_test
 
 import (
+	"encoding/hex"
+
+	sdkmath "cosmossdk.io/math"
 	"github.com/EscanBE/evermint/constants"
 	"github.com/EscanBE/evermint/rename_chain/marker"
 	vauthkeeper "github.com/EscanBE/evermint/x/vauth/keeper"
 	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 //goland:noinspection SpellCheckingInspection
 func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
+	cost := vauthtypes.DefaultSubmitProofFeeAmount.Int64()
+
+	paramsWith := func(modifier func(params *vauthtypes.Params)) *vauthtypes.Params {
+		params := vauthtypes.DefaultParams()
+		modifier(&params)
+		return &params
+	}
+
 	tests := []struct {
 		name             string
 		msg              *vauthtypes.MsgSubmitProofExternalOwnedAccount
+		params           *vauthtypes.Params
 		submitterBalance int64
 		preRunFunc       func(s *KeeperTestSuite)
 		wantErr          bool
@@ -27,7 +40,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: cost,
 			wantErr:          false,
 			postRunFunc: func(s *KeeperTestSuite) {
 				s.Require().True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
@@ -48,7 +61,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: cost,
 			preRunFunc: func(s *KeeperTestSuite) {
 				err := s.keeper.SaveProofExternalOwnedAccount(s.ctx, vauthtypes.ProofExternalOwnedAccount{
 					Account:   s.accAddr.String(),
@@ -72,7 +85,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr("invalid"),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: cost,
 			wantErr:          true,
 			wantErrContains:  errors.ErrInvalidRequest.Error(),
 			postRunFunc: func(s *KeeperTestSuite) {
@@ -81,18 +94,232 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 			},
 		},
 		{
-			name: "fail - fail tx does not persist, submitter and account to prove are equals",
+			name: "pass - fee is burned",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			submitterBalance: cost,
+			wantErr:          false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+				s.True(s.bankKeeper.GetBalance(s.ctx, s.submitterAccAddr, constants.BaseDenom).IsZero())
+				s.True(s.bankKeeper.GetSupply(s.ctx, constants.BaseDenom).IsZero())
+			},
+		},
+		{
+			name: "pass - fee goes to community pool",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			params: paramsWith(func(params *vauthtypes.Params) {
+				params.FeeDestination = vauthtypes.FEE_DESTINATION_COMMUNITY_POOL
+			}),
+			submitterBalance: cost,
+			wantErr:          false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+				s.True(s.bankKeeper.GetBalance(s.ctx, s.submitterAccAddr, constants.BaseDenom).IsZero())
+
+				feePool, err := s.distKeeper.FeePool.Get(s.ctx)
+				s.Require().NoError(err)
+				s.Equal(sdkmath.NewInt(cost), feePool.CommunityPool.AmountOf(constants.BaseDenom).TruncateInt())
+			},
+		},
+		{
+			name: "pass - fee goes to fee collector",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			params: paramsWith(func(params *vauthtypes.Params) {
+				params.FeeDestination = vauthtypes.FEE_DESTINATION_FEE_COLLECTOR
+			}),
+			submitterBalance: cost,
+			wantErr:          false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+				s.True(s.bankKeeper.GetBalance(s.ctx, s.submitterAccAddr, constants.BaseDenom).IsZero())
+
+				feeCollector := s.authKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+				s.Equal(sdkmath.NewInt(cost), s.bankKeeper.GetBalance(s.ctx, feeCollector, constants.BaseDenom).Amount)
+			},
+		},
+		{
+			name: "pass - fee is charged by params",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			params: paramsWith(func(params *vauthtypes.Params) {
+				params.SubmitProofFee = sdk.NewInt64Coin(constants.BaseDenom, 1)
+			}),
+			submitterBalance: 3,
+			wantErr:          false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+				s.Equal(int64(2), s.bankKeeper.GetBalance(s.ctx, s.submitterAccAddr, constants.BaseDenom).Amount.Int64())
+			},
+		},
+		{
+			name: "pass - no fee charged when fee is zero",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			params: paramsWith(func(params *vauthtypes.Params) {
+				params.SubmitProofFee = sdk.NewInt64Coin(constants.BaseDenom, 0)
+			}),
+			submitterBalance: 0,
+			wantErr:          false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+		},
+		{
+			name: "pass - submitter can submit proof for itself, fee charged when not exempted",
 			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
 				Submitter: s.accAddr.String(),
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: cost,
+			wantErr:          false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+				s.True(s.bankKeeper.GetBalance(s.ctx, s.accAddr, constants.BaseDenom).IsZero())
+			},
+		},
+		{
+			name: "fail - self submission without fee exemption requires balance",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.accAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			submitterBalance: 0,
 			wantErr:          true,
-			wantErrContains:  errors.ErrInvalidRequest.Error(),
+			wantErrContains:  "failed to deduct fee from submitter",
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+		},
+		{
+			name: "pass - self submission is free when exempted",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.accAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			params: paramsWith(func(params *vauthtypes.Params) {
+				params.ExemptFeeSelfSubmission = true
+			}),
+			submitterBalance: 0,
+			wantErr:          false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+		},
+		{
+			name: "fail - exemption does not apply when submitter and account are different",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			params: paramsWith(func(params *vauthtypes.Params) {
+				params.ExemptFeeSelfSubmission = true
+			}),
+			submitterBalance: 0,
+			wantErr:          true,
+			wantErrContains:  "failed to deduct fee from submitter",
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+		},
+		{
+			name: "pass - can submit with expiry height",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter:    s.submitterAccAddr.String(),
+				Account:      s.accAddr.String(),
+				Signature:    s.SignToStr(vauthtypes.MessageToSign),
+				ExpiryHeight: 20,
+			},
+			submitterBalance: cost,
+			preRunFunc: func(s *KeeperTestSuite) {
+				s.ctx = s.ctx.WithBlockHeight(10)
+			},
+			wantErr: false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.Require().True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+				s.Equal(uint64(20), s.keeper.GetProofExternalOwnedAccount(s.ctx, s.accAddr).ExpiryHeight)
+
+				s.False(s.keeper.HasProofExternalOwnedAccount(s.ctx.WithBlockHeight(20), s.accAddr), "proof must be expired")
+			},
+		},
+		{
+			name: "fail - expiry height must be greater than current block height",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter:    s.submitterAccAddr.String(),
+				Account:      s.accAddr.String(),
+				Signature:    s.SignToStr(vauthtypes.MessageToSign),
+				ExpiryHeight: 10,
+			},
+			submitterBalance: cost,
+			preRunFunc: func(s *KeeperTestSuite) {
+				s.ctx = s.ctx.WithBlockHeight(10)
+			},
+			wantErr:         true,
+			wantErrContains: "expiry height must be greater than current block height",
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+		},
+		{
+			name: "pass - can submit again after the previous proof expired",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: s.SignToStr(vauthtypes.MessageToSign),
+			},
+			submitterBalance: cost,
+			preRunFunc: func(s *KeeperTestSuite) {
+				err := s.keeper.SaveProofExternalOwnedAccount(s.ctx, vauthtypes.ProofExternalOwnedAccount{
+					Account:      s.accAddr.String(),
+					Hash:         s.HashToStr(vauthtypes.MessageToSign),
+					Signature:    s.SignToStr(vauthtypes.MessageToSign),
+					ExpiryHeight: 5,
+				})
+				s.Require().NoError(err)
+
+				s.ctx = s.ctx.WithBlockHeight(10)
+				s.Require().False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+			},
+			wantErr: false,
+			postRunFunc: func(s *KeeperTestSuite) {
+				s.Require().True(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+				s.Zero(s.keeper.GetProofExternalOwnedAccount(s.ctx, s.accAddr).ExpiryHeight)
+			},
+		},
+		{
+			name: "fail - contract wallet proof requires the account to be a contract",
+			msg: &vauthtypes.MsgSubmitProofExternalOwnedAccount{
+				Submitter: s.submitterAccAddr.String(),
+				Account:   s.accAddr.String(),
+				Signature: "0x" + hex.EncodeToString([]byte("contract wallet signature")),
+				Kind:      vauthtypes.PROOF_KIND_EIP1271,
+			},
+			submitterBalance: cost,
+			wantErr:          true,
+			wantErrContains:  "account is not a contract",
 			postRunFunc: func(s *KeeperTestSuite) {
 				s.False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
-				s.Nil(s.keeper.GetProofExternalOwnedAccount(s.ctx, s.accAddr))
 			},
 		},
 		{
@@ -102,7 +329,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   marker.ReplaceAbleAddress("evm13zqksjwyjdvtzqjhed2m9r4xq0y8fvz79xjsqd"),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount,
+			submitterBalance: cost,
 			wantErr:          true,
 			wantErrContains:  errors.ErrInvalidRequest.Error(),
 			postRunFunc: func(s *KeeperTestSuite) {
@@ -117,7 +344,7 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 				Account:   s.accAddr.String(),
 				Signature: s.SignToStr(vauthtypes.MessageToSign),
 			},
-			submitterBalance: vauthkeeper.CostSubmitProofExternalOwnedAccount - 1,
+			submitterBalance: cost - 1,
 			wantErr:          true,
 			wantErrContains:  "failed to deduct fee from submitter",
 			postRunFunc: func(s *KeeperTestSuite) {
@@ -136,6 +363,10 @@ func (s *KeeperTestSuite) Test_msgServer_SubmitProofExternalOwnedAccount() {
 		s.Run(tt.name, func() {
 			s.RefreshContext()
 
+			if tt.params != nil {
+				s.Require().NoError(s.keeper.SetParams(s.ctx, *tt.params))
+			}
+
 			if tt.submitterBalance > 0 && tt.msg != nil {
 				if submitterAccAddr, err := sdk.AccAddressFromBech32(tt.msg.Submitter); err == nil {
 					s.mintToAccount(submitterAccAddr, sdk.NewCoins(sdk.NewInt64Coin(constants.BaseDenom, tt.submitterBalance)))
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"github.com/EscanBE/evermint/constants"
+	vauthkeeper "github.com/EscanBE/evermint/x/vauth/keeper"
+	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) Test_msgServer_UpdateParams() {
+	newParams := vauthtypes.Params{
+		SubmitProofFee:          sdk.NewInt64Coin(constants.BaseDenom, 1),
+		FeeDestination:          vauthtypes.FEE_DESTINATION_COMMUNITY_POOL,
+		ExemptFeeSelfSubmission: true,
+	}
+
+	tests := []struct {
+		name            string
+		msg             *vauthtypes.MsgUpdateParams
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - gov authority can update params",
+			msg: &vauthtypes.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				NewParams: newParams,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - reject non-gov authority",
+			msg: &vauthtypes.MsgUpdateParams{
+				Authority: s.submitterAccAddr.String(),
+				NewParams: newParams,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid authority",
+		},
+		{
+			name: "fail - reject invalid params",
+			msg: &vauthtypes.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				NewParams: vauthtypes.Params{
+					SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+					FeeDestination: vauthtypes.FEE_DESTINATION_UNSPECIFIED,
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "invalid fee destination",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.RefreshContext()
+
+			resp, err := vauthkeeper.NewMsgServerImpl(s.keeper).UpdateParams(s.ctx, tt.msg)
+
+			if tt.wantErr {
+				s.Require().ErrorContains(err, tt.wantErrContains)
+				s.Nil(resp)
+				s.Equal(vauthtypes.DefaultParams(), s.keeper.GetParams(s.ctx))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(resp)
+			s.Equal(tt.msg.NewParams, s.keeper.GetParams(s.ctx))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	storetypes "cosmossdk.io/store/types"
 	vauthtypes "github.com/EscanBE/evermint/x/vauth/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,7 +22,7 @@ func (k Keeper) SaveProofExternalOwnedAccount(ctx sdk.Context, proof vauthtypes.
 }
 
 // GetProofExternalOwnedAccount retrieves proof from KVStore.
-// It returns nil if not found
+// It returns nil if not found or the proof was expired at the current block height.
 func (k Keeper) GetProofExternalOwnedAccount(ctx sdk.Context, accAddr sdk.AccAddress) *vauthtypes.ProofExternalOwnedAccount {
 	store := ctx.KVStore(k.storeKey)
 	key := vauthtypes.KeyProofExternalOwnedAccountByAddress(accAddr)
@@ -33,12 +34,46 @@ func (k Keeper) GetProofExternalOwnedAccount(ctx sdk.Context, accAddr sdk.AccAdd
 	var proof vauthtypes.ProofExternalOwnedAccount
 	k.cdc.MustUnmarshal(bz, &proof)
 
+	if proof.IsExpired(ctx.BlockHeight()) {
+		return nil
+	}
+
 	return &proof
 }
 
-// HasProofExternalOwnedAccount check if proof of EOA of the account exists in KVStore.
+// HasProofExternalOwnedAccount check if a non-expired proof of EOA of the account exists in KVStore.
 func (k Keeper) HasProofExternalOwnedAccount(ctx sdk.Context, accAddr sdk.AccAddress) bool {
+	return k.GetProofExternalOwnedAccount(ctx, accAddr) != nil
+}
+
+// hasProofExternalOwnedAccountRecord check if a proof of EOA of the account exists in KVStore, regardless of expiry.
+func (k Keeper) hasProofExternalOwnedAccountRecord(ctx sdk.Context, accAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(vauthtypes.KeyProofExternalOwnedAccountByAddress(accAddr))
+}
+
+// DeleteProofExternalOwnedAccount removes proof of the account from KVStore.
+func (k Keeper) DeleteProofExternalOwnedAccount(ctx sdk.Context, accAddr sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	key := vauthtypes.KeyProofExternalOwnedAccountByAddress(accAddr)
-	return store.Has(key)
+	store.Delete(key)
+}
+
+// GetAllProofsExternalOwnedAccount returns all proofs from KVStore.
+func (k Keeper) GetAllProofsExternalOwnedAccount(ctx sdk.Context) []vauthtypes.ProofExternalOwnedAccount {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, vauthtypes.KeyPrefixProofExternalOwnedAccount)
+
+	var proofs []vauthtypes.ProofExternalOwnedAccount
+
+	defer func() {
+		_ = iterator.Close()
+	}()
+	for ; iterator.Valid(); iterator.Next() {
+		var proof vauthtypes.ProofExternalOwnedAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &proof)
+		proofs = append(proofs, proof)
+	}
+
+	return proofs
 }
@@ -37,4 +37,47 @@ func (s *KeeperTestSuite) TestKeeper_GetSaveHasProofExternalOwnedAccount() {
 		err := s.keeper.SaveProofExternalOwnedAccount(s.ctx, vauthtypes.ProofExternalOwnedAccount{})
 		s.Require().Error(err)
 	})
+
+	s.Run("get all - returns all proofs", func() {
+		proofs := s.keeper.GetAllProofsExternalOwnedAccount(s.ctx)
+		s.Require().Len(proofs, 1)
+		s.Equal(proof, proofs[0])
+	})
+
+	s.Run("delete - proof removed", func() {
+		s.keeper.DeleteProofExternalOwnedAccount(s.ctx, s.accAddr)
+		s.Nil(s.keeper.GetProofExternalOwnedAccount(s.ctx, s.accAddr))
+		s.False(s.keeper.HasProofExternalOwnedAccount(s.ctx, s.accAddr))
+		s.Empty(s.keeper.GetAllProofsExternalOwnedAccount(s.ctx))
+	})
+}
+
+//goland:noinspection SpellCheckingInspection
+func (s *KeeperTestSuite) TestKeeper_GetHasProofExternalOwnedAccount_Expiry() {
+	proof := vauthtypes.ProofExternalOwnedAccount{
+		Account:      s.accAddr.String(),
+		Hash:         s.HashToStr(vauthtypes.MessageToSign),
+		Signature:    s.SignToStr(vauthtypes.MessageToSign),
+		ExpiryHeight: 10,
+	}
+	s.Require().NoError(s.keeper.SaveProofExternalOwnedAccount(s.ctx, proof))
+
+	s.Run("before expiry height, proof is valid", func() {
+		ctx := s.ctx.WithBlockHeight(9)
+		s.True(s.keeper.HasProofExternalOwnedAccount(ctx, s.accAddr))
+		s.NotNil(s.keeper.GetProofExternalOwnedAccount(ctx, s.accAddr))
+	})
+
+	s.Run("from expiry height, proof is treated as not found", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+		s.False(s.keeper.HasProofExternalOwnedAccount(ctx, s.accAddr))
+		s.Nil(s.keeper.GetProofExternalOwnedAccount(ctx, s.accAddr))
+	})
+
+	s.Run("expired proof is still exported", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+		proofs := s.keeper.GetAllProofsExternalOwnedAccount(ctx)
+		s.Require().Len(proofs, 1)
+		s.Equal(proof, proofs[0])
+	})
 }
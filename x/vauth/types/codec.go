@@ -10,6 +10,8 @@ import (
 // RegisterCodec registers the necessary types and interfaces for the module
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmitProofExternalOwnedAccount{}, "vauth/SubmitProofExternalOwnedAccount", nil)
+	cdc.RegisterConcrete(&MsgRevokeProofExternalOwnedAccount{}, "vauth/RevokeProofExternalOwnedAccount", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "vauth/MsgUpdateParams", nil)
 }
 
 // RegisterInterfaces registers implementations by its interface, for the module
@@ -17,6 +19,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgSubmitProofExternalOwnedAccount{},
+		&MsgRevokeProofExternalOwnedAccount{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,12 +1,39 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{},
+		Params:                     DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (m GenesisState) Validate() error {
+	if err := m.Params.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid params")
+	}
+
+	uniqueAccounts := make(map[string]struct{})
+	for _, proof := range m.ProofsExternalOwnedAccount {
+		if err := proof.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid proof of account: %s", proof.Account)
+		}
+
+		account := sdk.MustAccAddressFromBech32(proof.Account).String()
+		if _, found := uniqueAccounts[account]; found {
+			return errorsmod.Wrapf(errors.ErrInvalidRequest, "duplicate proof of account: %s", proof.Account)
+		}
+		uniqueAccounts[account] = struct{}{}
+	}
+
 	return nil
 }
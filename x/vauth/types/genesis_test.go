@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/EscanBE/evermint/rename_chain/marker"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+//goland:noinspection SpellCheckingInspection
+func TestGenesisState_Validate(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA("fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19")
+	require.NoError(t, err)
+
+	signature, err := crypto.Sign(crypto.Keccak256([]byte(MessageToSign)), privateKey)
+	require.NoError(t, err)
+
+	validProof := ProofExternalOwnedAccount{
+		Account:   marker.ReplaceAbleAddress("evm1jcsksjwyjdvtzqjhed2m9r4xq0y8fvz7zqvgem"),
+		Hash:      "0x" + hex.EncodeToString(crypto.Keccak256([]byte(MessageToSign))),
+		Signature: "0x" + hex.EncodeToString(signature),
+	}
+
+	tests := []struct {
+		name            string
+		genesis         GenesisState
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "pass - default genesis",
+			genesis: *DefaultGenesis(),
+			wantErr: false,
+		},
+		{
+			name: "pass - valid proofs",
+			genesis: GenesisState{
+				Params:                     DefaultParams(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{validProof},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - duplicate proofs",
+			genesis: GenesisState{
+				Params:                     DefaultParams(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{validProof, validProof},
+			},
+			wantErr:         true,
+			wantErrContains: "duplicate proof of account",
+		},
+		{
+			name: "fail - mis-match signature",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{
+					func() ProofExternalOwnedAccount {
+						proof := validProof
+						proof.Account = marker.ReplaceAbleAddress("evm13zqksjwyjdvtzqjhed2m9r4xq0y8fvyg85jr6a")
+						return proof
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "mis-match signature",
+		},
+		{
+			name: "fail - invalid account",
+			genesis: GenesisState{
+				Params: DefaultParams(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{
+					func() ProofExternalOwnedAccount {
+						proof := validProof
+						proof.Account = "invalid"
+						return proof
+					}(),
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "account is not a valid bech32 account address",
+		},
+		{
+			name: "fail - invalid params",
+			genesis: GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.FeeDestination = FEE_DESTINATION_UNSPECIFIED
+					return params
+				}(),
+				ProofsExternalOwnedAccount: []ProofExternalOwnedAccount{validProof},
+			},
+			wantErr:         true,
+			wantErrContains: "invalid fee destination",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.genesis.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -16,9 +16,14 @@ const (
 // prefix bytes for the VAuth persistent store.
 const (
 	prefixProofExternalOwnedAccount = iota + 1
+	prefixParams
 )
 
-var KeyPrefixProofExternalOwnedAccount = []byte{prefixProofExternalOwnedAccount}
+// KVStore key prefixes
+var (
+	KeyPrefixProofExternalOwnedAccount = []byte{prefixProofExternalOwnedAccount}
+	KeyPrefixParams                    = []byte{prefixParams}
+)
 
 func KeyProofExternalOwnedAccountByAddress(accAddr sdk.AccAddress) []byte {
 	return append(KeyPrefixProofExternalOwnedAccount, accAddr.Bytes()...)
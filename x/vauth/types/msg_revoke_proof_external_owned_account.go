@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRevokeProofExternalOwnedAccount{}
+
+// ValidateBasic performs basic validation for the MsgRevokeProofExternalOwnedAccount.
+func (m *MsgRevokeProofExternalOwnedAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "account is not a valid bech32 account address: %s", m.Account)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers for the MsgRevokeProofExternalOwnedAccount.
+func (m *MsgRevokeProofExternalOwnedAccount) GetSigners() []sdk.AccAddress {
+	account, err := sdk.AccAddressFromBech32(m.Account)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{account}
+}
+
+// Route returns the message router key for the MsgRevokeProofExternalOwnedAccount.
+func (m *MsgRevokeProofExternalOwnedAccount) Route() string {
+	return RouterKey
+}
+
+// Type returns the message type for the MsgRevokeProofExternalOwnedAccount.
+func (m *MsgRevokeProofExternalOwnedAccount) Type() string {
+	return TypeMsgRevokeProofExternalOwnedAccount
+}
+
+// GetSignBytes returns the raw bytes for the MsgRevokeProofExternalOwnedAccount.
+func (m *MsgRevokeProofExternalOwnedAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
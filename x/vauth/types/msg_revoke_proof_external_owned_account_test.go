@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/EscanBE/evermint/rename_chain/marker"
+	"github.com/stretchr/testify/require"
+)
+
+//goland:noinspection SpellCheckingInspection
+func TestMsgRevokeProofExternalOwnedAccount_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name            string
+		msg             MsgRevokeProofExternalOwnedAccount
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid",
+			msg: MsgRevokeProofExternalOwnedAccount{
+				Account: marker.ReplaceAbleAddress("evm1jcsksjwyjdvtzqjhed2m9r4xq0y8fvz7zqvgem"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - invalid account",
+			msg: MsgRevokeProofExternalOwnedAccount{
+				Account: "invalid",
+			},
+			wantErr:         true,
+			wantErrContains: "account is not a valid bech32 account address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
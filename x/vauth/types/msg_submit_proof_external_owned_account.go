@@ -1,7 +1,6 @@
 package types
 
 import (
-	"bytes"
 	"encoding/hex"
 	"strings"
 
@@ -17,8 +16,7 @@ var _ sdk.Msg = &MsgSubmitProofExternalOwnedAccount{}
 
 // ValidateBasic performs basic validation for the MsgSubmitProofExternalOwnedAccount.
 func (m *MsgSubmitProofExternalOwnedAccount) ValidateBasic() error {
-	submitterAccAddr, err := sdk.AccAddressFromBech32(m.Submitter)
-	if err != nil {
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
 		return errorsmod.Wrapf(errors.ErrInvalidRequest, "submitter is not a valid bech32 account address: %s", m.Submitter)
 	}
 
@@ -27,10 +25,6 @@ func (m *MsgSubmitProofExternalOwnedAccount) ValidateBasic() error {
 		return errorsmod.Wrapf(errors.ErrInvalidRequest, "account to prove is not a valid bech32 account address: %s", m.Account)
 	}
 
-	if bytes.Equal(submitterAccAddr, accAddr) {
-		return errorsmod.Wrapf(errors.ErrInvalidRequest, "submitter and account to prove are equals: %s", m.Account)
-	}
-
 	if !strings.HasPrefix(m.Signature, "0x") {
 		return errorsmod.Wrap(errors.ErrInvalidRequest, "signature must starts with 0x")
 	}
@@ -40,6 +34,16 @@ func (m *MsgSubmitProofExternalOwnedAccount) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalidRequest, "bad signature")
 	}
 
+	switch m.Kind {
+	case PROOF_KIND_SECP256K1:
+		// verify below
+	case PROOF_KIND_EIP1271:
+		// signature of contract wallet can only be verified by calling the contract, by keeper
+		return nil
+	default:
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "invalid proof kind: %s", m.Kind)
+	}
+
 	verified, err := vauthutils.VerifySignature(common.BytesToAddress(accAddr), bzSignature, MessageToSign)
 	if err != nil {
 		return errorsmod.Wrap(errors.ErrInvalidRequest, "bad signature or mis-match")
@@ -31,6 +31,7 @@ func TestMsgSubmitProofExternalOwnedAccount_ValidateBasic(t *testing.T) {
 		submitter       string
 		address         string
 		signature       string
+		kind            ProofKind
 		wantErr         bool
 		wantErrContains string
 	}{
@@ -42,12 +43,37 @@ func TestMsgSubmitProofExternalOwnedAccount_ValidateBasic(t *testing.T) {
 			wantErr:   false,
 		},
 		{
-			name:            "fail - submitter and address must be different",
-			submitter:       addressBech32,
+			name:      "pass - submitter can submit proof for itself",
+			submitter: addressBech32,
+			address:   addressBech32,
+			signature: "0x" + hex.EncodeToString(signature(MessageToSign)),
+			wantErr:   false,
+		},
+		{
+			name:      "pass - contract wallet signature is not verified by ecrecover",
+			submitter: submitterBech32,
+			address:   marker.ReplaceAbleAddress("evm13zqksjwyjdvtzqjhed2m9r4xq0y8fvz79xjsqd"),
+			signature: "0x" + hex.EncodeToString([]byte("contract wallet signature")),
+			kind:      PROOF_KIND_EIP1271,
+			wantErr:   false,
+		},
+		{
+			name:            "fail - contract wallet, bad signature",
+			submitter:       submitterBech32,
+			address:         addressBech32,
+			signature:       "0x",
+			kind:            PROOF_KIND_EIP1271,
+			wantErr:         true,
+			wantErrContains: "bad signature",
+		},
+		{
+			name:            "fail - invalid proof kind",
+			submitter:       submitterBech32,
 			address:         addressBech32,
 			signature:       "0x" + hex.EncodeToString(signature(MessageToSign)),
+			kind:            ProofKind(99),
 			wantErr:         true,
-			wantErrContains: "submitter and account to prove are equals",
+			wantErrContains: "invalid proof kind",
 		},
 		{
 			name:            "fail - not address of the signature",
@@ -104,6 +130,7 @@ func TestMsgSubmitProofExternalOwnedAccount_ValidateBasic(t *testing.T) {
 				Submitter: tt.submitter,
 				Account:   tt.address,
 				Signature: tt.signature,
+				Kind:      tt.kind,
 			}
 
 			err := m.ValidateBasic()
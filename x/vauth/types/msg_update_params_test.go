@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/EscanBE/evermint/rename_chain/marker"
+	"github.com/stretchr/testify/require"
+)
+
+//goland:noinspection SpellCheckingInspection
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name            string
+		msg             MsgUpdateParams
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name: "pass - valid",
+			msg: MsgUpdateParams{
+				Authority: marker.ReplaceAbleAddress("evm13zqksjwyjdvtzqjhed2m9r4xq0y8fvyg85jr6a"),
+				NewParams: DefaultParams(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - invalid authority",
+			msg: MsgUpdateParams{
+				Authority: "invalid",
+				NewParams: DefaultParams(),
+			},
+			wantErr:         true,
+			wantErrContains: "authority is not a valid bech32 account address",
+		},
+		{
+			name: "fail - invalid params",
+			msg: MsgUpdateParams{
+				Authority: marker.ReplaceAbleAddress("evm13zqksjwyjdvtzqjhed2m9r4xq0y8fvyg85jr6a"),
+				NewParams: Params{},
+			},
+			wantErr:         true,
+			wantErrContains: "invalid submit proof fee",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,3 +2,9 @@ package types
 
 // TypeMsgSubmitProofExternalOwnedAccount is type for MsgSubmitProofExternalOwnedAccount.
 const TypeMsgSubmitProofExternalOwnedAccount = "submit_proof_external_owned_account"
+
+// TypeMsgRevokeProofExternalOwnedAccount is type for MsgRevokeProofExternalOwnedAccount.
+const TypeMsgRevokeProofExternalOwnedAccount = "revoke_proof_external_owned_account"
+
+// TypeMsgUpdateParams is type for MsgUpdateParams.
+const TypeMsgUpdateParams = "update_params"
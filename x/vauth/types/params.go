@@ -0,0 +1,106 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/EscanBE/evermint/constants"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultSubmitProofFeeAmount is the default fee amount for each submission of proof,
+// which is the hard-coded cost before the fee was moved into module params.
+var DefaultSubmitProofFeeAmount = sdkmath.NewInt(1e18)
+
+// DefaultParams returns the default params of the vauth module.
+// The submission fee is priced in the base denom, which is the default EVM denom,
+// like it was before the fee was moved into module params.
+func DefaultParams() Params {
+	return DefaultParamsWithFeeDenom(constants.BaseDenom)
+}
+
+// DefaultParamsWithFeeDenom returns the default params of the vauth module, with the submission fee priced in the given denom.
+func DefaultParamsWithFeeDenom(feeDenom string) Params {
+	return Params{
+		SubmitProofFee:          sdk.NewCoin(feeDenom, DefaultSubmitProofFeeAmount),
+		FeeDestination:          FEE_DESTINATION_BURN,
+		ExemptFeeSelfSubmission: false,
+		EoaProofRules:           DefaultEoaProofRules(),
+	}
+}
+
+// DefaultEoaProofRules returns the default rules, which requires the vesting account to be created for EOA only.
+// They are the rules hard-coded before the rules were moved into module params.
+func DefaultEoaProofRules() []EoaProofRule {
+	return []EoaProofRule{
+		{
+			MsgTypeUrl:   "/cosmos.vesting.v1beta1.MsgCreateVestingAccount",
+			AddressField: "to_address",
+		},
+		{
+			MsgTypeUrl:   "/cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccount",
+			AddressField: "to_address",
+		},
+		{
+			MsgTypeUrl:   "/cosmos.vesting.v1beta1.MsgCreatePermanentLockedAccount",
+			AddressField: "to_address",
+		},
+	}
+}
+
+// Validate performs basic validation of the params.
+func (m Params) Validate() error {
+	if err := m.SubmitProofFee.Validate(); err != nil {
+		return errorsmod.Wrapf(errors.Join(sdkerrors.ErrInvalidRequest, err), "invalid submit proof fee")
+	}
+
+	switch m.FeeDestination {
+	case FEE_DESTINATION_BURN, FEE_DESTINATION_COMMUNITY_POOL, FEE_DESTINATION_FEE_COLLECTOR:
+		// valid
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fee destination: %s", m.FeeDestination)
+	}
+
+	uniqueRules := make(map[string]struct{})
+	for i, rule := range m.EoaProofRules {
+		if err := rule.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid EOA proof rule #%d", i)
+		}
+
+		if _, found := uniqueRules[rule.Describe()]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated EOA proof rule #%d: %s", i, rule.Describe())
+		}
+		uniqueRules[rule.Describe()] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the rule.
+func (m EoaProofRule) Validate() error {
+	if !strings.HasPrefix(m.MsgTypeUrl, "/") || strings.TrimSpace(m.MsgTypeUrl) != m.MsgTypeUrl {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid message type url: %s", m.MsgTypeUrl)
+	}
+
+	if m.AddressField == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "address field cannot be empty")
+	}
+
+	for _, part := range strings.Split(m.AddressField, ".") {
+		if part == "" || strings.TrimSpace(part) != part {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid address field: %s", m.AddressField)
+		}
+	}
+
+	return nil
+}
+
+// Describe returns the human-readable representation of the rule, used as unique key and in error messages.
+func (m EoaProofRule) Describe() string {
+	return fmt.Sprintf("%s:%s", m.MsgTypeUrl, m.AddressField)
+}
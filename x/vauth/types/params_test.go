@@ -0,0 +1,168 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/EscanBE/evermint/constants"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		params          Params
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "pass - default params",
+			params:  DefaultParams(),
+			wantErr: false,
+		},
+		{
+			name: "pass - zero fee",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 0),
+				FeeDestination: FEE_DESTINATION_BURN,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - fee to community pool, exempt self submission",
+			params: Params{
+				SubmitProofFee:          sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination:          FEE_DESTINATION_COMMUNITY_POOL,
+				ExemptFeeSelfSubmission: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - fee to fee collector",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FEE_DESTINATION_FEE_COLLECTOR,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - invalid fee denom",
+			params: Params{
+				SubmitProofFee: sdk.Coin{Denom: "", Amount: sdkmath.OneInt()},
+				FeeDestination: FEE_DESTINATION_BURN,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid submit proof fee",
+		},
+		{
+			name: "fail - negative fee",
+			params: Params{
+				SubmitProofFee: sdk.Coin{Denom: constants.BaseDenom, Amount: sdkmath.NewInt(-1)},
+				FeeDestination: FEE_DESTINATION_BURN,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid submit proof fee",
+		},
+		{
+			name: "fail - unspecified fee destination",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FEE_DESTINATION_UNSPECIFIED,
+			},
+			wantErr:         true,
+			wantErrContains: "invalid fee destination",
+		},
+		{
+			name: "fail - unknown fee destination",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FeeDestination(99),
+			},
+			wantErr:         true,
+			wantErrContains: "invalid fee destination",
+		},
+		{
+			name: "pass - custom EOA proof rules",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FEE_DESTINATION_BURN,
+				EoaProofRules: []EoaProofRule{
+					{MsgTypeUrl: "/cosmos.staking.v1beta1.MsgCreateValidator", AddressField: "validator_address"},
+					{MsgTypeUrl: "/cosmos.authz.v1beta1.MsgGrant", AddressField: "grantee"},
+					{MsgTypeUrl: "/cosmos.authz.v1beta1.MsgGrant", AddressField: "granter"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass - no EOA proof rule",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FEE_DESTINATION_BURN,
+				EoaProofRules:  nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - EOA proof rule with invalid message type url",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FEE_DESTINATION_BURN,
+				EoaProofRules: []EoaProofRule{
+					{MsgTypeUrl: "cosmos.authz.v1beta1.MsgGrant", AddressField: "grantee"},
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "invalid message type url",
+		},
+		{
+			name: "fail - EOA proof rule with empty address field",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FEE_DESTINATION_BURN,
+				EoaProofRules: []EoaProofRule{
+					{MsgTypeUrl: "/cosmos.authz.v1beta1.MsgGrant", AddressField: ""},
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "address field cannot be empty",
+		},
+		{
+			name: "fail - EOA proof rule with bad nested address field",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FEE_DESTINATION_BURN,
+				EoaProofRules: []EoaProofRule{
+					{MsgTypeUrl: "/cosmos.authz.v1beta1.MsgGrant", AddressField: "grant..grantee"},
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "invalid address field",
+		},
+		{
+			name: "fail - duplicated EOA proof rule",
+			params: Params{
+				SubmitProofFee: sdk.NewInt64Coin(constants.BaseDenom, 1),
+				FeeDestination: FEE_DESTINATION_BURN,
+				EoaProofRules: []EoaProofRule{
+					{MsgTypeUrl: "/cosmos.authz.v1beta1.MsgGrant", AddressField: "grantee"},
+					{MsgTypeUrl: "/cosmos.authz.v1beta1.MsgGrant", AddressField: "grantee"},
+				},
+			},
+			wantErr:         true,
+			wantErrContains: "duplicated EOA proof rule #1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
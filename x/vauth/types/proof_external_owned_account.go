@@ -38,6 +38,16 @@ func (m *ProofExternalOwnedAccount) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalidRequest, "bad signature")
 	}
 
+	switch m.Kind {
+	case PROOF_KIND_SECP256K1:
+		// verify below
+	case PROOF_KIND_EIP1271:
+		// signature of contract wallet can only be verified by calling the contract, on submission or genesis import
+		return nil
+	default:
+		return errorsmod.Wrapf(errors.ErrInvalidRequest, "invalid proof kind: %s", m.Kind)
+	}
+
 	verified, err := vauthutils.VerifySignature(common.BytesToAddress(accAddr), bzSignature, MessageToSign)
 	if err != nil {
 		return errorsmod.Wrap(errors.ErrInvalidRequest, "bad signature or mis-match")
@@ -48,3 +58,8 @@ func (m *ProofExternalOwnedAccount) ValidateBasic() error {
 
 	return nil
 }
+
+// IsExpired returns true if the proof is no longer valid at the given block height.
+func (m ProofExternalOwnedAccount) IsExpired(height int64) bool {
+	return m.ExpiryHeight > 0 && uint64(height) >= m.ExpiryHeight
+}
@@ -32,6 +32,7 @@ func TestProofExternalOwnedAccount_ValidateBasic(t *testing.T) {
 		address         string
 		hash            string
 		signature       string
+		kind            ProofKind
 		wantErr         bool
 		wantErrContains string
 	}{
@@ -42,6 +43,23 @@ func TestProofExternalOwnedAccount_ValidateBasic(t *testing.T) {
 			signature: "0x" + hex.EncodeToString(signature(MessageToSign)),
 			wantErr:   false,
 		},
+		{
+			name:      "pass - contract wallet signature is not verified by ecrecover",
+			address:   marker.ReplaceAbleAddress("evm13zqksjwyjdvtzqjhed2m9r4xq0y8fvz79xjsqd"),
+			hash:      common.BytesToHash(crypto.Keccak256([]byte(MessageToSign))).String(),
+			signature: "0x" + hex.EncodeToString([]byte("contract wallet signature")),
+			kind:      PROOF_KIND_EIP1271,
+			wantErr:   false,
+		},
+		{
+			name:            "fail - invalid proof kind",
+			address:         addressBech32,
+			hash:            common.BytesToHash(crypto.Keccak256([]byte(MessageToSign))).String(),
+			signature:       "0x" + hex.EncodeToString(signature(MessageToSign)),
+			kind:            ProofKind(99),
+			wantErr:         true,
+			wantErrContains: "invalid proof kind",
+		},
 		{
 			name:            "fail - not address of the signature",
 			address:         marker.ReplaceAbleAddress("evm13zqksjwyjdvtzqjhed2m9r4xq0y8fvz79xjsqd"),
@@ -121,6 +139,7 @@ func TestProofExternalOwnedAccount_ValidateBasic(t *testing.T) {
 				Account:   tt.address,
 				Hash:      tt.hash,
 				Signature: tt.signature,
+				Kind:      tt.kind,
 			}
 
 			err := m.ValidateBasic()
@@ -136,6 +155,48 @@ func TestProofExternalOwnedAccount_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestProofExternalOwnedAccount_IsExpired(t *testing.T) {
+	tests := []struct {
+		name         string
+		expiryHeight uint64
+		height       int64
+		want         bool
+	}{
+		{
+			name:         "never expire",
+			expiryHeight: 0,
+			height:       1_000_000,
+			want:         false,
+		},
+		{
+			name:         "before expiry height",
+			expiryHeight: 10,
+			height:       9,
+			want:         false,
+		},
+		{
+			name:         "at expiry height",
+			expiryHeight: 10,
+			height:       10,
+			want:         true,
+		},
+		{
+			name:         "after expiry height",
+			expiryHeight: 10,
+			height:       11,
+			want:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := ProofExternalOwnedAccount{
+				ExpiryHeight: tt.expiryHeight,
+			}
+			require.Equal(t, tt.want, m.IsExpired(tt.height))
+		})
+	}
+}
+
 func init() {
 	cfg := sdk.GetConfig()
 	cmdcfg.SetBech32Prefixes(cfg)
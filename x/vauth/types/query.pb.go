@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -123,36 +124,240 @@ func (m *QueryProofExternalOwnedAccountResponse) GetProof() ProofExternalOwnedAc
 	return ProofExternalOwnedAccount{}
 }
 
+// QueryProofsExternalOwnedAccountRequest is the request type for the Query/ProofsExternalOwnedAccount RPC method.
+type QueryProofsExternalOwnedAccountRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProofsExternalOwnedAccountRequest) Reset() {
+	*m = QueryProofsExternalOwnedAccountRequest{}
+}
+func (m *QueryProofsExternalOwnedAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProofsExternalOwnedAccountRequest) ProtoMessage()    {}
+func (*QueryProofsExternalOwnedAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_466659dc6fde2434, []int{2}
+}
+func (m *QueryProofsExternalOwnedAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofsExternalOwnedAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofsExternalOwnedAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProofsExternalOwnedAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofsExternalOwnedAccountRequest.Merge(m, src)
+}
+func (m *QueryProofsExternalOwnedAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofsExternalOwnedAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofsExternalOwnedAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProofsExternalOwnedAccountRequest proto.InternalMessageInfo
+
+func (m *QueryProofsExternalOwnedAccountRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProofsExternalOwnedAccountResponse is the response type for the Query/ProofsExternalOwnedAccount RPC method.
+type QueryProofsExternalOwnedAccountResponse struct {
+	// proofs is the list of proofs that accounts are EOA
+	Proofs []ProofExternalOwnedAccount `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProofsExternalOwnedAccountResponse) Reset() {
+	*m = QueryProofsExternalOwnedAccountResponse{}
+}
+func (m *QueryProofsExternalOwnedAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProofsExternalOwnedAccountResponse) ProtoMessage()    {}
+func (*QueryProofsExternalOwnedAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_466659dc6fde2434, []int{3}
+}
+func (m *QueryProofsExternalOwnedAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofsExternalOwnedAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofsExternalOwnedAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProofsExternalOwnedAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofsExternalOwnedAccountResponse.Merge(m, src)
+}
+func (m *QueryProofsExternalOwnedAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofsExternalOwnedAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofsExternalOwnedAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProofsExternalOwnedAccountResponse proto.InternalMessageInfo
+
+func (m *QueryProofsExternalOwnedAccountResponse) GetProofs() []ProofExternalOwnedAccount {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func (m *QueryProofsExternalOwnedAccountResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest defines the request type for querying x/vauth module parameters.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_466659dc6fde2434, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for querying x/vauth module parameters.
+type QueryParamsResponse struct {
+	// params define the vauth module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_466659dc6fde2434, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryProofExternalOwnedAccountRequest)(nil), "evermint.vauth.v1.QueryProofExternalOwnedAccountRequest")
 	proto.RegisterType((*QueryProofExternalOwnedAccountResponse)(nil), "evermint.vauth.v1.QueryProofExternalOwnedAccountResponse")
+	proto.RegisterType((*QueryProofsExternalOwnedAccountRequest)(nil), "evermint.vauth.v1.QueryProofsExternalOwnedAccountRequest")
+	proto.RegisterType((*QueryProofsExternalOwnedAccountResponse)(nil), "evermint.vauth.v1.QueryProofsExternalOwnedAccountResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "evermint.vauth.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "evermint.vauth.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("evermint/vauth/v1/query.proto", fileDescriptor_466659dc6fde2434) }
 
 var fileDescriptor_466659dc6fde2434 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2d, 0x4b, 0x2d,
-	0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x4b, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x49, 0xeb, 0x81, 0xa5,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44, 0xa1,
-	0x94, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e,
-	0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x16, 0x8b, 0x2d, 0x10, 0xf3, 0xc0, 0xd2,
-	0x4a, 0x8e, 0x5c, 0xaa, 0x81, 0x20, 0x4b, 0x03, 0x8a, 0xf2, 0xf3, 0xd3, 0x5c, 0x2b, 0x4a, 0x52,
-	0x8b, 0xf2, 0x12, 0x73, 0xfc, 0xcb, 0xf3, 0x52, 0x53, 0x1c, 0x93, 0x93, 0xf3, 0x4b, 0xf3, 0x4a,
-	0x82, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x24, 0xb8, 0xd8, 0x13, 0x21, 0x22, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x30, 0xae, 0x52, 0x11, 0x97, 0x1a, 0x21, 0x23, 0x8a, 0x0b, 0xf2,
-	0xf3, 0x8a, 0x53, 0x85, 0x3c, 0xb8, 0x58, 0x0b, 0x40, 0x8a, 0xc0, 0x26, 0x70, 0x1b, 0xe9, 0xe8,
-	0x61, 0x78, 0x51, 0x0f, 0xa7, 0x21, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x0c, 0x30,
-	0xba, 0xcc, 0xc8, 0xc5, 0x0a, 0xb6, 0x54, 0xe8, 0x24, 0x23, 0x97, 0x24, 0x4e, 0x4d, 0x42, 0x16,
-	0x58, 0xac, 0x20, 0xca, 0xbf, 0x52, 0x96, 0x64, 0xe8, 0x84, 0x78, 0x53, 0xc9, 0xbc, 0xe9, 0xf2,
-	0x93, 0xc9, 0x4c, 0x86, 0x42, 0xfa, 0xfa, 0x98, 0x61, 0x0f, 0x76, 0x7e, 0x7c, 0x2a, 0x54, 0x7b,
-	0x7c, 0x3e, 0x48, 0x7f, 0x3c, 0x34, 0x24, 0x9d, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x33, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf,
-	0xb5, 0x38, 0x39, 0x31, 0xcf, 0xc9, 0x15, 0x61, 0x78, 0x05, 0xd4, 0xf8, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0x70, 0xc4, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x82, 0x45, 0xfe,
-	0x5f, 0x02, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0xc7, 0x56, 0x84, 0x39, 0x61, 0x76, 0x68, 0x03, 0x84, 0x11, 0x69, 0x1d, 0x20, 0x64,
+	0x93, 0x72, 0xd8, 0xe0, 0xb6, 0xa1, 0x02, 0xe2, 0x00, 0xa3, 0x47, 0x2e, 0x95, 0x1b, 0x4c, 0x16,
+	0x69, 0xf5, 0xcb, 0x62, 0x27, 0x6c, 0x1c, 0xf9, 0x05, 0x48, 0xfc, 0x00, 0xfe, 0x09, 0xe7, 0x71,
+	0x40, 0x9a, 0xc4, 0x85, 0x13, 0x42, 0x2d, 0x3f, 0x04, 0xc5, 0xf6, 0x58, 0xab, 0x35, 0xed, 0xd4,
+	0x9b, 0xfd, 0xde, 0xfb, 0xbe, 0xf7, 0x7d, 0xcf, 0x2f, 0xc1, 0xb7, 0x44, 0x21, 0xb2, 0x41, 0x22,
+	0x35, 0x2b, 0x78, 0xae, 0xf7, 0x58, 0x11, 0xb2, 0x83, 0x5c, 0x64, 0x47, 0x34, 0xcd, 0x40, 0x03,
+	0xb9, 0x76, 0x9a, 0xa6, 0x26, 0x4d, 0x8b, 0xd0, 0xbb, 0x1f, 0x81, 0x1a, 0x80, 0x62, 0x7d, 0xae,
+	0x84, 0xad, 0x65, 0x45, 0xd8, 0x17, 0x9a, 0x87, 0x2c, 0xe5, 0x71, 0x22, 0xb9, 0x4e, 0x40, 0x5a,
+	0xb8, 0xb7, 0x1a, 0x43, 0x0c, 0xe6, 0xc8, 0xca, 0x93, 0x8b, 0xde, 0x8c, 0x01, 0xe2, 0x7d, 0xc1,
+	0x78, 0x9a, 0x30, 0x2e, 0x25, 0x68, 0x03, 0x51, 0x2e, 0x3b, 0x45, 0x91, 0xed, 0x6d, 0xd2, 0xc1,
+	0x36, 0x5e, 0x7f, 0x53, 0x36, 0xdd, 0xcd, 0x00, 0xde, 0x77, 0x0e, 0xb5, 0xc8, 0x24, 0xdf, 0x7f,
+	0xfd, 0x41, 0x8a, 0x77, 0xdb, 0x51, 0x04, 0xb9, 0xd4, 0x5d, 0x71, 0x90, 0x0b, 0xa5, 0x49, 0x03,
+	0x5f, 0xe6, 0x36, 0xd2, 0x40, 0x6b, 0xe8, 0xee, 0x95, 0xee, 0xe9, 0x35, 0xc8, 0x70, 0x6b, 0x1e,
+	0x85, 0x4a, 0x41, 0x2a, 0x41, 0x5e, 0xe0, 0x95, 0xb4, 0x2c, 0x32, 0x0c, 0x57, 0xdb, 0x0f, 0xe8,
+	0xb9, 0x71, 0xd0, 0x4a, 0x92, 0x9d, 0xe5, 0xe3, 0xdf, 0xb7, 0x6b, 0x5d, 0x4b, 0x10, 0xa4, 0xe3,
+	0x3d, 0xd5, 0x2c, 0xdd, 0xcf, 0x30, 0x3e, 0x9b, 0xa3, 0x6b, 0xdc, 0xa2, 0x76, 0xe8, 0xb4, 0x1c,
+	0x3a, 0xb5, 0x0f, 0xe4, 0x86, 0x4e, 0x77, 0x79, 0x2c, 0x1c, 0xb6, 0x3b, 0x86, 0x0c, 0xbe, 0x21,
+	0xbc, 0x31, 0xb7, 0xa5, 0xf3, 0xf9, 0x12, 0xd7, 0x8d, 0x4c, 0xd5, 0x40, 0x6b, 0x97, 0x16, 0x34,
+	0xea, 0x18, 0xc8, 0xf3, 0x09, 0xfd, 0x4b, 0x46, 0xff, 0xc6, 0x5c, 0xfd, 0x56, 0xc8, 0x84, 0x81,
+	0x55, 0x4c, 0xac, 0x7e, 0x9e, 0xf1, 0x81, 0x72, 0x16, 0x83, 0x57, 0xf8, 0xfa, 0x44, 0xd4, 0x39,
+	0xd8, 0xc4, 0xf5, 0xd4, 0x44, 0xdc, 0xc4, 0x9a, 0xd3, 0x1c, 0x98, 0x82, 0xff, 0x72, 0xcd, 0xad,
+	0xfd, 0x75, 0x19, 0xaf, 0x18, 0x42, 0xf2, 0x1d, 0xe1, 0x66, 0xa5, 0x49, 0xb2, 0x35, 0x85, 0xf0,
+	0x42, 0x8b, 0xe8, 0x3d, 0x5e, 0x00, 0x69, 0x5d, 0x05, 0x9b, 0x9f, 0x7e, 0xfe, 0xfd, 0xb2, 0x14,
+	0x12, 0xc6, 0xce, 0x7f, 0x14, 0x66, 0xdc, 0x3d, 0xe1, 0xe0, 0x3d, 0x28, 0xf1, 0x3d, 0xb7, 0xe2,
+	0xe4, 0x07, 0xc2, 0x5e, 0xf5, 0xbb, 0x93, 0xd9, 0x92, 0x66, 0xad, 0xa7, 0xf7, 0x64, 0x11, 0xa8,
+	0xb3, 0xb3, 0x65, 0xec, 0xb4, 0xc9, 0xc3, 0x2a, 0x3b, 0xaa, 0xca, 0xcf, 0x47, 0x5c, 0xb7, 0xaf,
+	0x47, 0xd6, 0x2b, 0xfb, 0x8f, 0xaf, 0x89, 0xd7, 0x9a, 0x57, 0xe6, 0x24, 0xdd, 0x31, 0x92, 0x6e,
+	0x90, 0xe6, 0x34, 0x49, 0x76, 0x5f, 0x9e, 0x1e, 0x0f, 0x7d, 0x74, 0x32, 0xf4, 0xd1, 0x9f, 0xa1,
+	0x8f, 0x3e, 0x8f, 0xfc, 0xda, 0xc9, 0xc8, 0xaf, 0xfd, 0x1a, 0xf9, 0xb5, 0xb7, 0xf7, 0xe2, 0x44,
+	0xef, 0xe5, 0x7d, 0x1a, 0xc1, 0x80, 0x75, 0x54, 0xc4, 0xe5, 0x4e, 0xe7, 0x8c, 0xe6, 0xd0, 0x11,
+	0xe9, 0xa3, 0x54, 0xa8, 0x7e, 0xdd, 0xfc, 0xbd, 0x1e, 0xfd, 0x1b, 0x00, 0x72, 0x64, 0x86, 0x09,
+	0x70, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -169,6 +374,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// ProofExternalOwnedAccount returns proof of external owned account (EOA)
 	ProofExternalOwnedAccount(ctx context.Context, in *QueryProofExternalOwnedAccountRequest, opts ...grpc.CallOption) (*QueryProofExternalOwnedAccountResponse, error)
+	// ProofsExternalOwnedAccount returns all proofs of external owned account (EOA)
+	ProofsExternalOwnedAccount(ctx context.Context, in *QueryProofsExternalOwnedAccountRequest, opts ...grpc.CallOption) (*QueryProofsExternalOwnedAccountResponse, error)
+	// Params queries the parameters of x/vauth module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -188,10 +397,32 @@ func (c *queryClient) ProofExternalOwnedAccount(ctx context.Context, in *QueryPr
 	return out, nil
 }
 
+func (c *queryClient) ProofsExternalOwnedAccount(ctx context.Context, in *QueryProofsExternalOwnedAccountRequest, opts ...grpc.CallOption) (*QueryProofsExternalOwnedAccountResponse, error) {
+	out := new(QueryProofsExternalOwnedAccountResponse)
+	err := c.cc.Invoke(ctx, "/evermint.vauth.v1.Query/ProofsExternalOwnedAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/evermint.vauth.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ProofExternalOwnedAccount returns proof of external owned account (EOA)
 	ProofExternalOwnedAccount(context.Context, *QueryProofExternalOwnedAccountRequest) (*QueryProofExternalOwnedAccountResponse, error)
+	// ProofsExternalOwnedAccount returns all proofs of external owned account (EOA)
+	ProofsExternalOwnedAccount(context.Context, *QueryProofsExternalOwnedAccountRequest) (*QueryProofsExternalOwnedAccountResponse, error)
+	// Params queries the parameters of x/vauth module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -201,6 +432,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) ProofExternalOwnedAccount(ctx context.Context, req *QueryProofExternalOwnedAccountRequest) (*QueryProofExternalOwnedAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProofExternalOwnedAccount not implemented")
 }
+func (*UnimplementedQueryServer) ProofsExternalOwnedAccount(ctx context.Context, req *QueryProofsExternalOwnedAccountRequest) (*QueryProofsExternalOwnedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProofsExternalOwnedAccount not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -224,6 +461,43 @@ func _Query_ProofExternalOwnedAccount_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProofsExternalOwnedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProofsExternalOwnedAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProofsExternalOwnedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evermint.vauth.v1.Query/ProofsExternalOwnedAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProofsExternalOwnedAccount(ctx, req.(*QueryProofsExternalOwnedAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evermint.vauth.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evermint.vauth.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -232,6 +506,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProofExternalOwnedAccount",
 			Handler:    _Query_ProofExternalOwnedAccount_Handler,
 		},
+		{
+			MethodName: "ProofsExternalOwnedAccount",
+			Handler:    _Query_ProofsExternalOwnedAccount_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evermint/vauth/v1/query.proto",
@@ -300,6 +582,146 @@ func (m *QueryProofExternalOwnedAccountResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProofsExternalOwnedAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofsExternalOwnedAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofsExternalOwnedAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofsExternalOwnedAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofsExternalOwnedAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofsExternalOwnedAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -335,7 +757,59 @@ func (m *QueryProofExternalOwnedAccountResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryProofsExternalOwnedAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProofsExternalOwnedAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
@@ -506,6 +980,345 @@ func (m *QueryProofExternalOwnedAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProofsExternalOwnedAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProofsExternalOwnedAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProofsExternalOwnedAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProofsExternalOwnedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProofsExternalOwnedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProofsExternalOwnedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, ProofExternalOwnedAccount{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -39,6 +39,10 @@ type MsgSubmitProofExternalOwnedAccount struct {
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	// signature is the Ethereum signed message, used to proof that the account is EOA
 	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// expiry_height is the block height from which the proof is no longer valid, zero means never expire
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// kind is the kind of the proof, defines how the signature is verified
+	Kind ProofKind `protobuf:"varint,5,opt,name=kind,proto3,enum=evermint.vauth.v1.ProofKind" json:"kind,omitempty"`
 }
 
 func (m *MsgSubmitProofExternalOwnedAccount) Reset()         { *m = MsgSubmitProofExternalOwnedAccount{} }
@@ -95,6 +99,20 @@ func (m *MsgSubmitProofExternalOwnedAccount) GetSignature() string {
 	return ""
 }
 
+func (m *MsgSubmitProofExternalOwnedAccount) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgSubmitProofExternalOwnedAccount) GetKind() ProofKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PROOF_KIND_SECP256K1
+}
+
 // MsgSubmitProofExternalOwnedAccountResponse returns no fields
 type MsgSubmitProofExternalOwnedAccountResponse struct {
 }
@@ -136,38 +154,236 @@ func (m *MsgSubmitProofExternalOwnedAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitProofExternalOwnedAccountResponse proto.InternalMessageInfo
 
+// MsgRevokeProofExternalOwnedAccount defines a Msg to revoke EOA proof
+type MsgRevokeProofExternalOwnedAccount struct {
+	// account is the cosmos bech32 address of the account to revoke proof, must be the signer
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *MsgRevokeProofExternalOwnedAccount) Reset()         { *m = MsgRevokeProofExternalOwnedAccount{} }
+func (m *MsgRevokeProofExternalOwnedAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeProofExternalOwnedAccount) ProtoMessage()    {}
+func (*MsgRevokeProofExternalOwnedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74b474a4acdc4171, []int{2}
+}
+func (m *MsgRevokeProofExternalOwnedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeProofExternalOwnedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeProofExternalOwnedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeProofExternalOwnedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeProofExternalOwnedAccount.Merge(m, src)
+}
+func (m *MsgRevokeProofExternalOwnedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeProofExternalOwnedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeProofExternalOwnedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeProofExternalOwnedAccount proto.InternalMessageInfo
+
+func (m *MsgRevokeProofExternalOwnedAccount) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// MsgRevokeProofExternalOwnedAccountResponse returns no fields
+type MsgRevokeProofExternalOwnedAccountResponse struct {
+}
+
+func (m *MsgRevokeProofExternalOwnedAccountResponse) Reset() {
+	*m = MsgRevokeProofExternalOwnedAccountResponse{}
+}
+func (m *MsgRevokeProofExternalOwnedAccountResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRevokeProofExternalOwnedAccountResponse) ProtoMessage() {}
+func (*MsgRevokeProofExternalOwnedAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74b474a4acdc4171, []int{3}
+}
+func (m *MsgRevokeProofExternalOwnedAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeProofExternalOwnedAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeProofExternalOwnedAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeProofExternalOwnedAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeProofExternalOwnedAccountResponse.Merge(m, src)
+}
+func (m *MsgRevokeProofExternalOwnedAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeProofExternalOwnedAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeProofExternalOwnedAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeProofExternalOwnedAccountResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a Msg for updating the x/vauth module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// new_params for the x/vauth module.
+	NewParams Params `protobuf:"bytes,2,opt,name=new_params,json=newParams,proto3" json:"new_params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74b474a4acdc4171, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetNewParams() Params {
+	if m != nil {
+		return m.NewParams
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74b474a4acdc4171, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProofExternalOwnedAccount)(nil), "evermint.vauth.v1.MsgSubmitProofExternalOwnedAccount")
 	proto.RegisterType((*MsgSubmitProofExternalOwnedAccountResponse)(nil), "evermint.vauth.v1.MsgSubmitProofExternalOwnedAccountResponse")
+	proto.RegisterType((*MsgRevokeProofExternalOwnedAccount)(nil), "evermint.vauth.v1.MsgRevokeProofExternalOwnedAccount")
+	proto.RegisterType((*MsgRevokeProofExternalOwnedAccountResponse)(nil), "evermint.vauth.v1.MsgRevokeProofExternalOwnedAccountResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "evermint.vauth.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evermint.vauth.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("evermint/vauth/v1/tx.proto", fileDescriptor_74b474a4acdc4171) }
 
 var fileDescriptor_74b474a4acdc4171 = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x41, 0x4b, 0xeb, 0x40,
-	0x10, 0xc7, 0xbb, 0x2d, 0xbc, 0x47, 0xf7, 0xf0, 0xe0, 0x05, 0xc1, 0x18, 0x4a, 0x94, 0x9c, 0xb4,
-	0x48, 0x96, 0x2a, 0x1e, 0x54, 0x3c, 0xb4, 0xd2, 0x63, 0x51, 0xea, 0xcd, 0x4b, 0xd8, 0xc6, 0xed,
-	0x36, 0xd0, 0xec, 0x84, 0xec, 0x26, 0xd6, 0x6b, 0x8f, 0x9e, 0x04, 0xbf, 0x88, 0x1f, 0xc3, 0x63,
-	0xc1, 0x8b, 0x78, 0x92, 0x54, 0xf0, 0x6b, 0x48, 0xb2, 0x09, 0x3d, 0x28, 0x14, 0xbc, 0xed, 0xcc,
-	0x6f, 0xe6, 0x3f, 0xb3, 0xf3, 0xc7, 0x16, 0x4b, 0x59, 0x1c, 0x06, 0x42, 0x91, 0x94, 0x26, 0x6a,
-	0x42, 0xd2, 0x0e, 0x51, 0x33, 0x37, 0x8a, 0x41, 0x81, 0xf1, 0xbf, 0x62, 0x6e, 0xc1, 0xdc, 0xb4,
-	0x63, 0x6d, 0x70, 0xe0, 0x50, 0x50, 0x92, 0xbf, 0x74, 0xa1, 0xd5, 0xe2, 0x00, 0x7c, 0xca, 0x08,
-	0x8d, 0x02, 0x42, 0x85, 0x00, 0x45, 0x55, 0x00, 0x42, 0x96, 0x74, 0xd3, 0x07, 0x19, 0x82, 0x24,
-	0xa1, 0xe4, 0xb9, 0x7c, 0x28, 0x79, 0x09, 0xb6, 0x34, 0xf0, 0xb4, 0x9e, 0x0e, 0x34, 0x72, 0xee,
-	0x11, 0x76, 0x06, 0x92, 0x5f, 0x25, 0xa3, 0x30, 0x50, 0x97, 0x31, 0xc0, 0xb8, 0x3f, 0x53, 0x2c,
-	0x16, 0x74, 0x7a, 0x71, 0x2b, 0xd8, 0x4d, 0xd7, 0xf7, 0x21, 0x11, 0xca, 0x68, 0xe1, 0xa6, 0x2c,
-	0x4a, 0x14, 0x8b, 0x4d, 0xb4, 0x83, 0x76, 0x9b, 0xc3, 0x55, 0xc2, 0x30, 0xf1, 0x5f, 0xaa, 0x0b,
-	0xcd, 0x7a, 0xc1, 0xaa, 0xb0, 0xe8, 0x0b, 0xb8, 0xa0, 0x2a, 0x89, 0x99, 0xd9, 0x28, 0xfb, 0xaa,
-	0xc4, 0xc9, 0xbf, 0xf9, 0xe7, 0x53, 0x7b, 0xa5, 0xe3, 0xec, 0xe3, 0xf6, 0xfa, 0x5d, 0x86, 0x4c,
-	0x46, 0x20, 0x24, 0x3b, 0xc8, 0x10, 0x6e, 0x0c, 0x24, 0x37, 0xde, 0x10, 0xde, 0x5e, 0xb7, 0xff,
-	0x91, 0xfb, 0xed, 0xc4, 0xee, 0xfa, 0x51, 0xd6, 0xd9, 0xaf, 0xda, 0xaa, 0x0d, 0x9d, 0xee, 0xfc,
-	0xe5, 0xe3, 0xb1, 0x7e, 0x6a, 0x1c, 0x93, 0x9f, 0xcc, 0x27, 0xfa, 0xe3, 0xb9, 0x2b, 0x30, 0xf6,
-	0x58, 0x29, 0xe4, 0x41, 0xae, 0xe4, 0x95, 0x07, 0xec, 0x9d, 0x3f, 0x67, 0x36, 0x5a, 0x64, 0x36,
-	0x7a, 0xcf, 0x6c, 0xf4, 0xb0, 0xb4, 0x6b, 0x8b, 0xa5, 0x5d, 0x7b, 0x5d, 0xda, 0xb5, 0xeb, 0x3d,
-	0x1e, 0xa8, 0x49, 0x32, 0x72, 0x7d, 0x08, 0x49, 0x5f, 0xfa, 0x54, 0xf4, 0xfa, 0xab, 0x31, 0xb3,
-	0x72, 0x90, 0xba, 0x8b, 0x98, 0x1c, 0xfd, 0x29, 0xbc, 0x3e, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0x48, 0xb3, 0xcb, 0xf1, 0x84, 0x02, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0xb4, 0xd1, 0x92, 0xb1, 0x56, 0x5c, 0x0a, 0xdd, 0x2e, 0x75, 0x5b, 0xd6, 0x4b, 0x0c,
+	0xba, 0x6b, 0x23, 0x0a, 0x56, 0x2a, 0x24, 0x12, 0x10, 0x34, 0x58, 0xb6, 0x78, 0xf1, 0xe0, 0x32,
+	0xc9, 0x4e, 0x27, 0x43, 0xbb, 0x33, 0xcb, 0xce, 0xe4, 0xdf, 0xb5, 0x9f, 0x40, 0xf0, 0x20, 0x7e,
+	0x0b, 0x0f, 0x7e, 0x88, 0x1e, 0x8b, 0x5e, 0x44, 0x44, 0x34, 0x11, 0xfc, 0x1a, 0xb2, 0xb3, 0xbb,
+	0x49, 0x6a, 0x53, 0x16, 0x7a, 0xdb, 0x79, 0xbf, 0xdf, 0xfb, 0xbd, 0xf7, 0x7e, 0xf3, 0x66, 0xa1,
+	0x81, 0x7b, 0x38, 0x0a, 0x28, 0x93, 0x4e, 0x0f, 0x75, 0x65, 0xc7, 0xe9, 0x6d, 0x3b, 0x72, 0x60,
+	0x87, 0x11, 0x97, 0x5c, 0xbb, 0x99, 0x61, 0xb6, 0xc2, 0xec, 0xde, 0xb6, 0xb1, 0x4a, 0x38, 0xe1,
+	0x0a, 0x75, 0xe2, 0xaf, 0x84, 0x68, 0x6c, 0x10, 0xce, 0xc9, 0x11, 0x76, 0x50, 0x48, 0x1d, 0xc4,
+	0x18, 0x97, 0x48, 0x52, 0xce, 0x44, 0x8a, 0xae, 0xb5, 0xb9, 0x08, 0xb8, 0x70, 0x02, 0x41, 0x62,
+	0xf9, 0x40, 0x90, 0x14, 0x58, 0x4f, 0x00, 0x2f, 0xd1, 0x4b, 0x0e, 0x29, 0x74, 0xeb, 0x7c, 0x5b,
+	0x49, 0x0f, 0x0a, 0xb6, 0x7e, 0x03, 0x68, 0x35, 0x05, 0xd9, 0xef, 0xb6, 0x02, 0x2a, 0xf7, 0x22,
+	0xce, 0x0f, 0x1a, 0x03, 0x89, 0x23, 0x86, 0x8e, 0x5e, 0xf5, 0x19, 0xf6, 0x6b, 0xed, 0x36, 0xef,
+	0x32, 0xa9, 0x6d, 0xc0, 0x92, 0x50, 0x14, 0x89, 0x23, 0x1d, 0x6c, 0x81, 0x72, 0xc9, 0x9d, 0x06,
+	0x34, 0x1d, 0x2e, 0xa1, 0x84, 0xa8, 0x2f, 0x28, 0x6c, 0x09, 0xcd, 0xe4, 0x51, 0xc2, 0x90, 0xec,
+	0x46, 0x58, 0x5f, 0x4c, 0xf3, 0xb2, 0x80, 0x76, 0x1b, 0x5e, 0xc7, 0x83, 0x90, 0x46, 0x43, 0xaf,
+	0x83, 0x29, 0xe9, 0x48, 0xbd, 0xb8, 0x05, 0xca, 0x45, 0x77, 0x39, 0x09, 0x3e, 0x57, 0x31, 0xed,
+	0x3e, 0x2c, 0x1e, 0x52, 0xe6, 0xeb, 0x57, 0xb6, 0x40, 0x79, 0xa5, 0xba, 0x61, 0x9f, 0xb3, 0xd2,
+	0x56, 0x6d, 0xbf, 0xa0, 0xcc, 0x77, 0x15, 0x73, 0x67, 0xe5, 0xf8, 0xef, 0xa7, 0xca, 0xb4, 0x3d,
+	0xeb, 0x2e, 0xac, 0xe4, 0x8f, 0xe8, 0x62, 0x11, 0x72, 0x26, 0xb0, 0xf5, 0x52, 0x19, 0xe2, 0xe2,
+	0x1e, 0x3f, 0xc4, 0x17, 0x1b, 0x32, 0x33, 0x32, 0x38, 0x33, 0xf2, 0xce, 0x72, 0x5c, 0x3d, 0x3b,
+	0xa5, 0xb5, 0x73, 0xd4, 0x26, 0xb5, 0x3f, 0x02, 0x78, 0xa3, 0x29, 0xc8, 0xeb, 0xd0, 0x47, 0x12,
+	0xef, 0xa1, 0x08, 0x05, 0x42, 0x7b, 0x04, 0x4b, 0xf1, 0xa0, 0x3c, 0xa2, 0x72, 0x98, 0xd4, 0xaa,
+	0xeb, 0x5f, 0x3e, 0xdf, 0x5b, 0x4d, 0x6f, 0xb9, 0xe6, 0xfb, 0x11, 0x16, 0x62, 0x5f, 0x46, 0x94,
+	0x11, 0x77, 0x4a, 0xd5, 0x9e, 0x42, 0xc8, 0x70, 0xdf, 0x0b, 0x95, 0x8a, 0xba, 0x97, 0x6b, 0xd5,
+	0xf5, 0x79, 0xee, 0x29, 0x42, 0xbd, 0x78, 0xf2, 0x73, 0xb3, 0xe0, 0x96, 0x18, 0xee, 0x27, 0x81,
+	0xd4, 0xc5, 0x89, 0x9e, 0xb5, 0x0e, 0xd7, 0xfe, 0x6b, 0x2d, 0x6b, 0xbb, 0xfa, 0x63, 0x11, 0x2e,
+	0x36, 0x05, 0xd1, 0xbe, 0x03, 0xb8, 0x99, 0xb7, 0x49, 0x0f, 0xe7, 0xb4, 0x90, 0x7f, 0x3b, 0xc6,
+	0xee, 0xa5, 0xd2, 0x26, 0xc6, 0xd6, 0x8e, 0xbf, 0xfe, 0x79, 0xbf, 0xf0, 0x44, 0x7b, 0xec, 0xcc,
+	0x7b, 0xa5, 0x4e, 0xb2, 0x2b, 0xf1, 0xf3, 0xe1, 0x07, 0x1e, 0x4e, 0x85, 0x3c, 0x1e, 0x2b, 0x79,
+	0xd9, 0x2a, 0x7f, 0x00, 0x70, 0x33, 0x6f, 0x2b, 0x2e, 0x18, 0x2e, 0x27, 0xcd, 0xd8, 0xbd, 0x54,
+	0x5a, 0x36, 0x9c, 0xf6, 0x16, 0x2e, 0x9f, 0xd9, 0x18, 0x6b, 0xbe, 0xdc, 0x2c, 0xc7, 0xa8, 0xe4,
+	0x73, 0x32, 0xfd, 0xfa, 0xb3, 0x93, 0x91, 0x09, 0x4e, 0x47, 0x26, 0xf8, 0x35, 0x32, 0xc1, 0xbb,
+	0xb1, 0x59, 0x38, 0x1d, 0x9b, 0x85, 0x6f, 0x63, 0xb3, 0xf0, 0xe6, 0x0e, 0xa1, 0xb2, 0xd3, 0x6d,
+	0xd9, 0x6d, 0x1e, 0x38, 0x0d, 0xd1, 0x46, 0xac, 0xde, 0x98, 0x1a, 0x3c, 0x48, 0x2d, 0x96, 0xc3,
+	0x10, 0x8b, 0xd6, 0x55, 0xf5, 0xbf, 0x79, 0xf0, 0x6f, 0x00, 0x49, 0x4b, 0x72, 0x20, 0x27, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -184,6 +400,11 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// SubmitProofExternalOwnedAccount submit proof that an account is external owned account (EOA)
 	SubmitProofExternalOwnedAccount(ctx context.Context, in *MsgSubmitProofExternalOwnedAccount, opts ...grpc.CallOption) (*MsgSubmitProofExternalOwnedAccountResponse, error)
+	// RevokeProofExternalOwnedAccount revokes the proof of an account, signed by the account itself
+	RevokeProofExternalOwnedAccount(ctx context.Context, in *MsgRevokeProofExternalOwnedAccount, opts ...grpc.CallOption) (*MsgRevokeProofExternalOwnedAccountResponse, error)
+	// UpdateParams defined a governance operation for updating the x/vauth module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -203,10 +424,33 @@ func (c *msgClient) SubmitProofExternalOwnedAccount(ctx context.Context, in *Msg
 	return out, nil
 }
 
+func (c *msgClient) RevokeProofExternalOwnedAccount(ctx context.Context, in *MsgRevokeProofExternalOwnedAccount, opts ...grpc.CallOption) (*MsgRevokeProofExternalOwnedAccountResponse, error) {
+	out := new(MsgRevokeProofExternalOwnedAccountResponse)
+	err := c.cc.Invoke(ctx, "/evermint.vauth.v1.Msg/RevokeProofExternalOwnedAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/evermint.vauth.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProofExternalOwnedAccount submit proof that an account is external owned account (EOA)
 	SubmitProofExternalOwnedAccount(context.Context, *MsgSubmitProofExternalOwnedAccount) (*MsgSubmitProofExternalOwnedAccountResponse, error)
+	// RevokeProofExternalOwnedAccount revokes the proof of an account, signed by the account itself
+	RevokeProofExternalOwnedAccount(context.Context, *MsgRevokeProofExternalOwnedAccount) (*MsgRevokeProofExternalOwnedAccountResponse, error)
+	// UpdateParams defined a governance operation for updating the x/vauth module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -216,6 +460,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitProofExternalOwnedAccount(ctx context.Context, req *MsgSubmitProofExternalOwnedAccount) (*MsgSubmitProofExternalOwnedAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofExternalOwnedAccount not implemented")
 }
+func (*UnimplementedMsgServer) RevokeProofExternalOwnedAccount(ctx context.Context, req *MsgRevokeProofExternalOwnedAccount) (*MsgRevokeProofExternalOwnedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeProofExternalOwnedAccount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -239,6 +489,43 @@ func _Msg_SubmitProofExternalOwnedAccount_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeProofExternalOwnedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeProofExternalOwnedAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeProofExternalOwnedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evermint.vauth.v1.Msg/RevokeProofExternalOwnedAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeProofExternalOwnedAccount(ctx, req.(*MsgRevokeProofExternalOwnedAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evermint.vauth.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evermint.vauth.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -247,6 +534,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitProofExternalOwnedAccount",
 			Handler:    _Msg_SubmitProofExternalOwnedAccount_Handler,
 		},
+		{
+			MethodName: "RevokeProofExternalOwnedAccount",
+			Handler:    _Msg_RevokeProofExternalOwnedAccount_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evermint/vauth/v1/tx.proto",
@@ -272,6 +567,16 @@ func (m *MsgSubmitProofExternalOwnedAccount) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -319,6 +624,122 @@ func (m *MsgSubmitProofExternalOwnedAccountResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeProofExternalOwnedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeProofExternalOwnedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeProofExternalOwnedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeProofExternalOwnedAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeProofExternalOwnedAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeProofExternalOwnedAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -348,6 +769,12 @@ func (m *MsgSubmitProofExternalOwnedAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovTx(uint64(m.Kind))
+	}
 	return n
 }
 
@@ -360,9 +787,55 @@ func (m *MsgSubmitProofExternalOwnedAccountResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *MsgRevokeProofExternalOwnedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeProofExternalOwnedAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -491,6 +964,44 @@ func (m *MsgSubmitProofExternalOwnedAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ProofKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -562,6 +1073,303 @@ func (m *MsgSubmitProofExternalOwnedAccountResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
+func (m *MsgRevokeProofExternalOwnedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeProofExternalOwnedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeProofExternalOwnedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeProofExternalOwnedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeProofExternalOwnedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeProofExternalOwnedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
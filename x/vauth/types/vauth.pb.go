@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,6 +24,71 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProofKind defines how the signature of a proof is verified
+type ProofKind int32
+
+const (
+	// PROOF_KIND_SECP256K1 is the signature signed by the private key of the account, verified by ecrecover
+	PROOF_KIND_SECP256K1 ProofKind = 0
+	// PROOF_KIND_EIP1271 is the signature of a contract wallet,
+	// verified by calling `isValidSignature(bytes32,bytes)` on the account's contract code (EIP-1271)
+	PROOF_KIND_EIP1271 ProofKind = 1
+)
+
+var ProofKind_name = map[int32]string{
+	0: "PROOF_KIND_SECP256K1",
+	1: "PROOF_KIND_EIP1271",
+}
+
+var ProofKind_value = map[string]int32{
+	"PROOF_KIND_SECP256K1": 0,
+	"PROOF_KIND_EIP1271":   1,
+}
+
+func (x ProofKind) String() string {
+	return proto.EnumName(ProofKind_name, int32(x))
+}
+
+func (ProofKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9389809a2e1cd610, []int{0}
+}
+
+// FeeDestination defines where the submission fee goes to
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_UNSPECIFIED is invalid
+	FEE_DESTINATION_UNSPECIFIED FeeDestination = 0
+	// FEE_DESTINATION_BURN burns the fee
+	FEE_DESTINATION_BURN FeeDestination = 1
+	// FEE_DESTINATION_COMMUNITY_POOL funds the fee to the community pool
+	FEE_DESTINATION_COMMUNITY_POOL FeeDestination = 2
+	// FEE_DESTINATION_FEE_COLLECTOR sends the fee to the fee collector, to be distributed like tx fees
+	FEE_DESTINATION_FEE_COLLECTOR FeeDestination = 3
+)
+
+var FeeDestination_name = map[int32]string{
+	0: "FEE_DESTINATION_UNSPECIFIED",
+	1: "FEE_DESTINATION_BURN",
+	2: "FEE_DESTINATION_COMMUNITY_POOL",
+	3: "FEE_DESTINATION_FEE_COLLECTOR",
+}
+
+var FeeDestination_value = map[string]int32{
+	"FEE_DESTINATION_UNSPECIFIED":    0,
+	"FEE_DESTINATION_BURN":           1,
+	"FEE_DESTINATION_COMMUNITY_POOL": 2,
+	"FEE_DESTINATION_FEE_COLLECTOR":  3,
+}
+
+func (x FeeDestination) String() string {
+	return proto.EnumName(FeeDestination_name, int32(x))
+}
+
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9389809a2e1cd610, []int{1}
+}
+
 // ProofExternalOwnedAccount store the proof that account is external owned account (EOA)
 type ProofExternalOwnedAccount struct {
 	// account is the cosmos bech32 address of the account that has proof
@@ -31,6 +97,10 @@ type ProofExternalOwnedAccount struct {
 	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	// signature is the signed message using private key
 	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// expiry_height is the block height from which the proof is no longer valid, zero means never expire
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// kind is the kind of the proof, defines how the signature was verified
+	Kind ProofKind `protobuf:"varint,5,opt,name=kind,proto3,enum=evermint.vauth.v1.ProofKind" json:"kind,omitempty"`
 }
 
 func (m *ProofExternalOwnedAccount) Reset()         { *m = ProofExternalOwnedAccount{} }
@@ -87,28 +157,203 @@ func (m *ProofExternalOwnedAccount) GetSignature() string {
 	return ""
 }
 
+func (m *ProofExternalOwnedAccount) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *ProofExternalOwnedAccount) GetKind() ProofKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PROOF_KIND_SECP256K1
+}
+
+// Params defines the vauth module params
+type Params struct {
+	// submit_proof_fee is the fee charged from the submitter for each submission of proof of external owned account (EOA)
+	SubmitProofFee types.Coin `protobuf:"bytes,1,opt,name=submit_proof_fee,json=submitProofFee,proto3" json:"submit_proof_fee"`
+	// fee_destination is where the submission fee goes to
+	FeeDestination FeeDestination `protobuf:"varint,2,opt,name=fee_destination,json=feeDestination,proto3,enum=evermint.vauth.v1.FeeDestination" json:"fee_destination,omitempty"`
+	// exempt_fee_self_submission exempts the submission fee when the submitter is the account to prove
+	ExemptFeeSelfSubmission bool `protobuf:"varint,3,opt,name=exempt_fee_self_submission,json=exemptFeeSelfSubmission,proto3" json:"exempt_fee_self_submission,omitempty"`
+	// eoa_proof_rules is the list of rules, each rule requires the account at the address field of the matching message
+	// to have proof of external owned account (EOA), otherwise the transaction will be rejected
+	EoaProofRules []EoaProofRule `protobuf:"bytes,4,rep,name=eoa_proof_rules,json=eoaProofRules,proto3" json:"eoa_proof_rules"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9389809a2e1cd610, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSubmitProofFee() types.Coin {
+	if m != nil {
+		return m.SubmitProofFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetFeeDestination() FeeDestination {
+	if m != nil {
+		return m.FeeDestination
+	}
+	return FEE_DESTINATION_UNSPECIFIED
+}
+
+func (m *Params) GetExemptFeeSelfSubmission() bool {
+	if m != nil {
+		return m.ExemptFeeSelfSubmission
+	}
+	return false
+}
+
+func (m *Params) GetEoaProofRules() []EoaProofRule {
+	if m != nil {
+		return m.EoaProofRules
+	}
+	return nil
+}
+
+// EoaProofRule defines a message which requires the account at the given address field to have proof of EOA
+type EoaProofRule struct {
+	// msg_type_url is the type url of the message, e.g. `/cosmos.vesting.v1beta1.MsgCreateVestingAccount`
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// address_field is the proto field name of the address to check, e.g. `to_address`.
+	// Nested field is separated by dot, e.g. `grant.grantee`.
+	// Both account address and validator operator address are supported.
+	AddressField string `protobuf:"bytes,2,opt,name=address_field,json=addressField,proto3" json:"address_field,omitempty"`
+}
+
+func (m *EoaProofRule) Reset()         { *m = EoaProofRule{} }
+func (m *EoaProofRule) String() string { return proto.CompactTextString(m) }
+func (*EoaProofRule) ProtoMessage()    {}
+func (*EoaProofRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9389809a2e1cd610, []int{2}
+}
+func (m *EoaProofRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EoaProofRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EoaProofRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EoaProofRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EoaProofRule.Merge(m, src)
+}
+func (m *EoaProofRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EoaProofRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EoaProofRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EoaProofRule proto.InternalMessageInfo
+
+func (m *EoaProofRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EoaProofRule) GetAddressField() string {
+	if m != nil {
+		return m.AddressField
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("evermint.vauth.v1.ProofKind", ProofKind_name, ProofKind_value)
+	proto.RegisterEnum("evermint.vauth.v1.FeeDestination", FeeDestination_name, FeeDestination_value)
 	proto.RegisterType((*ProofExternalOwnedAccount)(nil), "evermint.vauth.v1.ProofExternalOwnedAccount")
+	proto.RegisterType((*Params)(nil), "evermint.vauth.v1.Params")
+	proto.RegisterType((*EoaProofRule)(nil), "evermint.vauth.v1.EoaProofRule")
 }
 
 func init() { proto.RegisterFile("evermint/vauth/v1/vauth.proto", fileDescriptor_9389809a2e1cd610) }
 
 var fileDescriptor_9389809a2e1cd610 = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2d, 0x4b, 0x2d,
-	0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x4b, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x84, 0x30, 0xf4,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x61, 0xd2, 0x7a, 0x10, 0xd1, 0x32, 0x43, 0x29, 0x91,
-	0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0xac, 0x3e, 0x88, 0x05, 0x51, 0xa8, 0x94, 0xce, 0x25, 0x19, 0x50,
-	0x94, 0x9f, 0x9f, 0xe6, 0x5a, 0x51, 0x92, 0x5a, 0x94, 0x97, 0x98, 0xe3, 0x5f, 0x9e, 0x97, 0x9a,
-	0xe2, 0x98, 0x9c, 0x9c, 0x5f, 0x9a, 0x57, 0x22, 0x24, 0xc1, 0xc5, 0x9e, 0x08, 0x61, 0x4a, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0x19, 0x89, 0xc5, 0x19, 0x12,
-	0x4c, 0x60, 0x61, 0x30, 0x5b, 0x48, 0x86, 0x8b, 0xb3, 0x38, 0x33, 0x3d, 0x2f, 0xb1, 0xa4, 0xb4,
-	0x28, 0x55, 0x82, 0x19, 0x2c, 0x81, 0x10, 0x70, 0x72, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0xcd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d,
-	0xd7, 0xe2, 0xe4, 0xc4, 0x3c, 0x27, 0x57, 0x7d, 0xb8, 0xef, 0x2a, 0xa0, 0xfe, 0x2b, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xda, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x12, 0x4a, 0x51,
-	0x0b, 0xfe, 0x00, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3d, 0x73, 0xd3, 0x40,
+	0x10, 0xb5, 0x12, 0x13, 0xc8, 0xc5, 0x71, 0xcc, 0x4d, 0x06, 0x14, 0x93, 0x28, 0x8e, 0x69, 0x4c,
+	0x0a, 0x09, 0x9b, 0x01, 0x0a, 0xaa, 0x58, 0x96, 0x07, 0x91, 0xc4, 0xf2, 0xc8, 0x76, 0x01, 0xcd,
+	0xcd, 0xd9, 0x5e, 0xcb, 0x1a, 0x24, 0x9d, 0x47, 0x77, 0x32, 0xce, 0x3f, 0xa0, 0xa4, 0xa4, 0xa7,
+	0xe4, 0x3f, 0x50, 0xa7, 0x4c, 0x49, 0xc5, 0x30, 0xc9, 0x1f, 0x61, 0xf4, 0x91, 0x4f, 0xd2, 0xed,
+	0xbe, 0xf7, 0xa4, 0x7b, 0xfb, 0x76, 0x16, 0xed, 0xc0, 0x1c, 0x42, 0xdf, 0x0d, 0x84, 0x36, 0xa7,
+	0x91, 0x98, 0x6a, 0xf3, 0x7a, 0x5a, 0xa8, 0xb3, 0x90, 0x09, 0x86, 0x1f, 0x5f, 0xd2, 0x6a, 0x8a,
+	0xce, 0xeb, 0xe5, 0x4d, 0x87, 0x39, 0x2c, 0x61, 0xb5, 0xb8, 0x4a, 0x85, 0x65, 0x65, 0xc4, 0xb8,
+	0xcf, 0xb8, 0x36, 0xa4, 0x1c, 0xb4, 0x79, 0x7d, 0x08, 0x82, 0xd6, 0xb5, 0x11, 0x73, 0x83, 0x94,
+	0xaf, 0xfe, 0x92, 0xd0, 0x56, 0x37, 0x64, 0x6c, 0x62, 0x2c, 0x04, 0x84, 0x01, 0xf5, 0xac, 0x2f,
+	0x01, 0x8c, 0x0f, 0x46, 0x23, 0x16, 0x05, 0x02, 0xcb, 0xe8, 0x21, 0x4d, 0x4b, 0x59, 0xaa, 0x48,
+	0xb5, 0x55, 0xfb, 0xb2, 0xc5, 0x18, 0xe5, 0xa7, 0x94, 0x4f, 0xe5, 0xa5, 0x04, 0x4e, 0x6a, 0xbc,
+	0x8d, 0x56, 0xb9, 0xeb, 0x04, 0x54, 0x44, 0x21, 0xc8, 0xcb, 0x09, 0x71, 0x0d, 0xe0, 0xe7, 0x68,
+	0x1d, 0x16, 0x33, 0x37, 0x3c, 0x21, 0x53, 0x70, 0x9d, 0xa9, 0x90, 0xf3, 0x15, 0xa9, 0x96, 0xb7,
+	0x0b, 0x29, 0xf8, 0x3e, 0xc1, 0xf0, 0x4b, 0x94, 0xff, 0xec, 0x06, 0x63, 0xf9, 0x41, 0x45, 0xaa,
+	0x15, 0x1b, 0xdb, 0xea, 0x7f, 0x63, 0xaa, 0x89, 0xd9, 0x43, 0x37, 0x18, 0xdb, 0x89, 0xb2, 0xfa,
+	0x73, 0x09, 0xad, 0x74, 0x69, 0x48, 0x7d, 0x8e, 0x4d, 0x54, 0xe2, 0xd1, 0xd0, 0x77, 0x05, 0x99,
+	0xc5, 0x22, 0x32, 0x01, 0x48, 0x6c, 0xaf, 0x35, 0xb6, 0xd4, 0x34, 0x06, 0x35, 0x8e, 0x41, 0xcd,
+	0x62, 0x50, 0x75, 0xe6, 0x06, 0xcd, 0xfc, 0xe9, 0x9f, 0xdd, 0x9c, 0x5d, 0x4c, 0x3f, 0x4c, 0x7e,
+	0xde, 0x06, 0xc0, 0x1f, 0xd0, 0xc6, 0x04, 0x80, 0x8c, 0x81, 0x0b, 0x37, 0xa0, 0xc2, 0x65, 0x41,
+	0x32, 0x69, 0xb1, 0xb1, 0x77, 0x8f, 0xa5, 0x36, 0x40, 0xeb, 0x5a, 0x68, 0x17, 0x27, 0xb7, 0x7a,
+	0xfc, 0x0e, 0x95, 0x61, 0x01, 0xfe, 0x4c, 0xc4, 0x86, 0x08, 0x07, 0x6f, 0x42, 0x92, 0xd7, 0x38,
+	0x8f, 0x7f, 0x1b, 0xe7, 0xf4, 0xc8, 0x7e, 0x9a, 0x2a, 0xda, 0x00, 0x3d, 0xf0, 0x26, 0xbd, 0x2b,
+	0x1a, 0x1f, 0xa3, 0x0d, 0x60, 0x34, 0x1b, 0x28, 0x8c, 0x3c, 0xe0, 0x72, 0xbe, 0xb2, 0x5c, 0x5b,
+	0x6b, 0xec, 0xde, 0x63, 0xc4, 0x60, 0x34, 0x99, 0xc0, 0x8e, 0x3c, 0xc8, 0x06, 0x5b, 0x87, 0x1b,
+	0x18, 0xaf, 0x0e, 0x50, 0xe1, 0xa6, 0x08, 0x57, 0x50, 0xc1, 0xe7, 0x0e, 0x11, 0x27, 0x33, 0x20,
+	0x51, 0xe8, 0x65, 0x5b, 0x46, 0x3e, 0x77, 0xfa, 0x27, 0x33, 0x18, 0x84, 0x5e, 0xbc, 0x36, 0x3a,
+	0x1e, 0x87, 0xc0, 0x39, 0x99, 0xb8, 0xe0, 0x8d, 0xb3, 0x8d, 0x17, 0x32, 0xb0, 0x1d, 0x63, 0xfb,
+	0x3a, 0x5a, 0xbd, 0xda, 0x0b, 0x96, 0xd1, 0x66, 0xd7, 0xb6, 0xac, 0x36, 0x39, 0x34, 0x3b, 0x2d,
+	0xd2, 0x33, 0xf4, 0x6e, 0xe3, 0xf5, 0x9b, 0xc3, 0x7a, 0x29, 0x87, 0x9f, 0x20, 0x7c, 0x83, 0x31,
+	0xcc, 0x6e, 0xbd, 0xf1, 0xb6, 0x5e, 0x92, 0xca, 0xf9, 0xaf, 0x3f, 0x94, 0xdc, 0xfe, 0x77, 0x09,
+	0x15, 0x6f, 0x47, 0x89, 0x77, 0xd1, 0xb3, 0xb6, 0x61, 0x90, 0x96, 0xd1, 0xeb, 0x9b, 0x9d, 0x83,
+	0xbe, 0x69, 0x75, 0xc8, 0xa0, 0xd3, 0xeb, 0x1a, 0xba, 0xd9, 0x36, 0x8d, 0x56, 0x29, 0x17, 0xbf,
+	0x75, 0x57, 0xd0, 0x1c, 0xd8, 0x9d, 0x92, 0x84, 0xab, 0x48, 0xb9, 0xcb, 0xe8, 0xd6, 0xf1, 0xf1,
+	0xa0, 0x63, 0xf6, 0x3f, 0x92, 0xae, 0x65, 0x1d, 0x95, 0x96, 0xf0, 0x1e, 0xda, 0xb9, 0xab, 0x89,
+	0x7b, 0xdd, 0x3a, 0x3a, 0x32, 0xf4, 0xbe, 0x65, 0x97, 0x96, 0x53, 0x6b, 0x4d, 0xfd, 0xf4, 0x5c,
+	0x91, 0xce, 0xce, 0x15, 0xe9, 0xef, 0xb9, 0x22, 0x7d, 0xbb, 0x50, 0x72, 0x67, 0x17, 0x4a, 0xee,
+	0xf7, 0x85, 0x92, 0xfb, 0xf4, 0xc2, 0x71, 0xc5, 0x34, 0x1a, 0xaa, 0x23, 0xe6, 0x6b, 0x06, 0x1f,
+	0xd1, 0xa0, 0x69, 0x68, 0x57, 0xa7, 0xbb, 0xc8, 0x8e, 0x37, 0x8e, 0x97, 0x0f, 0x57, 0x92, 0x8b,
+	0x7b, 0xf5, 0x6f, 0x00, 0x20, 0x47, 0xdb, 0xdf, 0xdb, 0x03, 0x00, 0x00,
 }
 
 func (m *ProofExternalOwnedAccount) Marshal() (dAtA []byte, err error) {
@@ -131,6 +376,16 @@ func (m *ProofExternalOwnedAccount) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintVauth(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintVauth(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -155,6 +410,105 @@ func (m *ProofExternalOwnedAccount) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EoaProofRules) > 0 {
+		for iNdEx := len(m.EoaProofRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EoaProofRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVauth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExemptFeeSelfSubmission {
+		i--
+		if m.ExemptFeeSelfSubmission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FeeDestination != 0 {
+		i = encodeVarintVauth(dAtA, i, uint64(m.FeeDestination))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubmitProofFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVauth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EoaProofRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EoaProofRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EoaProofRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressField) > 0 {
+		i -= len(m.AddressField)
+		copy(dAtA[i:], m.AddressField)
+		i = encodeVarintVauth(dAtA, i, uint64(len(m.AddressField)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintVauth(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVauth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVauth(v)
 	base := offset
@@ -184,6 +538,52 @@ func (m *ProofExternalOwnedAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVauth(uint64(l))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovVauth(uint64(m.ExpiryHeight))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovVauth(uint64(m.Kind))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubmitProofFee.Size()
+	n += 1 + l + sovVauth(uint64(l))
+	if m.FeeDestination != 0 {
+		n += 1 + sovVauth(uint64(m.FeeDestination))
+	}
+	if m.ExemptFeeSelfSubmission {
+		n += 2
+	}
+	if len(m.EoaProofRules) > 0 {
+		for _, e := range m.EoaProofRules {
+			l = e.Size()
+			n += 1 + l + sovVauth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EoaProofRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovVauth(uint64(l))
+	}
+	l = len(m.AddressField)
+	if l > 0 {
+		n += 1 + l + sovVauth(uint64(l))
+	}
 	return n
 }
 
@@ -318,6 +718,314 @@ func (m *ProofExternalOwnedAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ProofKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVauth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVauth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitProofFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmitProofFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+			}
+			m.FeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDestination |= FeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptFeeSelfSubmission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExemptFeeSelfSubmission = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EoaProofRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EoaProofRules = append(m.EoaProofRules, EoaProofRule{})
+			if err := m.EoaProofRules[len(m.EoaProofRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVauth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EoaProofRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVauth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EoaProofRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EoaProofRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVauth(dAtA[iNdEx:])